@@ -0,0 +1,47 @@
+// Renders a shelter's open items where this script is placed. The items are read from the JSON
+// served next to the script, so one copy of it works for every shelter.
+(function () {
+    var script = document.currentScript;
+    var container = document.createElement('div');
+    container.className = 'neighbors-widget';
+    script.parentNode.insertBefore(container, script);
+
+    var element = function (tagName, text, parent) {
+        var child = document.createElement(tagName);
+        if (text) {
+            child.textContent = text;
+        }
+        parent.appendChild(child);
+        return child;
+    };
+
+    var link = function (text, href, parent) {
+        var anchor = element('a', text, parent);
+        anchor.href = href;
+        anchor.target = '_blank';
+        anchor.rel = 'noopener';
+        return anchor;
+    };
+
+    var req = new XMLHttpRequest();
+    req.open('GET', script.src.replace(/\.js(\?.*)?$/, '.json'));
+    req.onreadystatechange = function () {
+        if (req.readyState !== 4 || req.status !== 200) {
+            return false;
+        }
+
+        var payload = JSON.parse(req.response);
+        element('h3', 'Items ' + payload.Shelter.Name + ' needs', container);
+        var list = element('ul', '', container);
+        payload.Items.forEach(function (item) {
+            var row = element('li', item.Quantity + ' ' + item.Category + ' (' + item.Gender + ', size ' + item.Size + ') ', list);
+            link('Help with this', item.HelpURL, row);
+        });
+        if (payload.Items.length === 0) {
+            element('li', 'Nothing right now. Thank you!', list);
+        }
+        link('See ' + payload.Shelter.Name + ' on Neighbors', payload.Shelter.URL, element('p', '', container));
+        return false;
+    };
+    req.send();
+})();
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os"
+
+	"github.com/kwhite17/Neighbors/pkg/config"
+)
+
+func runConfig(args []string) error {
+	return runSubcommand("config", args, map[string]func([]string) error{"print": printConfig})
+}
+
+// printConfig implements `neighbors config print`, which shows the settings the server would start
+// with after layering the config file, environment and flags. Invalid settings are still printed,
+// so they can be found.
+func printConfig(args []string) error {
+	cfg, err := config.Load("neighbors config print", args, os.LookupEnv)
+	if cfg == nil {
+		return err
+	}
+
+	if printErr := cfg.Print(os.Stdout); printErr != nil {
+		return printErr
+	}
+	return err
+}
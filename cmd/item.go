@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func runItem(args []string) error {
+	return runSubcommand("item", args, map[string]func([]string) error{
+		"import": importItems,
+		"export": exportItems,
+	})
+}
+
+// importItems posts every item in a CSV or XLSX file for a shelter. The whole file is checked
+// before any item is written, so a bad line doesn't leave a partial import behind.
+func importItems(args []string) error {
+	flags := flag.NewFlagSet("neighbors item import", flag.ContinueOnError)
+	shelter := flags.String("shelter", "", "ID, email or username of the shelter to post the items for")
+	cfg, err := loadCommandConfig(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if *shelter == "" || flags.NArg() != 1 {
+		return errors.New("Usage: neighbors item import -shelter <id|email|username> <file.csv|file.xlsx>")
+	}
+
+	rows, err := readItemsFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	items := managers.ImportableItems(rows)
+	if len(items) < len(rows) {
+		for _, row := range rows {
+			if len(row.Errors) > 0 {
+				fmt.Fprintf(os.Stderr, "line %d: %v\n", row.Line, row.Errors)
+			}
+		}
+		return fmt.Errorf("%d of %d lines have problems, so nothing was imported", len(rows)-len(items), len(rows))
+	}
+
+	ctx := commandContext()
+	datasource := buildDatasource(cfg)
+	user, err := findUser(ctx, &managers.UserManager{Datasource: datasource}, *shelter)
+	if err != nil {
+		return err
+	}
+
+	if user.UserType != managers.SHELTER {
+		return fmt.Errorf("%s is not a shelter", *shelter)
+	}
+
+	itemIDs, err := (&managers.ItemManager{Datasource: datasource}).ImportItems(ctx, user.ID, items)
+	fmt.Printf("Imported %d of %d items for %s\n", len(itemIDs), len(items), user.Name)
+	return err
+}
+
+func readItemsFile(name string) ([]*managers.ItemImportRow, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if !strings.EqualFold(filepath.Ext(name), ".xlsx") {
+		return managers.ReadItemsCSV(file)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return managers.ReadItemsXLSX(file, info.Size())
+}
+
+// exportItems writes every item, or one shelter's items, as CSV.
+func exportItems(args []string) error {
+	flags := flag.NewFlagSet("neighbors item export", flag.ContinueOnError)
+	shelter := flags.String("shelter", "", "only export the items of the shelter with this ID, email or username")
+	output := flags.String("o", "", "file to write, instead of standard output")
+	cfg, err := loadCommandConfig(flags, args)
+	if err != nil {
+		return err
+	}
+
+	ctx := commandContext()
+	datasource := buildDatasource(cfg)
+	itemManager := &managers.ItemManager{Datasource: datasource}
+	var items []*managers.Item
+	if *shelter == "" {
+		items, err = itemManager.GetItems(ctx)
+	} else {
+		user, lookupErr := findUser(ctx, &managers.UserManager{Datasource: datasource}, *shelter)
+		if lookupErr != nil {
+			return lookupErr
+		}
+		items, err = itemManager.GetItemsForShelter(ctx, user.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	return managers.WriteItemsCSV(w, items)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+// runMigrate brings the configured database up to date with the schema. SQLite databases are
+// also migrated whenever they are opened, so this is mostly for Postgres.
+func runMigrate(args []string) error {
+	cfg, err := loadCommandConfig(flag.NewFlagSet("neighbors migrate", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+
+	alterations, err := database.MigrateDatasource(buildDatasource(cfg))
+	for _, statement := range alterations {
+		fmt.Println(statement)
+	}
+
+	if err != nil {
+		return err
+	}
+	fmt.Println("The database is up to date")
+	return nil
+}
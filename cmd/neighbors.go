@@ -1,15 +1,17 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
-	"log"
-	"net/http"
+	"fmt"
 	"os"
+	"sort"
+	"strings"
 
-	"github.com/gorilla/mux"
+	"github.com/kwhite17/Neighbors/pkg/config"
+	"github.com/kwhite17/Neighbors/pkg/logging"
 	"github.com/kwhite17/Neighbors/pkg/managers"
-	"github.com/kwhite17/Neighbors/pkg/resources"
-	"github.com/kwhite17/Neighbors/pkg/retrievers"
 	"gopkg.in/gomail.v2"
 
 	"github.com/kwhite17/Neighbors/pkg/database"
@@ -18,83 +20,123 @@ import (
 )
 
 type EnvironmentConfig struct {
+	Config      *config.Config
 	Datasource  database.Datasource
 	EmailSender email.EmailSender
 }
 
-func buildDatasource(driver string, host string, developmentMode bool) database.Datasource {
-	return database.BuildDatasource(driver, host, developmentMode)
+// commands are run as `neighbors <command> [arguments]`. Running neighbors without a command, or
+// with only flags, serves the site.
+var commands = map[string]func(args []string) error{
+	"serve":    runServe,
+	"migrate":  runMigrate,
+	"seed":     runSeed,
+	"user":     runUser,
+	"item":     runItem,
+	"sessions": runSessions,
+	"config":   runConfig,
 }
 
-func buildEmailSender(developmentMode bool, userManager *managers.UserManager) email.EmailSender {
-	if developmentMode {
-		return &email.LocalSender{Dialer: &gomail.Dialer{Host: "localhost", Port: 25}, UserManager: userManager}
+const usage = `Usage: neighbors <command> [arguments]
+
+Commands:
+  serve                              serve the site (the default)
+  migrate                            bring the database schema up to date
+  seed                               fill the database with fake shelters, samaritans and items
+  user create|disable|reset-password manage accounts
+  item import|export                 import items from CSV or XLSX, export them as CSV
+  sessions purge                     sign users out
+  config print                       show the settings after layering file, environment and flags
+
+Every command accepts the server's settings as flags, e.g. -dbDriver postgres. Run
+neighbors <command> -h for the rest of its flags.
+`
+
+func buildDatasource(cfg *config.Config) database.Datasource {
+	return database.BuildDatasource(cfg.Database.Driver, cfg.DatabaseHost(), cfg.Server.DevelopmentMode)
+}
+
+func buildEmailSender(cfg *config.Config, userManager *managers.UserManager) email.EmailSender {
+	if cfg.EmailProvider() == config.SMTP_PROVIDER {
+		return &email.LocalSender{
+			Dialer:        &gomail.Dialer{Host: cfg.Email.SMTPHost, Port: cfg.Email.SMTPPort},
+			UserManager:   userManager,
+			SenderAddress: cfg.Email.SenderAddress,
+			SenderName:    cfg.Email.SenderName,
+		}
+	}
+	return &email.SendGridSender{
+		Client:        sendgrid.NewSendClient(cfg.Email.SendGridAPIKey),
+		UserManager:   userManager,
+		SenderAddress: cfg.Email.SenderAddress,
+		SenderName:    cfg.Email.SenderName,
 	}
-	return &email.SendGridSender{Client: sendgrid.NewSendClient(os.Getenv("SENDGRID_API_KEY")), UserManager: userManager}
 }
 
-func buildEnvironment(datasource database.Datasource, emailSender email.EmailSender) *EnvironmentConfig {
-	return &EnvironmentConfig{Datasource: datasource, EmailSender: emailSender}
+func buildEnvironment(cfg *config.Config) *EnvironmentConfig {
+	datasource := buildDatasource(cfg)
+	return &EnvironmentConfig{Config: cfg, Datasource: datasource, EmailSender: buildEmailSender(cfg, &managers.UserManager{Datasource: datasource})}
 }
 
-func main() {
-	driver := flag.String("dbDriver", "sqlite3", "Name of database driver to use")
-	dbHost, dbHostFound := os.LookupEnv("DATABASE_URL")
-	developmentMode := flag.Bool("developmentMode", false, "run app in development mode")
-	if !dbHostFound {
-		dbHost = "file::memory:?mode=memory&cache=shared"
+// loadCommandConfig loads the config for a command that changes the database. The default
+// in-memory database would be thrown away as soon as the command exits, so one has to be named.
+func loadCommandConfig(flags *flag.FlagSet, args []string) (*config.Config, error) {
+	cfg, err := config.LoadFlags(flags, args, os.LookupEnv)
+	if err != nil {
+		return nil, err
 	}
-	port, portFound := os.LookupEnv("PORT")
-	if !portFound {
-		port = "8080"
+
+	if cfg.Database.URL == "" && cfg.Database.SQLiteFile == "" {
+		return nil, errors.New("No database configured: set -databaseURL or -sqliteFile")
 	}
-	flag.Parse()
-	log.Println("Connecting to host", dbHost)
-	log.Println("Development mode set to:", *developmentMode)
-
-	datasource := buildDatasource(*driver, dbHost, *developmentMode)
-	userManager := &managers.UserManager{Datasource: datasource}
-	itemManager := &managers.ItemManager{Datasource: datasource}
-	userSessionManager := &managers.UserSessionManager{Datasource: datasource}
-	environment := buildEnvironment(datasource, buildEmailSender(*developmentMode, userManager))
-
-	router := mux.NewRouter()
-	router.PathPrefix("/shelters").Handler(buildUserServiceHandler(userSessionManager, userManager, itemManager))
-	router.PathPrefix("/items").Handler(buildItemServiceHandler(userSessionManager, itemManager, environment))
-	router.PathPrefix("/session").Handler(buildLoginServiceHandler(userSessionManager, userManager, environment))
-	router.PathPrefix("/").Handler(buildHomeServiceHandler(userSessionManager))
-	http.ListenAndServe(":"+port, router)
+	logging.SetDefault(cfg.NewLogger(os.Stderr))
+	return cfg, nil
 }
 
-func buildHomeServiceHandler(userSessionManager *managers.UserSessionManager) resources.HomeServiceHandler {
-	return resources.HomeServiceHandler{
-		UserSessionManager: userSessionManager,
-	}
+// commandContext marks the changes a command makes as coming from the command line in the audit log.
+func commandContext() context.Context {
+	return managers.WithAuditActor(context.Background(), &managers.AuditActor{Session: "cli"})
 }
 
-func buildUserServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager, itemManager *managers.ItemManager) resources.UserServiceHandler {
-	return resources.UserServiceHandler{
-		UserSessionManager: userSessionManager,
-		UserManager:        userManager,
-		ItemManager:        itemManager,
-		UserRetriever:      &retrievers.ShelterRetriever{},
+// runSubcommand runs commands like `neighbors user create`, where name is "user".
+func runSubcommand(name string, args []string, subcommands map[string]func(args []string) error) error {
+	names := make([]string, 0, len(subcommands))
+	for subcommand := range subcommands {
+		names = append(names, subcommand)
 	}
-}
+	sort.Strings(names)
 
-func buildItemServiceHandler(userSessionManager *managers.UserSessionManager, itemManager *managers.ItemManager, environment *EnvironmentConfig) resources.ItemServiceHandler {
-	return resources.ItemServiceHandler{
-		UserSessionManager: userSessionManager,
-		ItemManager:        itemManager,
-		EmailSender:        environment.EmailSender,
-		ItemRetriever:      &retrievers.ItemRetriever{},
+	if len(args) == 0 {
+		return fmt.Errorf("Usage: neighbors %s %s", name, strings.Join(names, "|"))
 	}
+
+	run, found := subcommands[args[0]]
+	if !found {
+		return fmt.Errorf("Unknown command %q, expected neighbors %s %s", args[0], name, strings.Join(names, "|"))
+	}
+	return run(args[1:])
 }
 
-func buildLoginServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager, environment *EnvironmentConfig) resources.LoginServiceHandler {
-	return resources.LoginServiceHandler{
-		UserManager:        userManager,
-		UserSessionManager: userSessionManager,
-		LoginRetriever:     &retrievers.LoginRetriever{},
-		EmailSender:        environment.EmailSender,
+func main() {
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+
+	if command == "help" {
+		fmt.Print(usage)
+		return
+	}
+
+	run, found := commands[command]
+	if !found {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := run(args); err == flag.ErrHelp {
+		os.Exit(2)
+	} else if err != nil {
+		logging.Default().Fatal("Command failed", logging.Fields{"command": command, "error": err})
 	}
 }
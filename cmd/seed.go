@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+type seedAddress struct {
+	Street     string
+	City       string
+	PostalCode string
+}
+
+var seedShelterNames = []string{
+	"Harbor Light Family Shelter",
+	"Riverside Women's Center",
+	"Elm Street Community House",
+	"North End Warming Center",
+	"Maple Hill Youth Shelter",
+	"Bayview Veterans House",
+	"Canal Street Day Center",
+	"Cedar Grove Family Residence",
+}
+
+var seedAddresses = []seedAddress{
+	{"112 Harrison Ave", "Boston", "02118"},
+	{"45 Prospect St", "Cambridge", "02139"},
+	{"300 Somerville Ave", "Somerville", "02143"},
+	{"18 Main St", "Worcester", "01608"},
+	{"76 Merrimack St", "Lowell", "01852"},
+	{"210 State St", "Springfield", "01103"},
+	{"9 Washington St", "Quincy", "02169"},
+	{"54 Pleasant St", "Brockton", "02301"},
+}
+
+var seedFirstNames = []string{"Maria", "James", "Aisha", "Wei", "Daniel", "Priya", "Tomás", "Grace", "Kwame", "Hannah", "Luis", "Siobhan"}
+var seedLastNames = []string{"Garcia", "O'Brien", "Nguyen", "Patel", "Johnson", "Silva", "Murphy", "Chen", "Okafor", "Rossi", "Kowalski", "Haddad"}
+
+var seedSizes = map[string][]string{
+	"SOCKS":     {"S", "M", "L", "XL"},
+	"UNDERWEAR": {"S", "M", "L", "XL"},
+	"BLANKETS":  {"Twin", "Full", "Queen"},
+}
+var seedCategories = []string{"SOCKS", "UNDERWEAR", "BLANKETS"}
+var seedGenders = []string{"FEMALE", "MALE", "UNISEX"}
+
+// runSeed fills a development database with shelters, samaritans, and items at every stage of
+// delivery. Every account gets the same password, so any of them can be logged in as.
+func runSeed(args []string) error {
+	flags := flag.NewFlagSet("neighbors seed", flag.ContinueOnError)
+	shelterCount := flags.Int("shelters", 4, "number of shelters to create")
+	samaritanCount := flags.Int("samaritans", 6, "number of samaritans to create")
+	itemCount := flags.Int("items", 5, "number of items to post for each shelter")
+	password := flags.String("password", "neighbors", "password for every account")
+	seed := flags.Int64("randomSeed", time.Now().UnixNano(), "seed for the generated data")
+	cfg, err := loadCommandConfig(flags, args)
+	if err != nil {
+		return err
+	}
+
+	ctx := commandContext()
+	datasource := buildDatasource(cfg)
+	userManager := &managers.UserManager{Datasource: datasource}
+	itemManager := &managers.ItemManager{Datasource: datasource}
+	random := rand.New(rand.NewSource(*seed))
+	// The suffix keeps usernames and emails unique when seeding the same database more than once.
+	suffix := fmt.Sprintf("%04d", random.Intn(10000))
+
+	samaritanIDs := make([]int64, 0, *samaritanCount)
+	for i := 0; i < *samaritanCount; i++ {
+		firstName := seedFirstNames[random.Intn(len(seedFirstNames))]
+		lastName := seedLastNames[random.Intn(len(seedLastNames))]
+		username := seedUsername(firstName+lastName, suffix, i)
+		samaritan := &managers.User{
+			UserType: managers.SAMARITAN,
+			Username: username,
+			ContactInformation: &managers.ContactInformation{
+				Name:  firstName + " " + lastName,
+				Email: username + "@example.com",
+			},
+		}
+		samaritanID, err := userManager.WriteUser(ctx, samaritan, *password)
+		if err != nil {
+			return err
+		}
+		samaritanIDs = append(samaritanIDs, samaritanID)
+	}
+
+	itemTotal := 0
+	for i := 0; i < *shelterCount; i++ {
+		name := seedShelterNames[i%len(seedShelterNames)]
+		address := seedAddresses[random.Intn(len(seedAddresses))]
+		username := seedUsername(name, suffix, i)
+		shelter := &managers.User{
+			UserType: managers.SHELTER,
+			Username: username,
+			ContactInformation: &managers.ContactInformation{
+				Name:       name,
+				Email:      username + "@example.org",
+				Street:     address.Street,
+				City:       address.City,
+				State:      "MA",
+				PostalCode: address.PostalCode,
+			},
+		}
+		shelterID, err := userManager.WriteUser(ctx, shelter, *password)
+		if err != nil {
+			return err
+		}
+
+		for j := 0; j < *itemCount; j++ {
+			if err := seedItem(ctx, itemManager, random, shelterID, samaritanIDs); err != nil {
+				return err
+			}
+			itemTotal++
+		}
+	}
+
+	fmt.Printf("Created %d shelters, %d samaritans and %d items with the password %q\n", *shelterCount, *samaritanCount, itemTotal, *password)
+	return nil
+}
+
+// seedItem posts an item, and moves it along to a random status if there is a samaritan to claim it.
+func seedItem(ctx context.Context, itemManager *managers.ItemManager, random *rand.Rand, shelterID int64, samaritanIDs []int64) error {
+	category := seedCategories[random.Intn(len(seedCategories))]
+	sizes := seedSizes[category]
+	item := &managers.Item{
+		Category:  category,
+		Gender:    seedGenders[random.Intn(len(seedGenders))],
+		Size:      sizes[random.Intn(len(sizes))],
+		Quantity:  1 + random.Intn(40),
+		Unit:      managers.DefaultUnit(category),
+		Status:    managers.CREATED,
+		ShelterID: shelterID,
+	}
+	itemID, err := itemManager.WriteItem(ctx, item)
+	if err != nil {
+		return err
+	}
+
+	status := managers.ItemStatus(1 + random.Intn(4))
+	if status == managers.CREATED || len(samaritanIDs) == 0 {
+		return nil
+	}
+	item.ID = itemID
+	item.Status = status
+	item.SamaritanID = samaritanIDs[random.Intn(len(samaritanIDs))]
+	return itemManager.UpdateItem(ctx, item)
+}
+
+func seedUsername(name string, suffix string, index int) string {
+	username := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, strings.ToLower(name))
+	return fmt.Sprintf("%s%s%d", username, suffix, index)
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kwhite17/Neighbors/pkg/config"
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/jobs"
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/metrics"
+	"github.com/kwhite17/Neighbors/pkg/resources"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func runServe(args []string) error {
+	cfg, err := config.LoadFlags(flag.NewFlagSet("neighbors serve", flag.ContinueOnError), args, os.LookupEnv)
+	if err != nil {
+		return err
+	}
+	logger := cfg.NewLogger(os.Stderr)
+	logging.SetDefault(logger)
+	logger.Info("Connecting to database", logging.Fields{"driver": cfg.Database.Driver})
+	logger.Info("Development mode set", logging.Fields{"developmentMode": cfg.Server.DevelopmentMode})
+
+	environment := buildEnvironment(cfg)
+	datasource := environment.Datasource
+	userManager := &managers.UserManager{Datasource: datasource}
+	itemManager := &managers.ItemManager{Datasource: datasource}
+	userSessionManager := &managers.UserSessionManager{Datasource: datasource}
+	apiTokenManager := &managers.ApiTokenManager{Datasource: datasource}
+	accountManager := &managers.AccountManager{Datasource: datasource}
+	auditManager := &managers.AuditManager{Datasource: datasource}
+	attachmentManager := &managers.AttachmentManager{Datasource: datasource, Store: cfg.NewStore()}
+	deliveryManager := &managers.DeliveryManager{Datasource: datasource}
+	intakeManager := &managers.IntakeManager{Datasource: datasource}
+	feedManager := &managers.FeedManager{Datasource: datasource}
+	twoFactorManager := &managers.TwoFactorManager{Datasource: datasource}
+	settingsManager := &managers.SettingsManager{Datasource: datasource}
+	rateLimitStore := buildRateLimitStore(cfg.Security.RateLimitStore, datasource)
+	loginLimiter := managers.BuildLoginLimiter(rateLimitStore)
+	widgetManager := &managers.WidgetManager{Datasource: datasource, Store: rateLimitStore}
+	wishlistManager := &managers.WishlistManager{Datasource: datasource}
+
+	jobRunner := buildJobRunner(accountManager, itemManager, attachmentManager, userManager, userSessionManager, rateLimitStore, time.Duration(cfg.Retention.DeletedRecords))
+	jobRunner.Start(logging.WithLogger(context.Background(), logger))
+	shuttingDown := make(chan struct{})
+
+	prometheus.MustRegister(buildStateCollector(userSessionManager, itemManager))
+
+	router := mux.NewRouter()
+	router.Use(resources.MeasureRequests)
+	router.Path("/metrics").Handler(promhttp.Handler())
+	healthServiceHandler := buildHealthServiceHandler(environment, shuttingDown)
+	router.Path("/healthz").Handler(healthServiceHandler)
+	router.Path("/readyz").Handler(healthServiceHandler)
+	requireEnrollment := resources.RequireTwoFactorEnrollment(userSessionManager, apiTokenManager, twoFactorManager)
+	router.PathPrefix("/shelters").Handler(requireEnrollment(buildUserServiceHandler(userSessionManager, userManager, itemManager, intakeManager, widgetManager, wishlistManager, apiTokenManager, accountManager, environment)))
+	router.PathPrefix("/items").Handler(requireEnrollment(buildItemServiceHandler(userSessionManager, itemManager, attachmentManager, deliveryManager, intakeManager, apiTokenManager, environment)))
+	router.PathPrefix("/attachments").Handler(requireEnrollment(buildAttachmentServiceHandler(userSessionManager, itemManager, attachmentManager, apiTokenManager)))
+	router.PathPrefix("/feeds").Handler(requireEnrollment(buildFeedServiceHandler(userSessionManager, userManager, itemManager, intakeManager, feedManager)))
+	router.PathPrefix("/widget").Handler(buildWidgetServiceHandler(userManager, itemManager, widgetManager))
+	router.PathPrefix("/wishlists").Handler(requireEnrollment(buildWishlistServiceHandler(userSessionManager, userManager, itemManager, wishlistManager, apiTokenManager)))
+	router.PathPrefix("/tokens").Handler(requireEnrollment(buildApiTokenServiceHandler(userSessionManager, apiTokenManager)))
+	router.PathPrefix("/admin/audit").Handler(buildAuditServiceHandler(userSessionManager, auditManager))
+	router.PathPrefix("/admin/deleted").Handler(buildDeletedAccountServiceHandler(userSessionManager, userManager))
+	router.PathPrefix("/admin/settings").Handler(buildSettingsServiceHandler(userSessionManager, settingsManager))
+	router.PathPrefix("/session/2fa").Handler(buildTwoFactorServiceHandler(userSessionManager, userManager, twoFactorManager, accountManager, loginLimiter))
+	router.PathPrefix("/session").Handler(buildLoginServiceHandler(userSessionManager, userManager, twoFactorManager, accountManager, loginLimiter, environment))
+	router.PathPrefix("/").Handler(buildHomeServiceHandler(userSessionManager))
+	handler := resources.LinkToPublicOrigin(cfg.PublicOrigin())(resources.AuditRequests(router))
+	handler = resources.ResolveClientAddresses(cfg.TrustedProxyNetworks())(handler)
+	server := &http.Server{
+		Addr:         ":" + strconv.Itoa(cfg.Server.Port),
+		Handler:      resources.TraceRequests(logger)(handler),
+		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout),
+		WriteTimeout: time.Duration(cfg.Server.WriteTimeout),
+		IdleTimeout:  time.Duration(cfg.Server.IdleTimeout),
+	}
+	return serveUntilSignalled(server, jobRunner, datasource, shuttingDown, time.Duration(cfg.Server.ShutdownTimeout))
+}
+
+// serveUntilSignalled serves until SIGTERM or an interrupt, then stops accepting connections,
+// waits up to shutdownTimeout for in-flight requests and background jobs, and closes the database.
+func serveUntilSignalled(server *http.Server, jobRunner *jobs.Runner, datasource database.Datasource, shuttingDown chan struct{}, shutdownTimeout time.Duration) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	defer signal.Stop(signals)
+
+	serverErrors := make(chan error, 1)
+	go func() {
+		serverErrors <- server.ListenAndServe()
+	}()
+	logging.Default().Info("Listening", logging.Fields{"address": server.Addr})
+
+	select {
+	case err := <-serverErrors:
+		jobRunner.Stop()
+		return err
+	case received := <-signals:
+		logging.Default().Info("Shutting down", logging.Fields{"signal": received.String()})
+	}
+
+	close(shuttingDown)
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := server.Shutdown(ctx)
+
+	jobsStopped := make(chan struct{})
+	go func() {
+		jobRunner.Stop()
+		close(jobsStopped)
+	}()
+
+	select {
+	case <-jobsStopped:
+	case <-ctx.Done():
+		logging.Default().Error("Background jobs didn't stop before the shutdown timeout", nil)
+		return ctx.Err()
+	}
+
+	if closeErr := datasource.Close(); err == nil {
+		err = closeErr
+	}
+	logging.Default().Info("Shut down", nil)
+	return err
+}
+
+func buildStateCollector(userSessionManager *managers.UserSessionManager, itemManager *managers.ItemManager) *metrics.StateCollector {
+	return &metrics.StateCollector{
+		CountActiveSessions: func(ctx context.Context) (int64, error) {
+			return userSessionManager.CountActiveSessions(ctx, time.Now())
+		},
+		CountItemsByStatus: func(ctx context.Context) (map[string]int64, error) {
+			counts, err := itemManager.CountItemsByStatus(ctx)
+			byName := make(map[string]int64, len(counts))
+			for status, count := range counts {
+				byName[status.String()] = count
+			}
+			return byName, err
+		},
+	}
+}
+
+func buildHealthServiceHandler(environment *EnvironmentConfig, shuttingDown chan struct{}) resources.HealthServiceHandler {
+	return resources.HealthServiceHandler{
+		Datasource:   environment.Datasource,
+		EmailSender:  environment.EmailSender,
+		ShuttingDown: shuttingDown,
+	}
+}
+
+func buildRateLimitStore(storeType string, datasource database.Datasource) managers.RateLimitStore {
+	if storeType == "database" {
+		return &managers.DatabaseRateLimitStore{Datasource: datasource}
+	}
+	return &managers.MemoryRateLimitStore{}
+}
+
+func buildJobRunner(accountManager *managers.AccountManager, itemManager *managers.ItemManager, attachmentManager *managers.AttachmentManager, userManager *managers.UserManager, userSessionManager *managers.UserSessionManager, rateLimitStore managers.RateLimitStore, deletedRetention time.Duration) *jobs.Runner {
+	return jobs.BuildRunner(&jobs.Job{
+		Name:     "anonymize-closed-accounts",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			anonymized, err := accountManager.AnonymizeClosedAccounts(ctx, time.Now())
+			if anonymized > 0 {
+				logging.FromContext(ctx).Info("Anonymized closed accounts", logging.Fields{"count": anonymized})
+			}
+			return err
+		},
+	}, &jobs.Job{
+		Name:     "purge-deleted-records",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			before := time.Now().Add(-deletedRetention)
+			purgedItems, err := itemManager.PurgeDeletedItems(ctx, before)
+			if purgedItems > 0 {
+				logging.FromContext(ctx).Info("Purged deleted items", logging.Fields{"count": purgedItems})
+			}
+			if err != nil {
+				return err
+			}
+
+			purgedUsers, err := userManager.PurgeDeletedUsers(ctx, before)
+			if purgedUsers > 0 {
+				logging.FromContext(ctx).Info("Purged deleted accounts", logging.Fields{"count": purgedUsers})
+			}
+			if err != nil {
+				return err
+			}
+
+			purgedAttachments, err := attachmentManager.PurgeDetachedAttachments(ctx)
+			if purgedAttachments > 0 {
+				logging.FromContext(ctx).Info("Purged attachments of purged items", logging.Fields{"count": purgedAttachments})
+			}
+			return err
+		},
+	}, &jobs.Job{
+		Name:     "purge-expired-sessions",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			purged, err := userSessionManager.PurgeExpiredSessions(ctx, time.Now())
+			if purged > 0 {
+				logging.FromContext(ctx).Info("Purged expired sessions", logging.Fields{"count": purged})
+			}
+			return err
+		},
+	}, &jobs.Job{
+		Name:     "purge-rate-limit-hits",
+		Interval: 10 * time.Minute,
+		Run: func(ctx context.Context) error {
+			purged, err := rateLimitStore.PurgeHits(ctx, time.Now().Add(-managers.RATE_LIMIT_HIT_RETENTION).Unix())
+			if purged > 0 {
+				logging.FromContext(ctx).Debug("Purged rate limit hits", logging.Fields{"count": purged})
+			}
+			return err
+		},
+	})
+}
+func buildHomeServiceHandler(userSessionManager *managers.UserSessionManager) resources.HomeServiceHandler {
+	return resources.HomeServiceHandler{
+		UserSessionManager: userSessionManager,
+	}
+}
+
+func buildUserServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager, itemManager *managers.ItemManager, intakeManager *managers.IntakeManager, widgetManager *managers.WidgetManager, wishlistManager *managers.WishlistManager, apiTokenManager *managers.ApiTokenManager, accountManager *managers.AccountManager, environment *EnvironmentConfig) resources.UserServiceHandler {
+	return resources.UserServiceHandler{
+		UserSessionManager: userSessionManager,
+		UserManager:        userManager,
+		ItemManager:        itemManager,
+		ApiTokenManager:    apiTokenManager,
+		AccountManager:     accountManager,
+		IntakeManager:      intakeManager,
+		WidgetManager:      widgetManager,
+		WishlistManager:    wishlistManager,
+		UserRetriever:      &retrievers.ShelterRetriever{},
+		EmailSender:        environment.EmailSender,
+	}
+}
+
+func buildItemServiceHandler(userSessionManager *managers.UserSessionManager, itemManager *managers.ItemManager, attachmentManager *managers.AttachmentManager, deliveryManager *managers.DeliveryManager, intakeManager *managers.IntakeManager, apiTokenManager *managers.ApiTokenManager, environment *EnvironmentConfig) resources.ItemServiceHandler {
+	return resources.ItemServiceHandler{
+		UserSessionManager: userSessionManager,
+		ItemManager:        itemManager,
+		AttachmentManager:  attachmentManager,
+		DeliveryManager:    deliveryManager,
+		IntakeManager:      intakeManager,
+		ApiTokenManager:    apiTokenManager,
+		EmailSender:        environment.EmailSender,
+		ItemRetriever:      &retrievers.ItemRetriever{},
+	}
+}
+
+func buildAttachmentServiceHandler(userSessionManager *managers.UserSessionManager, itemManager *managers.ItemManager, attachmentManager *managers.AttachmentManager, apiTokenManager *managers.ApiTokenManager) resources.AttachmentServiceHandler {
+	return resources.AttachmentServiceHandler{
+		UserSessionManager: userSessionManager,
+		ApiTokenManager:    apiTokenManager,
+		ItemManager:        itemManager,
+		AttachmentManager:  attachmentManager,
+	}
+}
+
+func buildApiTokenServiceHandler(userSessionManager *managers.UserSessionManager, apiTokenManager *managers.ApiTokenManager) resources.ApiTokenServiceHandler {
+	return resources.ApiTokenServiceHandler{
+		UserSessionManager: userSessionManager,
+		ApiTokenManager:    apiTokenManager,
+	}
+}
+
+func buildFeedServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager, itemManager *managers.ItemManager, intakeManager *managers.IntakeManager, feedManager *managers.FeedManager) resources.FeedServiceHandler {
+	return resources.FeedServiceHandler{
+		UserSessionManager: userSessionManager,
+		UserManager:        userManager,
+		ItemManager:        itemManager,
+		IntakeManager:      intakeManager,
+		FeedManager:        feedManager,
+	}
+}
+
+func buildWidgetServiceHandler(userManager *managers.UserManager, itemManager *managers.ItemManager, widgetManager *managers.WidgetManager) resources.WidgetServiceHandler {
+	return resources.WidgetServiceHandler{
+		UserManager:   userManager,
+		ItemManager:   itemManager,
+		WidgetManager: widgetManager,
+	}
+}
+
+func buildWishlistServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager, itemManager *managers.ItemManager, wishlistManager *managers.WishlistManager, apiTokenManager *managers.ApiTokenManager) resources.WishlistServiceHandler {
+	return resources.WishlistServiceHandler{
+		UserSessionManager: userSessionManager,
+		ApiTokenManager:    apiTokenManager,
+		UserManager:        userManager,
+		ItemManager:        itemManager,
+		WishlistManager:    wishlistManager,
+		WishlistRetriever:  &retrievers.WishlistRetriever{},
+	}
+}
+
+func buildAuditServiceHandler(userSessionManager *managers.UserSessionManager, auditManager *managers.AuditManager) resources.AuditServiceHandler {
+	return resources.AuditServiceHandler{
+		UserSessionManager: userSessionManager,
+		AuditManager:       auditManager,
+		AuditRetriever:     &retrievers.AuditRetriever{},
+	}
+}
+
+func buildDeletedAccountServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager) resources.DeletedAccountServiceHandler {
+	return resources.DeletedAccountServiceHandler{
+		UserSessionManager: userSessionManager,
+		UserManager:        userManager,
+		UserRetriever:      &retrievers.ShelterRetriever{},
+	}
+}
+
+func buildSettingsServiceHandler(userSessionManager *managers.UserSessionManager, settingsManager *managers.SettingsManager) resources.SettingsServiceHandler {
+	return resources.SettingsServiceHandler{
+		UserSessionManager: userSessionManager,
+		SettingsManager:    settingsManager,
+	}
+}
+
+func buildTwoFactorServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager, twoFactorManager *managers.TwoFactorManager, accountManager *managers.AccountManager, loginLimiter *managers.LoginLimiter) resources.TwoFactorServiceHandler {
+	return resources.TwoFactorServiceHandler{
+		UserSessionManager: userSessionManager,
+		UserManager:        userManager,
+		TwoFactorManager:   twoFactorManager,
+		AccountManager:     accountManager,
+		LoginLimiter:       loginLimiter,
+		LoginRetriever:     &retrievers.LoginRetriever{},
+	}
+}
+
+func buildLoginServiceHandler(userSessionManager *managers.UserSessionManager, userManager *managers.UserManager, twoFactorManager *managers.TwoFactorManager, accountManager *managers.AccountManager, loginLimiter *managers.LoginLimiter, environment *EnvironmentConfig) resources.LoginServiceHandler {
+	return resources.LoginServiceHandler{
+		UserManager:        userManager,
+		UserSessionManager: userSessionManager,
+		TwoFactorManager:   twoFactorManager,
+		AccountManager:     accountManager,
+		LoginLimiter:       loginLimiter,
+		LoginRetriever:     &retrievers.LoginRetriever{},
+		EmailSender:        environment.EmailSender,
+	}
+}
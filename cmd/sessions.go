@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func runSessions(args []string) error {
+	return runSubcommand("sessions", args, map[string]func([]string) error{"purge": purgeSessions})
+}
+
+// purgeSessions signs out expired sessions, or every session of one user or of everyone.
+func purgeSessions(args []string) error {
+	flags := flag.NewFlagSet("neighbors sessions purge", flag.ContinueOnError)
+	userIdentifier := flags.String("user", "", "sign out every session of the user with this ID, email or username")
+	all := flags.Bool("all", false, "sign out every user")
+	cfg, err := loadCommandConfig(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if *all && *userIdentifier != "" {
+		return errors.New("Use either -all or -user")
+	}
+
+	ctx := commandContext()
+	datasource := buildDatasource(cfg)
+	userSessionManager := &managers.UserSessionManager{Datasource: datasource}
+	var purged int64
+	switch {
+	case *all:
+		purged, err = userSessionManager.DeleteAllUserSessions(ctx)
+	case *userIdentifier != "":
+		user, lookupErr := findUser(ctx, &managers.UserManager{Datasource: datasource}, *userIdentifier)
+		if lookupErr != nil {
+			return lookupErr
+		}
+		purged, err = userSessionManager.DeleteUserSessionsForUser(ctx, user.ID)
+	default:
+		purged, err = userSessionManager.PurgeExpiredSessions(ctx, time.Now())
+	}
+
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Purged %d sessions\n", purged)
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/resources"
+)
+
+var userTypes = map[string]managers.UserType{
+	"shelter":   managers.SHELTER,
+	"samaritan": managers.SAMARITAN,
+	"admin":     managers.ADMIN,
+}
+
+func runUser(args []string) error {
+	return runSubcommand("user", args, map[string]func([]string) error{
+		"create":         createUser,
+		"disable":        disableUser,
+		"reset-password": resetUserPassword,
+	})
+}
+
+// findUser looks a user up by ID, email or username.
+func findUser(ctx context.Context, userManager *managers.UserManager, identifier string) (*managers.User, error) {
+	userID, err := strconv.ParseInt(identifier, 10, 64)
+	if err != nil {
+		login, loginErr := userManager.GetPasswordForLogin(ctx, identifier)
+		if loginErr == sql.ErrNoRows {
+			return nil, fmt.Errorf("No user %q", identifier)
+		}
+
+		if loginErr != nil {
+			return nil, loginErr
+		}
+		userID = login.ID
+	}
+
+	user, err := userManager.GetUser(ctx, userID)
+	if _, notFound := err.(*managers.NotFoundError); notFound {
+		return nil, fmt.Errorf("No user %q", identifier)
+	}
+	return user, err
+}
+
+// userIdentifierArg reads the single user named after a command's flags.
+func userIdentifierArg(flags *flag.FlagSet) (string, error) {
+	if flags.NArg() != 1 {
+		return "", fmt.Errorf("Usage: %s [flags] <id|email|username>", flags.Name())
+	}
+	return flags.Arg(0), nil
+}
+
+// createUser adds an account the way registering would, but can also create administrators. The
+// password is generated and printed unless one is given.
+func createUser(args []string) error {
+	flags := flag.NewFlagSet("neighbors user create", flag.ContinueOnError)
+	userType := flags.String("type", "samaritan", "shelter, samaritan or admin")
+	name := flags.String("name", "", "display name")
+	username := flags.String("username", "", "username to log in with")
+	emailAddress := flags.String("email", "", "email address")
+	password := flags.String("password", "", "password, generated if empty")
+	street := flags.String("street", "", "shelter street address")
+	city := flags.String("city", "", "shelter city")
+	state := flags.String("state", "", "shelter state")
+	postalCode := flags.String("postalCode", "", "shelter postal code")
+	cfg, err := loadCommandConfig(flags, args)
+	if err != nil {
+		return err
+	}
+
+	user := &managers.User{
+		UserType: userTypes[strings.ToLower(*userType)],
+		Username: *username,
+		ContactInformation: &managers.ContactInformation{
+			Name:       *name,
+			Email:      *emailAddress,
+			Street:     *street,
+			City:       *city,
+			State:      *state,
+			PostalCode: *postalCode,
+		},
+	}
+
+	ctx := commandContext()
+	userManager := &managers.UserManager{Datasource: buildDatasource(cfg)}
+	if user.UserType == 0 {
+		return fmt.Errorf("Unknown user type %q, expected shelter, samaritan or admin", *userType)
+	}
+
+	generated := *password == ""
+	if generated {
+		*password = resources.GenerateResetPassword(resources.RESET_PASSWORD_LENGTH)
+	}
+
+	if err := userManager.ValidateForUserCreate(ctx, user, *password); err != nil {
+		return err
+	}
+
+	userID, err := userManager.WriteUser(ctx, user, *password)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created %s %d (%s)\n", strings.ToLower(*userType), userID, user.Username)
+	if generated {
+		fmt.Println("Password:", *password)
+	}
+	return nil
+}
+
+// disableUser deletes the account. An administrator can restore it from /admin/deleted until it
+// is purged.
+func disableUser(args []string) error {
+	flags := flag.NewFlagSet("neighbors user disable", flag.ContinueOnError)
+	cfg, err := loadCommandConfig(flags, args)
+	if err != nil {
+		return err
+	}
+
+	identifier, err := userIdentifierArg(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx := commandContext()
+	userManager := &managers.UserManager{Datasource: buildDatasource(cfg)}
+	user, err := findUser(ctx, userManager, identifier)
+	if err != nil {
+		return err
+	}
+
+	if _, err := userManager.DeleteUser(ctx, user.ID); err != nil {
+		return err
+	}
+	fmt.Printf("Disabled user %d (%s)\n", user.ID, user.Username)
+	return nil
+}
+
+// resetUserPassword sets a new temporary password, and prints it or emails it to the user.
+func resetUserPassword(args []string) error {
+	flags := flag.NewFlagSet("neighbors user reset-password", flag.ContinueOnError)
+	notify := flags.Bool("notify", false, "email the new password to the user instead of printing it")
+	cfg, err := loadCommandConfig(flags, args)
+	if err != nil {
+		return err
+	}
+
+	identifier, err := userIdentifierArg(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx := commandContext()
+	environment := buildEnvironment(cfg)
+	userManager := &managers.UserManager{Datasource: environment.Datasource}
+	user, err := findUser(ctx, userManager, identifier)
+	if err != nil {
+		return err
+	}
+
+	password := resources.GenerateResetPassword(resources.RESET_PASSWORD_LENGTH)
+	if err := userManager.UpdatePasswordForUser(ctx, user.Email, password); err != nil {
+		return err
+	}
+
+	if *notify {
+		if err := environment.EmailSender.DeliverPasswordResetEmail(ctx, user, password); err != nil {
+			return err
+		}
+		fmt.Printf("Emailed a new password to %s\n", user.Email)
+		return nil
+	}
+	fmt.Println("Password:", password)
+	return nil
+}
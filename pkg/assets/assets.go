@@ -1,22 +1,34 @@
 // Package assets Code generated by go-bindata. (@generated) DO NOT EDIT.
 // sources:
-// assets/scripts/neighbors_db.sql
+// assets/templates/admin/audit.html
+// assets/templates/admin/deletedUsers.html
+// assets/templates/admin/settings.html
 // assets/templates/home/error.html
 // assets/templates/home/index.html
 // assets/templates/home/layout.html
 // assets/templates/home/unauthorized.html
 // assets/templates/items/edit.html
+// assets/templates/items/import.html
 // assets/templates/items/item.html
 // assets/templates/items/items.html
 // assets/templates/items/new.html
+// assets/templates/items/receive.html
 // assets/templates/login/login.html
 // assets/templates/login/reset.html
+// assets/templates/login/twoFactor.html
+// assets/templates/users/apiTokens.html
 // assets/templates/users/edit.html
+// assets/templates/users/intake.html
 // assets/templates/users/new.html
 // assets/templates/users/samaritanSummary.html
 // assets/templates/users/shelterSummary.html
 // assets/templates/users/user.html
 // assets/templates/users/users.html
+// assets/templates/users/widget.html
+// assets/templates/widget/shelter.html
+// assets/templates/wishlists/edit.html
+// assets/templates/wishlists/wishlist.html
+// assets/widget/widget.js
 package assets
 
 import (
@@ -93,27 +105,67 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsScriptsNeighbors_dbSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\x41\x4f\xe3\x30\x10\x85\xef\xfd\x15\x23\x4e\x89\xc4\xa1\x45\xda\x13\x27\xe3\x4c\x8b\x45\xea\x80\xed\xa0\xed\x09\x59\xea\x88\xb5\xd4\x24\xab\xd8\xd5\x2e\xfb\xeb\x57\x4e\x4b\x1b\x02\x4d\xd9\xe5\x58\xbd\xd7\x79\xce\xf7\xc6\xe6\x0a\x99\x41\x30\xec\x26\x47\x10\x73\x90\x85\x01\xfc\x2e\xb4\xd1\xb0\xf5\xd4\x9a\x97\x9f\xe4\x21\x99\x00\x00\x88\x0c\x84\x34\xb8\x40\x05\xf7\x4a\x2c\x99\x5a\xc1\x1d\xae\x2e\x3b\x2d\xfa\xa4\xad\x08\x1e\x99\xe2\xb7\x4c\x25\x57\xd3\xb4\x9b\x25\xcb\x3c\x9f\xa4\xd7\x93\xc9\x99\xa0\xf1\x10\x60\xa5\x29\x84\xe4\x0a\x97\x28\xcd\x2e\xf2\x4d\xdc\x6c\xda\xcb\xdb\xe9\x58\x59\xb7\x19\x33\xdc\x5b\xef\x7f\x35\xed\x7a\xcc\xc3\x5d\x78\x19\xe8\xc7\xff\x37\x3e\xd8\x0d\x6f\xd6\xc3\x63\x1c\x1c\x3a\xd8\x30\x22\xb6\x44\xe1\x94\x5a\xee\xe1\x83\x11\x72\x25\xa4\x39\x9c\x0b\x32\x9c\xb3\x32\x37\x30\xdb\x9f\xb0\x90\xda\x28\x16\x2d\x6e\xfd\xfb\xa9\x63\xf9\x44\xdd\xb7\x97\x52\x3c\x94\x08\x49\x47\x22\xdd\xd9\xe7\x85\x42\xb1\x90\x11\x6a\xf2\x9a\x91\x82\xc2\x39\x2a\x94\x1c\x7b\xad\x27\x22\x4b\xa1\x90\x90\x61\x8e\x06\x81\x33\xcd\x59\x86\xe7\xba\x74\x81\xaa\x7f\xef\x92\xdb\x40\xcf\x4d\x3b\x44\xfd\xa6\x8a\x05\xd5\x6b\x6a\xc7\x1c\x0f\x5b\x5b\x87\x58\xd8\x90\xd9\x1e\xb8\xfb\x33\xba\x30\xb1\xad\xad\x1f\x75\xfc\xa0\x4d\xa0\xb6\xf7\x5d\x03\xdd\x56\xb6\x75\xc1\xd6\x7d\xc7\x41\xed\xa3\x3f\x4c\x7a\xc7\xfe\x04\xf7\x0f\x46\x1c\xc3\x3e\x3b\xe4\x33\x17\x51\x93\xf7\xae\xa9\x5f\x3b\xdc\xff\xbc\xa3\x63\x37\xdf\xa6\xe9\xfb\x07\x20\xee\xd2\x49\x2e\x27\x97\x79\x27\xe7\xcd\xb3\xab\x8d\xab\x08\x6e\xc4\xe2\x03\xd9\xfa\xa0\x89\x46\x1c\xc3\xa5\xfe\x0a\xd6\xff\xbe\x14\x42\x6a\x54\x26\x12\x28\x7a\x4f\xe7\x23\xcb\x4b\xd4\x90\xcc\x2e\xe1\x42\xdf\x62\x6e\x50\x5d\xa4\xd7\x67\xcc\x57\xd1\xcc\x96\x4c\x09\xc3\x64\xb4\xff\x0d\x00\x00\xff\xff\x8e\x4d\x75\xba\xa6\x05\x00\x00")
+var _assetsTemplatesAdminAuditHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xdf\x6f\xdb\x36\x10\x7e\xf7\x5f\x41\x10\xd8\xb0\x01\xb3\x84\x76\x0f\x7b\xa1\x39\x64\x6d\x5a\x18\x28\xba\x60\x4e\xf6\x4e\x8b\x67\x9b\xa8\x44\xaa\xe4\x29\x8d\x21\xe8\x7f\x1f\xf8\x43\xb6\x14\x59\x8d\x83\x35\x36\x62\x91\x77\xf7\x7d\xf7\x1d\xc9\xa3\xdd\xb6\x12\x76\x4a\x03\xa1\x95\x50\x7a\x59\x18\x8d\xa0\x91\x76\xdd\x82\x1d\xde\xf0\x9b\x46\x2a\x24\x9f\xcc\x9e\xe5\x87\x37\x7c\xc1\x76\xc6\x56\xa4\x28\x85\x73\x2b\xea\x9f\x97\x4a\x97\x3e\xb8\xda\x2e\x7f\xa7\xa4\x02\x3c\x18\xb9\xa2\x1f\x6f\xef\x29\x11\x05\x2a\xa3\x57\x34\x17\xb2\x52\x3a\x17\x1e\x29\xa7\x7c\x41\x08\x21\xac\x14\x5b\x28\x7b\x20\x67\x97\x46\x97\x47\x4a\x76\xc6\xae\x68\x70\xbc\x87\x27\xa4\x7c\x03\xc2\x16\x07\x96\x07\xef\x14\xa9\x74\xdd\x20\xc1\x63\x0d\x2b\x8a\xde\x6b\x94\x8e\x4f\xdf\x9a\x92\x54\x76\xf9\x96\x54\xdb\xe5\x5b\x4a\x94\x1c\x62\x12\x2d\x2a\x58\xd1\xaf\x94\xd4\xa5\x28\xe0\x60\x4a\x09\x76\x45\x23\x13\x25\x8f\xa2\x6c\x60\x45\xdb\x36\xfb\xa0\x4a\x04\xeb\xb2\x8f\x80\x84\x7e\xa5\x5d\x77\x6d\xea\x37\x05\x1a\x4b\x79\xf8\x20\xeb\xf7\xf3\xd9\xeb\xa6\xda\x82\xbd\x3e\xff\x80\xd8\x0b\x10\x71\x30\x12\xd1\x53\xce\xca\x88\x41\xd7\x4b\xb9\xd5\xa8\xf0\x48\x79\xfc\x1c\x2b\x71\x50\x42\x81\x57\x27\x9f\xa0\x52\xf6\x90\x80\x03\x94\x7f\x33\x53\xfb\xdd\xd2\x27\x4e\xf9\x8d\x3e\x12\x48\xb4\xd1\x36\xeb\xac\x10\x2a\x4a\xda\x56\xed\x08\x7c\x25\xbf\x8c\x25\x27\xa6\x5f\x49\x74\xeb\xba\x98\x37\xc8\xb6\x05\x2d\xbb\x8e\xaf\x11\xaa\x17\x29\x1a\x07\xf6\x0a\x8a\xe0\x36\xa5\x78\x70\x60\x5f\xa4\x10\xb5\xba\x37\x5f\x40\x5f\x41\x73\x72\x9d\x52\xdd\xdc\xad\x09\x7a\xdb\x8b\x7c\x0e\x10\x95\xde\xbb\x2b\xf8\x4e\xae\x53\xbe\x8d\x42\x20\xbd\x7d\xcc\xc9\xf2\xe8\xfc\xaa\xbd\xb6\x7e\xdf\xef\xb6\x1f\x7a\x74\x4e\xe0\xa3\xfd\xb7\x96\xcf\x0e\xd0\x89\x79\xf6\x04\x9d\x02\x5f\xd5\x0f\x94\xd1\xa1\x21\x28\xa3\x7f\x54\x33\x4b\xa8\x49\x8f\x48\xa3\xe7\xed\x40\x19\xfd\x1b\x81\x6c\x9f\x11\xbf\xfb\xb3\xa6\x96\x02\xe1\x7b\xed\xc1\x67\x3a\x23\x6d\x90\xc5\x59\xdc\x46\xe9\x02\x28\xff\x60\x4d\x35\xaf\x2c\x92\x5e\xab\x2c\x42\x26\x61\x2e\x0e\x66\x12\x8e\xd6\xd7\xe4\xfb\xa0\x51\x95\x94\xdf\x9b\x1f\x95\x6d\x04\x4c\xd9\x36\x71\x30\x93\x6d\xb4\x9e\xb3\xdd\x36\x88\x46\x27\x56\xd7\x6c\x2b\x75\x5e\xff\x2d\x6a\xb2\x45\xbd\xac\xad\xaa\x84\x3d\x0e\xa8\x4f\x97\x62\x0c\x4f\x58\xe2\x79\xa0\x69\xd0\x5f\xcc\x4b\x07\x85\xd1\x32\x40\x84\xc4\x0f\x16\x76\xe1\x7e\xbb\x7d\xaa\x8d\xc5\x87\x7f\x3e\xf9\x84\xe2\x80\xbc\xdb\xfc\xcb\x72\xc1\x17\x2c\xf7\x27\x8a\x2f\x18\x8a\x6d\x09\x3d\x74\x1c\x84\xff\x4b\x87\x56\xd5\x20\xfb\x51\xd5\x6b\xc2\x03\x08\x79\x0a\xf0\x83\xa5\x14\xf6\x4b\x32\x27\x17\x7e\xaf\x2a\x60\x39\x1e\xc6\xb3\xe1\xfa\x9a\x4e\x6f\xc0\xb9\x70\x70\x9e\x1b\xd6\x77\xe4\x46\x4a\x0b\xce\x4d\x6d\xfd\x61\x7b\x3e\xdf\xdf\x64\xcf\xe7\xdf\x1d\x84\xde\xc3\x00\xc8\x3f\x81\x90\xbd\xac\xad\x91\xc7\x73\x44\xdb\x5a\xef\x4e\xb2\xdb\x47\xd0\xe8\xba\x6e\x80\x65\xcf\x6e\xfe\xc5\x50\xf2\xb6\xf5\xe5\x14\xf8\xa0\xd5\x93\x97\x9e\xe2\xfc\x63\xd7\xb1\x1c\xe5\xa5\x10\xb5\x23\x59\xa8\xc8\xfa\x7d\xd7\x31\x91\x16\x6e\xf4\x65\xea\xcf\x70\x9f\xaf\xda\xf6\xec\x48\xf9\x70\xe4\x17\xb3\x6d\xa1\x74\xd0\x75\x9b\xa3\x43\xa8\x52\xdf\xbe\x4c\xca\x0a\x23\x81\xa0\xc2\x32\xee\xde\x54\xf9\x88\x5a\x5b\xa5\x71\x47\xe8\x4f\xd9\x9b\xb7\x8e\x92\xb3\x91\xe5\x3e\x8c\xcf\xe9\xc8\xd6\x77\x69\x95\xe6\xb5\xfa\x8c\x13\xd6\x25\x87\xd1\x84\x7f\x87\x1b\x2b\xeb\x9b\x7a\xd7\x4d\x1c\x66\xea\x15\xbb\xb7\x2f\x58\x8c\xed\xba\x9f\xfb\x86\x7e\x9e\x3c\x95\xb1\xf7\x21\x23\x93\xaf\xe9\x85\x84\x62\x91\x2f\x18\x52\xe8\x45\x5b\x58\x8b\xd1\xfc\x6b\x0a\x60\x2c\xc9\xfe\x82\x9d\xb1\xb0\x41\x81\x40\xb2\x9b\x1d\x82\x0d\xcf\x17\xd8\x98\x04\x14\xaa\x74\x53\x30\xff\x62\xae\xa9\x7c\xa3\xe1\x9b\x83\xf9\xc6\xf2\x7e\x74\xd1\x37\x56\x7f\xc0\xdc\x75\xcc\xa1\x35\x7a\xcf\xe3\x24\xcb\xd3\x90\xd5\x16\xfc\x86\x1c\xfb\xe6\x71\x76\x2a\xbe\xff\x4b\x9b\x7f\xa0\xa6\xc7\x0f\x0a\x27\xf0\x43\xdd\xdf\x47\x67\xf9\x6c\x15\x5e\x5e\x0d\x96\x0f\x0f\xf7\x64\xc9\x2f\x1d\x7d\x52\x98\xd2\xd5\x42\xaf\xe8\x1f\x94\x7f\x36\x04\x42\xbf\x20\x95\xc0\xe2\x40\xf0\xa0\x1c\x71\xa1\x9b\x67\x2f\x30\x9d\x32\x63\x79\x6a\x45\x2c\x0f\xad\x97\x2f\x98\x16\x8f\xa9\x4b\x35\xa7\xbb\xaf\x16\x7b\xa5\x85\x6f\x81\x83\xce\x1b\xcb\x7a\x67\xe1\x51\x99\xc6\x85\xce\x7f\xb2\xb1\x52\x0d\x62\x61\xe9\xbf\x2e\x50\x7e\xbe\x56\xc2\x64\xa9\xf4\x97\xc1\x0d\x32\x42\xa2\xfc\x33\x7c\xf3\x5f\x73\x05\x67\x79\xa9\x2e\x67\x3f\xc7\x44\xa4\x72\x5e\x8d\xa4\x9c\xf9\x72\x4d\x59\xf9\x9d\xd8\x83\x3f\x4d\xfe\xd3\xaf\xb2\x77\x9b\x30\x79\x7d\x9f\xe1\x09\xff\xbf\xb6\x13\x0a\xe5\x7f\x97\xf2\x25\x5d\x2c\x6f\x4a\x7f\x6b\x86\xa5\xe8\x0d\x8b\xf3\x2f\x6b\x57\x58\x55\xe3\xf0\xb7\x75\xdb\x82\x96\x5d\xb7\xf8\x6f\x00\xde\xee\x4a\x53\x7e\x0f\x00\x00")
 
-func assetsScriptsNeighbors_dbSqlBytes() ([]byte, error) {
+func assetsTemplatesAdminAuditHtmlBytes() ([]byte, error) {
 	return bindataRead(
-		_assetsScriptsNeighbors_dbSql,
-		"assets/scripts/neighbors_db.sql",
+		_assetsTemplatesAdminAuditHtml,
+		"assets/templates/admin/audit.html",
 	)
 }
 
-func assetsScriptsNeighbors_dbSql() (*asset, error) {
-	bytes, err := assetsScriptsNeighbors_dbSqlBytes()
+func assetsTemplatesAdminAuditHtml() (*asset, error) {
+	bytes, err := assetsTemplatesAdminAuditHtmlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/scripts/neighbors_db.sql", size: 1446, mode: os.FileMode(511), modTime: time.Unix(1604104044, 0)}
+	info := bindataFileInfo{name: "assets/templates/admin/audit.html", size: 3966, mode: os.FileMode(420), modTime: time.Unix(1792437082, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesHomeErrorHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x50\x4d\x6b\xe3\x50\x0c\xbc\x1b\xfc\x1f\xb4\xef\x1c\x27\xbb\xb7\x85\xfa\x19\x4a\xda\x42\x2e\x6d\x0f\x09\xb4\x47\xd9\x1e\x6c\x11\xbf\x8f\x3e\x2b\x0e\xfd\xf7\xc5\x6e\x1a\x9a\x93\xa4\xd1\xcc\x30\x52\xf9\xe7\xe1\x65\xbb\x7f\x7f\x7d\xa4\x5e\xdd\x50\xe5\x59\x39\x57\x1a\xd8\x77\xd6\xc0\x9b\x2a\xcf\x66\x0c\xdc\x56\x79\x46\x44\x54\x3a\x28\x53\xd3\x73\x1a\xa1\xd6\x1c\xf6\x4f\xc5\x7f\x73\xb3\xf3\xec\x60\xcd\x24\x38\xc7\x90\xd4\x50\x13\xbc\xc2\xab\x35\x67\x69\xb5\xb7\x2d\x26\x69\x50\x2c\xc3\x8a\xc4\x8b\x0a\x0f\xc5\xd8\xf0\x00\xfb\x6f\xfd\xf7\xd6\xab\x57\x8d\x05\x3e\x4e\x32\x59\xf3\x56\x1c\xee\x8b\x6d\x70\x91\x55\xea\x01\xbf\x8c\x05\x16\x6d\x87\xab\x54\x45\x07\x54\xcf\x90\xae\xaf\x43\x1a\xcb\xcd\x37\x90\x67\xe5\xe6\x72\x49\x9e\x95\x75\x68\x3f\x7f\x04\xb1\xda\x29\x71\x8c\xe0\x34\xd2\x19\xa4\x3d\x48\xc1\x8e\x58\xe9\x6a\x43\x3d\x8f\x84\x94\xd0\xde\xd1\xce\x2f\x1c\x07\xf6\xa4\xe2\xb0\xa2\x66\x90\xe6\x48\x35\x37\x47\xd2\x40\x09\x7a\x4a\x7e\xee\x66\x5a\x4c\x98\x24\x9c\x46\x8a\xdc\x61\x5d\x6e\xe2\x12\xe5\x12\x60\x49\x35\xff\xfe\x2b\x00\x00\xff\xff\xa7\xdb\xcf\x3c\x8b\x01\x00\x00")
+var _assetsTemplatesAdminDeletedusersHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x41\x6f\xeb\x36\x0c\xbe\xfb\x57\x70\x3a\xec\xa5\x78\x2f\x36\xde\x65\x97\xca\x1e\x1e\x90\x01\x0b\xb0\x75\x43\xd7\x02\xdb\x91\xb1\x98\x58\xab\x4d\x39\x12\x9d\xd6\x33\xfc\xdf\x07\xc7\x76\x92\x36\xeb\x0a\x01\x09\xc5\x8f\x22\xa9\x4f\x1f\xdd\x75\x86\xb6\x96\x09\x54\x85\x96\x97\xb9\x63\x21\x16\xd5\xf7\x91\x2e\xbe\x66\x2b\x2a\x49\xc8\xc0\xb7\x3c\x77\x0d\x4b\xd0\x49\xf1\x35\x8b\x74\x7d\x02\x70\x02\x20\x47\x86\x0d\x81\xa7\x20\xce\x93\x81\x86\xc5\x96\x20\x05\xb5\x9f\x3c\x41\x4d\xbe\x42\x26\x96\xb2\x05\x4f\x95\x3b\x90\x89\xe1\xfe\x14\x1b\xc8\x07\x28\xf0\x40\x20\x0e\x4a\xb7\x03\xcb\x80\x3b\xb4\x1c\xeb\xa4\xce\x22\x2d\xb8\x29\x09\xf2\x12\x43\x48\xd5\xb8\x39\xfe\x2e\x83\x78\x5b\x93\x99\x77\x95\xca\x22\x00\x00\x2d\x05\xa1\x39\x1d\x18\x36\x4b\x83\xfe\x69\x82\xa7\x90\x6c\xbd\xd2\x89\x14\xaf\x7d\x77\x58\xd1\xb5\xf7\x31\x90\xe7\xff\x44\x7e\xaa\xd0\x96\xd7\xee\x89\x9f\x6b\xe0\xec\x19\x2c\x42\x33\x77\xbc\x71\xa6\x3d\x87\x76\x9d\x47\xde\x11\xc4\x43\xe5\xd0\xf7\x17\x39\xfc\x39\x6a\x58\x5a\x4c\xa6\x11\x0a\x4f\xdb\x54\x25\x68\x2a\xcb\x09\x36\xc6\x4a\xf2\x23\xb1\x58\x69\xd3\x26\x90\xff\x7e\xb4\xd7\x26\xed\xba\x78\xbd\xea\x7b\x95\x4d\x86\x4e\x30\xd3\x89\x98\xeb\xac\x5d\x17\x0f\x6c\xf4\xfd\xbb\xf0\x4c\xcb\xff\x84\x1c\xf9\x79\x1f\xdf\x3a\x5f\xa1\x3c\xb2\x7d\x79\xb0\x15\x41\x3c\xf1\xf6\x60\xdf\x4f\xaa\x37\x8d\x88\x63\x70\x9c\x97\x36\x7f\x4a\xd5\xa4\xb9\x49\xa3\x8b\xe9\x5e\x37\x6a\x7e\xff\x8d\x30\x6c\x84\x97\xa1\x3a\xfe\xb9\x46\x4a\xcb\xb4\xac\xbd\xad\xd0\xb7\x2a\x9b\x74\xa8\x93\x31\xf1\x1b\x36\x74\x72\xc9\x78\xd7\x51\x19\xe8\x83\xf7\x80\xdc\x95\xa1\x46\x4e\xd5\x0f\x2a\xbb\x73\xe7\x21\x39\x4a\x7c\x43\xc4\x60\xc6\x7b\xc6\x1f\xd4\x62\x33\x95\xd2\xc9\x24\x10\x9d\x1c\xb5\x9e\x45\x33\x1a\x9d\x27\x38\xe4\xde\xd6\xf2\x6a\x86\x47\x17\x48\x5b\x53\xaa\x84\x5e\x24\xf9\x1b\x0f\x38\x7a\xa7\x69\x38\xa0\x87\xd7\x14\x42\x0a\xdb\x86\x73\xb1\x8e\x61\x31\xa8\x67\xbd\xba\x81\xee\xd4\xd7\x78\x60\x0f\x29\x30\x3d\xc3\x9f\xbf\xfe\xf2\xb3\x48\x7d\x4f\xfb\x86\x82\x2c\x6e\x6e\x4f\x71\x9e\xf6\xb1\xab\x89\x17\xea\xf7\xdf\xfe\x78\x50\x5f\xe0\xd9\xb2\x71\xcf\x71\xe9\x72\x1c\x72\xc7\xce\xdb\x9d\x65\xf8\x0c\xb3\x6e\x27\x56\x12\x05\x9f\x61\xac\x7b\x04\xa7\xee\xd4\xdb\xdc\xec\x09\x4d\x1b\x04\x85\xf2\xe2\x38\x2e\x97\x8d\x5f\xb6\x3c\x2c\x4f\xd2\x78\x86\x02\xd9\x94\xf4\x2d\xb4\x9c\xdf\x53\xa8\x1d\x07\x5a\x78\xda\x5f\x75\xf7\x05\xd4\x5f\xae\x01\xf4\xc4\x9f\x04\xb0\x91\xc2\x79\xfb\xcf\xf0\xad\x71\x33\x5d\xa7\x87\xfd\xee\xb2\xb5\xfe\x36\x3a\xd9\x03\x05\x81\xd8\xcc\xb4\xf4\xb7\x91\x4e\x46\xf6\xb3\xa8\xeb\x88\x4d\xdf\x47\xff\x0e\x00\xc2\x70\xba\x39\x83\x05\x00\x00")
+
+func assetsTemplatesAdminDeletedusersHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesAdminDeletedusersHtml,
+		"assets/templates/admin/deletedUsers.html",
+	)
+}
+
+func assetsTemplatesAdminDeletedusersHtml() (*asset, error) {
+	bytes, err := assetsTemplatesAdminDeletedusersHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/admin/deletedUsers.html", size: 1411, mode: os.FileMode(420), modTime: time.Unix(1792429550, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesAdminSettingsHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x4d\x8f\xda\x30\x10\xbd\xe7\x57\x4c\x7d\x21\xa8\x90\x68\xaf\xdd\x24\x52\x2b\x75\xd5\xad\xfa\xa5\x65\x2b\xb5\x47\x13\x0f\xc4\x25\x19\x07\x7b\x02\x4b\xa3\xfc\xf7\xca\x49\x60\x61\x5b\x54\x29\x42\xc3\xf8\x79\xe6\x8d\xdf\xb3\xdb\x56\xe1\x4a\x13\x82\xa8\xa4\xa6\x79\x6e\x88\x91\x58\x74\x5d\x90\x14\x37\xd9\x42\x33\xc2\x02\x99\x35\xad\x5d\x12\x17\x37\x59\x90\xac\x8c\xad\x40\xab\x54\xb8\x31\x7f\x67\x6c\x25\xb2\x00\x00\x20\x51\x7a\x07\x79\x29\x9d\x4b\x85\xc7\xcd\xd7\xd6\x34\x35\xf4\x61\x5e\x60\xbe\x19\x71\xfe\x4b\x34\xd5\x0d\x03\x1f\x6a\x4c\x45\xbf\xb8\x34\x4f\xe2\x62\x77\x9f\x9d\xf7\x38\x01\x24\x2b\x4c\x85\xc5\x6d\xa3\x2d\x2e\x0a\x2c\x19\xed\xe3\xde\xdc\xc9\x9c\x8d\x15\x17\x8c\x1e\xae\x81\xda\x56\xaf\x20\xf2\x53\x1d\x87\x8a\xae\x60\xbb\xae\x6f\x8e\xaa\x6d\x91\x54\xd7\x9d\xf1\x2e\xe5\x12\xcb\x7f\xf0\xec\xf3\xc2\x0f\xfb\x7f\x26\xd9\xb8\x00\xbc\x37\xf3\x55\x9f\x03\xd9\x70\x81\xc4\x3a\x97\xac\x0d\xf9\x3a\xe0\x86\x7d\x2e\x89\xfb\xe2\x67\x24\x5c\x25\xcb\x4b\x12\x8c\x4f\x0c\xfe\x67\x5e\x35\x8c\x4a\x64\x63\x53\x07\x5c\x48\x86\x42\xee\x90\x26\x0c\x0e\x19\x34\x43\x53\x43\x2e\x09\x1c\xeb\xb2\x04\xa7\xd7\x04\x9a\x40\x92\x82\xd2\x98\x0d\x48\x6b\x1a\x52\x33\x58\x36\xec\x61\x13\x86\x4a\x6e\x10\xf2\x42\xd2\x1a\x1d\x34\xc4\xba\x04\x2e\xf0\x00\xca\x44\x49\xdc\x93\x19\x1d\x10\x2b\xbd\x1b\xc3\x65\xc3\x6c\x68\x54\x78\xf8\x73\xd2\x77\xc9\x04\x4b\xa6\x79\x6d\x75\x25\xed\x41\x80\xa1\xbc\xd4\xf9\x26\x15\x4e\xee\xf0\x5c\xa1\x70\x2a\xb2\x85\xdc\x9d\xfb\x70\xa8\x95\x05\x49\xec\x8f\x3f\x0b\x46\x8d\x82\xe0\xd9\xcd\x2e\xb7\xba\xe6\x0b\x3f\x0f\xa9\x91\x8e\x3f\xa8\xf8\x97\xdc\xc9\x21\x3b\xfa\x72\x27\x2d\xbc\xec\x0f\x29\xac\x1a\xca\x7b\x4d\xc2\x29\xb4\x27\x0d\x3c\xd8\xf7\x7f\x5f\x62\x85\xc4\x0e\x52\x50\x26\x6f\x7c\x1c\xad\x91\xc7\xf4\xbb\xc3\xbd\x0a\x27\x47\x3f\xf8\xbb\x32\x99\x46\x38\x6e\xb9\xbd\x28\x66\x71\x0b\x29\x10\xee\xe1\xc7\xe7\x4f\x1f\x98\x6b\x6f\x12\x74\x1c\x4e\x9f\x71\x16\xb7\x91\xa9\x91\x42\xf1\xed\xfb\xa3\x98\xc1\x5e\x93\x32\xfb\xa8\x34\x83\x6b\x22\x63\xf5\x5a\x13\xbc\x06\x11\x4b\x55\x69\x8a\x8f\x9d\x63\xf1\xb2\x0a\x59\x94\xea\xe0\x58\x32\x0e\xc2\x5e\x9d\xd4\x7f\x16\xb9\xb1\x04\x85\x24\x55\xe2\x5b\x77\xa0\xfc\x01\x5d\x6d\xc8\x61\x68\x71\xfb\x17\x91\x19\x88\x9f\xa6\x01\x69\x7b\xd3\x79\x6b\x1b\xab\x7f\xa3\x02\x36\xa3\x8d\xc0\xf9\xc7\xe5\x48\xef\xd5\x39\xbd\xee\x36\x38\xc5\x7e\x60\x87\xa4\xc2\x8f\x8b\xaf\x5f\x22\xc7\x56\xd3\x5a\xaf\x0e\xe1\x25\xbb\x2b\xf7\xec\xcd\x85\x40\x91\x7f\x42\xd4\x3d\x63\x15\x4e\xae\xbc\x23\x93\x69\x34\xde\xfb\xd9\xa9\x7e\x37\x1d\xa9\x75\xb7\x41\x12\x0f\x7e\xc9\x82\xb6\x45\x52\x5d\x17\xfc\x19\x00\x2e\xc6\x8a\x39\x41\x05\x00\x00")
+
+func assetsTemplatesAdminSettingsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesAdminSettingsHtml,
+		"assets/templates/admin/settings.html",
+	)
+}
+
+func assetsTemplatesAdminSettingsHtml() (*asset, error) {
+	bytes, err := assetsTemplatesAdminSettingsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/admin/settings.html", size: 1345, mode: os.FileMode(420), modTime: time.Unix(1792437082, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesHomeErrorHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8f\x41\x8f\xd3\x30\x10\x85\xef\x91\xf2\x1f\x06\x9f\x37\x09\xbd\x21\x61\x47\x42\xbb\x20\xed\x05\x90\xe8\x4a\x70\x9c\x26\x43\x3d\x5a\xdb\x31\xf6\x34\x15\x8a\xf2\xdf\x91\x4b\x88\xe8\xc9\xf6\xcc\x7b\x9f\xdf\xd3\x6f\x9e\xbe\x3c\x1e\x7f\x7c\xfd\x08\x56\xbc\xeb\xeb\x4a\x97\x13\x1c\x86\xb3\x51\x14\x54\x5f\x57\x65\x46\x38\xf6\x75\x05\x00\xa0\x3d\x09\xc2\x60\x31\x65\x12\xa3\x5e\x8e\x9f\x9a\x77\xea\x6e\x17\xd0\x93\x51\x33\xd3\x35\x4e\x49\x14\x0c\x53\x10\x0a\x62\xd4\x95\x47\xb1\x66\xa4\x99\x07\x6a\x6e\x8f\x07\xe0\xc0\xc2\xe8\x9a\x3c\xa0\x23\x73\x68\xdf\xde\xb3\xac\x48\x6c\xe8\xd7\x85\x67\xa3\xbe\x37\x2f\x1f\x9a\xc7\xc9\x47\x14\x3e\x39\xfa\x0f\xcc\x64\x68\x3c\xd3\x6e\x15\x16\x47\xfd\x67\xe2\xb3\x3d\x4d\x29\xeb\xee\xef\xa0\xae\x74\xb7\x35\xa9\x2b\x7d\x9a\xc6\xdf\x9b\x61\x59\xf8\x27\xb4\xeb\xba\xd9\xed\xa1\x5f\x96\xf6\x9b\xa0\x5c\xf2\xba\xc2\xb2\xb4\xc7\xe2\x5f\x57\xdd\xd9\xc3\xbf\x3f\x62\xd1\x3c\x91\x20\xbb\xb2\x88\x3b\x8a\x5c\xa6\x1d\x15\xfb\x67\x01\x8c\x91\x30\x65\xb8\x12\x88\x25\x10\x42\x0f\x28\xb0\xe7\x03\x8b\x19\x28\x25\x1a\xdf\xc3\x73\xb8\x69\x3c\x61\x00\x61\x4f\x0f\x30\x38\x1e\x5e\xe1\x84\xc3\x2b\xc8\x04\x89\xe4\x92\x42\xb9\x15\x59\x4c\x34\xf3\x74\xc9\x10\xf1\x4c\xed\x5d\x8a\x30\x96\x10\xba\xdb\x6a\xd6\x95\xee\xac\x78\xd7\xff\x19\x00\xdd\xe2\x59\xa8\xf1\x01\x00\x00")
 
 func assetsTemplatesHomeErrorHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -128,12 +180,12 @@ func assetsTemplatesHomeErrorHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/home/error.html", size: 395, mode: os.FileMode(511), modTime: time.Unix(1581215359, 0)}
+	info := bindataFileInfo{name: "assets/templates/home/error.html", size: 497, mode: os.FileMode(420), modTime: time.Unix(1792431161, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesHomeIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd1\xc1\x4a\xc3\x40\x10\xc6\xf1\x7b\x20\xef\x30\xee\xbd\x2c\x85\x9e\x24\xcd\x55\x0a\xe2\x41\x45\xf1\xb8\xc9\x4e\x93\x81\x64\xa6\xec\x4c\x5b\x4b\xe8\xbb\xcb\x52\xb5\x62\x4b\x2f\xcb\x1e\xbe\xf9\x5d\xfe\xd3\x14\x71\x4d\x8c\xe0\xc6\x40\x3c\x6b\x85\x0d\xd9\xdc\xf1\x58\x16\x55\x93\xea\xb2\xa8\xfa\x39\xa8\x1d\x06\x5c\x3a\xc3\x4f\x9b\x85\x81\x3a\xbe\x87\x16\xd9\x30\xb9\xfa\x1d\x87\x56\x46\x04\x13\x78\x42\xea\xfa\x46\x92\xde\x55\xbe\x9f\xd7\x67\x60\x71\x0b\x78\x40\x83\x17\x0b\xc9\x30\xc2\x8a\xe1\x43\xb6\xe9\x57\xea\x45\x22\xbc\x4a\x0c\x87\x4c\x2e\xce\xe4\xe9\x8d\xb4\xbb\x25\x97\x05\x00\x40\x15\xa0\x4f\xb8\x5e\x3a\x4f\x86\xa3\x7a\x07\xed\x10\x54\x97\xae\x31\x86\xc6\x78\xb6\x49\x34\x86\x74\x70\x90\x24\x4b\xcd\xd6\x4c\xd8\xd5\x6f\x84\x7b\x58\xe5\x93\xca\x87\x0b\x4b\x51\x95\x84\xfd\x20\x1d\xf1\xa5\xa9\xd8\x0a\xc7\x2b\xea\x63\xde\x5f\x05\x7b\x1c\x0c\x93\x7a\xc6\xfd\x05\x47\xbc\x96\xff\xd2\x33\x76\xa4\x86\xe9\x84\x55\x3e\xd2\xae\x2e\x8b\x69\x42\x8e\x39\x5e\xfe\xfe\x94\xd5\x36\xd1\xc6\xfe\xb4\xfd\x5e\x7d\x05\x00\x00\xff\xff\x62\x2c\x47\xe2\xfc\x01\x00\x00")
+var _assetsTemplatesHomeIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd1\xb1\x6a\xc3\x40\x0c\xc6\xf1\xdd\xe0\x77\x50\x6f\x0f\x47\x20\x53\x71\xbc\x96\x40\xe9\xd0\x96\x96\x8e\x67\x9f\x62\x0b\x6c\x29\x9c\x94\xa4\xe1\xf0\xbb\x17\x13\x68\x4a\x13\xb2\x68\xd2\xf7\x5b\xfe\x39\x47\xdc\x12\x23\xb8\x31\x10\x2f\x5a\x61\x43\x36\x37\x4d\x65\x51\x35\xa9\x2e\x8b\xaa\x5f\x82\xda\x69\xc0\xb5\x33\xfc\xb6\x45\x18\xa8\xe3\x47\x68\x91\x0d\x93\xab\x3f\x71\x68\x65\x44\x30\x81\x17\xa4\xae\x6f\x24\xe9\x43\xe5\xfb\x65\x7d\x01\x56\xf7\x80\x27\x34\x78\xb3\x90\x0c\x23\x6c\x18\xbe\x64\x9f\x7e\xa5\x5e\x24\xc2\xbb\xc4\x70\x9a\xc9\xd5\x85\x3c\xdf\x48\x87\x7b\x72\x59\x00\x00\x54\x01\xfa\x84\xdb\xb5\xf3\x64\x38\xaa\x77\xd0\x0e\x41\x75\xed\x1a\x63\x68\x8c\x17\xbb\x44\x63\x48\x27\x07\x49\x66\xa9\xd9\x9b\x09\xbb\xfa\x83\xf0\x08\x9b\x79\x52\xf9\x70\x65\x29\xaa\x92\xb0\x1f\xa4\x23\xbe\x36\x15\x5b\xe1\x78\x43\x7d\x9e\xff\x6f\x82\x3d\x0e\x86\x49\x3d\xe3\xf1\x8a\x23\xde\xca\x7f\xe9\x15\x3b\x52\xc3\x74\xc6\x2a\x1f\xe9\x50\x97\x45\xce\xc8\x71\x8e\x57\x16\x97\xb2\xda\x26\xda\xd9\x9f\xb6\x39\x23\xc7\x69\xfa\x19\x00\x62\x2c\x47\xe2\xfc\x01\x00\x00")
 
 func assetsTemplatesHomeIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -148,12 +200,12 @@ func assetsTemplatesHomeIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/home/index.html", size: 508, mode: os.FileMode(511), modTime: time.Unix(1603506893, 0)}
+	info := bindataFileInfo{name: "assets/templates/home/index.html", size: 508, mode: os.FileMode(436), modTime: time.Unix(1604454525, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesHomeLayoutHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x6d\x53\xdb\xb8\x16\xfe\xde\x99\xfe\x07\x55\x77\x66\x97\x0e\xb5\x9d\x94\x14\xe8\x12\x67\x86\x6d\x60\x09\x2d\x0b\x84\x40\xa1\xdf\x4e\xac\x13\x5b\xa9\x2c\x39\x92\x9c\x17\xb2\xfc\xf7\x3b\xb6\xf3\x4e\x08\xf4\xb6\x73\xe7\xce\x5c\x7f\xb1\x23\x9f\xb7\xe7\x39\x8f\xe5\xe3\x54\xdf\xd4\xcf\x3f\xb5\xee\x2e\x8e\x48\x64\x63\x51\x7b\xfd\xaa\x9a\x9d\x89\x00\x19\xfa\x14\x25\xad\xbd\x7e\x95\xad\x21\xb0\xda\xeb\x57\x84\x10\x52\x8d\xd1\x02\x09\x22\xd0\x06\xad\x4f\xaf\x5b\xc7\xce\x3e\x5d\xba\x27\x21\x46\x9f\xf6\x39\x0e\x12\xa5\x2d\x25\x81\x92\x16\xa5\xf5\xe9\x80\x33\x1b\xf9\x0c\xfb\x3c\x40\x27\xff\xf1\x8e\x70\xc9\x2d\x07\xe1\x98\x00\x04\xfa\x65\xb7\xb4\x1c\x2b\xb2\x36\x71\xb0\x97\xf2\xbe\x4f\x6f\x9d\xeb\x43\xe7\x93\x8a\x13\xb0\xbc\x2d\x70\x21\x30\x47\x1f\x59\x88\x33\x57\xcb\xad\xc0\xda\xdf\xc8\xc3\xa8\xad\xb4\xa9\x7a\xc5\xc2\xe4\xae\xe0\xf2\x3b\xd1\x28\x7c\x6a\xec\x48\xa0\x89\x10\x2d\x25\x91\xc6\x8e\x4f\xb3\x7c\xe6\x0f\xcf\x33\x16\x82\xef\x09\xd8\xc8\x6d\x2b\x65\x8d\xd5\x90\x04\x4c\xba\x81\x8a\xbd\xd9\x82\x57\x71\x77\xdc\xb2\x17\x18\x33\x5f\x73\x63\x2e\xdd\xc0\x18\x5a\xe4\xca\x0e\x2e\x2d\x86\x9a\xdb\x91\x4f\x4d\x04\x3b\xfb\x15\x27\x0c\xcf\x47\xcd\x12\xbf\xfd\xd4\x3e\xbb\xec\xef\xdc\xf2\x24\x86\x9d\xca\x59\x7d\x9b\x9d\x78\xe5\xce\xe5\xde\x7e\xc5\xeb\xee\x06\x77\x1e\x3f\x6d\x5d\x5e\x9f\x47\xc1\x57\xbd\x37\xfc\x78\xda\x57\xcd\x61\xeb\xfd\xd9\xb7\x41\xb9\x45\x49\xa0\x95\x31\x4a\xf3\x90\x4b\x9f\x82\x54\x72\x14\xab\xd4\x64\xf8\xab\xde\xa4\x57\xaf\x5f\x55\xdb\x8a\x8d\xa6\xa0\x25\xf4\x49\x20\xc0\x18\x9f\x4a\xe8\xb7\x41\x93\xe2\xe4\xe0\x30\x01\xc9\x9c\x98\x4d\x17\x18\xe8\xef\xa4\x1d\xe6\xe7\x29\xa3\x79\x08\x58\x0e\xe0\xb4\x35\x48\x36\x25\xce\xa3\x8b\x7c\xc3\xa2\x5f\x3b\xb5\x56\xc9\x15\x67\xab\xc2\x50\xa0\xa6\xc4\x8e\x12\xf4\x69\x61\x43\x09\x03\x0b\x93\x7b\x3e\x0d\x94\x10\x90\x18\x9c\x2e\x83\x0e\x33\xc9\xfd\xab\x08\x61\x8e\x86\x10\x27\x02\xeb\xd8\x81\x54\xd8\x05\xc6\xb3\x03\x34\x07\x27\x13\x88\x56\x62\x96\x75\xd5\xa5\xb0\x2a\x18\x40\xe6\xd3\x0e\x88\x2c\x5b\xbe\x2a\xa0\x9d\x49\xa4\x95\xd7\x92\x71\xc3\x43\xb0\x5c\xc9\x45\x4a\x72\x78\x26\x81\x27\xc0\x39\x3c\xc8\xec\xab\x5e\x66\xb2\xc8\x88\x57\xc0\xcd\xbb\x34\x5b\x64\x7c\xd6\xa1\x29\xf0\x69\x4b\xe6\x44\x70\xf6\x04\x16\xf3\xa8\xae\x54\xac\x54\x95\x49\x20\xd6\x0e\xa4\x56\xad\x1a\x4f\x9e\x8b\x05\x07\x87\x5b\x8c\x09\x04\x96\xf7\x71\x9d\xf5\x23\x45\x38\xd9\x63\xb5\xa0\x86\x13\x15\xe3\x32\x39\x46\x3b\x4a\x8a\x11\xad\x6d\x05\xa9\xd6\x28\xed\xdb\x09\x33\xcb\x7a\x99\xb3\x24\xf8\x0b\xcb\x64\x5a\x25\x4c\x0d\x1e\xf5\xe6\xe9\x42\x67\x2e\x93\x5e\x4d\x0b\xef\x42\x1f\x4c\xa0\x79\x62\xff\x20\x7d\xc5\xd9\x56\xe9\xed\xc1\x84\xf5\xa9\xba\x9d\x59\xb6\xf5\xc9\xb2\x63\x49\xc6\x33\xfb\x42\x58\x11\x98\x44\x25\x69\xe2\x53\xab\x53\x7c\x42\x83\xb5\x86\xc5\xd8\xac\x27\x66\x55\x2d\x33\x28\x31\xca\x74\x51\xbd\x02\x59\x7b\xb4\xb6\xf2\x27\xa2\x2e\x73\x35\x8b\x9b\x71\x3c\xeb\x6c\xf6\xc3\x78\xb4\x76\xc3\x71\x40\x0e\xfb\xc0\x05\xb4\x05\x6e\xa8\xd4\x63\xbc\xff\xbf\xdf\x5e\x13\xa1\xb0\xa8\xff\x3b\xcd\xbd\xd0\xaa\xc3\x37\x92\xf6\x03\xed\x7d\x54\xf9\x86\xe6\x8e\xc7\xbc\x43\xdc\x6b\x83\xfa\x0a\x8d\xe1\x4a\x3e\x3c\x3c\x63\x1c\xda\x25\xfb\xfc\xba\x51\x27\xa5\x4d\x8e\xcf\x49\x68\x52\xb1\xf1\xc6\xe3\x35\xb1\x1f\x1e\x0a\x71\x3d\xcd\xce\x0b\x72\x2c\x36\x5a\xa8\x50\xa5\x36\x6f\x75\xed\x4b\x7e\xbd\x39\xf4\x78\x8c\xc2\xe0\x4f\x01\x2c\x00\x79\x42\x85\x5c\x7a\x79\x56\x2e\x7f\x0a\xcf\x9c\x33\x89\x03\x8f\xd6\x9a\x18\x72\x63\x51\x3f\x8b\x44\xb2\xcd\x2d\xfe\xbf\x80\xfa\x03\x7b\x50\xd5\x4b\xc5\xd2\xab\x7a\xee\x59\xf5\x24\xf4\x67\xef\xec\x6a\x0c\x5c\x12\xad\xb2\x4d\x20\xbb\xa4\xf3\x97\xb7\xb4\xc0\x25\x6a\xa7\x23\x52\xce\x16\x1f\xc7\xf1\xd8\x62\x9c\x08\xb0\x48\x72\x1f\x67\x32\xc1\x52\xe2\x4e\x4b\xaf\x7a\xd9\x8d\x79\x96\x42\xc4\xc4\xe8\x60\x3e\x9c\x06\x8a\xa1\xdb\xed\xa5\xa8\x47\xf9\x44\x5a\x5c\x3a\x3b\xd9\x38\xea\x1a\xc1\xe3\x7c\x0a\xed\x6e\x1e\x42\x7b\xfb\xdc\xbb\xdd\xfe\xb8\xfb\xa1\x7e\x7f\x5e\xd2\xad\x3d\x68\x7f\xae\x94\x4f\xaf\xec\x65\xe3\xb0\x77\x13\x36\x6f\xee\x93\xf6\xbd\xfa\x60\xe2\xdb\xcf\x49\xe5\xae\xd3\xec\x9f\x6c\xef\x43\xdb\xb6\x8e\xca\x17\x7c\xb7\xcb\xef\xd5\x42\xf0\xa7\xa6\xd1\xaa\x57\x54\x5f\xdb\x84\x85\xc9\xae\x71\x03\xa1\x52\xd6\x11\xa0\x31\x07\x04\x5d\x18\x7a\x82\xb7\x8d\x97\xa8\x24\x41\xed\x76\x8d\x57\x76\xcb\x15\x77\xcf\x4b\x63\x36\x5d\x7c\x01\xc8\xeb\xf3\xf7\xd8\x2a\x7d\x4a\x4e\x7a\xec\xea\xf4\x72\x37\x3a\xb5\xa3\x0f\x9f\x6f\x92\xc8\x5e\x44\xf7\x5f\xbb\x1f\xbf\x9e\x97\x03\x71\xd2\x3a\xfb\x0b\x76\x4e\xeb\xdf\x06\x5a\x5e\xf6\x2a\xe6\x78\x7f\x97\x35\x4e\xfe\xae\xdf\x97\xbe\x96\x7f\x11\xc8\x1f\xf8\x9a\xe8\xae\x7e\x4c\x3c\x83\xf0\xb4\x7b\x15\xdf\x84\x23\x56\x4a\x76\x92\xdb\x3f\xcb\xba\xc9\xdb\xdf\xae\x0f\xef\x54\xa3\x31\xda\x3d\xd7\x97\xbb\x37\xba\xdb\x38\x82\xe3\x8e\x27\x4f\xff\xba\x6f\x0c\x8f\xeb\xa6\x53\x19\x96\x86\x8d\xb3\xed\x3f\x4b\x7b\xdd\xe6\xd9\x7f\x8e\xb0\x18\xdc\x2d\x0e\xad\x37\xdf\x6d\x17\xd5\xde\x07\x3d\xd9\x7b\x89\x4f\x3a\xa9\x0c\xb2\xf1\x99\x6c\xbd\x25\xe3\xe5\x47\x2e\xb3\xd3\xd8\x23\x3e\x91\x38\x20\xb7\x67\x5f\x4e\xac\x4d\x9a\xd8\x4b\xd1\xd8\xad\xb7\x07\x8f\x8d\x23\x15\xe3\x05\x84\x48\x7c\x32\xe0\x92\xa9\x81\x2b\x54\x90\x0f\xe7\x6e\x51\xfe\xc1\xe2\x60\x9d\x1d\x1a\x7b\xae\x4a\x50\x6e\xd1\xfa\xd1\x97\xa3\xd6\x11\x7d\x37\x0f\xb2\x4d\x7e\x5f\xdc\xc5\x54\x6a\x7f\x5f\x4d\x9a\xbb\x4b\x8d\xc0\x46\xc6\x82\xc5\x20\x02\x99\xa7\xdf\x80\x2a\x6f\x57\x87\x6c\x65\xbe\xb9\xe7\x55\xe6\x49\x7c\xdf\x27\x15\xf2\xdb\x6f\x79\xcc\x2c\x58\x6a\xf2\xb5\xf7\xa5\xca\xda\x10\xd9\xb1\x02\x92\xf8\xb3\xe2\x0f\xd6\x3b\x68\xb4\xa9\x96\x24\x9f\x36\xd6\x98\x3c\x90\x6c\xd7\x7f\x69\x75\x6f\x9e\xa9\x0e\x04\x6a\xbb\x45\x8f\x81\x0b\x64\xc4\xaa\xac\xe7\x44\xa5\xf6\x0d\x5d\xa5\xf1\xa5\xe5\x2d\x2f\x3d\xac\xed\xa6\x41\xc9\x96\xc4\xb1\x6c\x96\xcb\x04\x24\x13\x78\x68\x46\x32\x68\xa2\x49\x94\x34\xcb\x2d\xd3\xd8\x7b\x47\x34\x32\xae\x31\xb0\x5f\x26\xe4\xbe\x23\xa9\x84\xd4\x46\x4a\xf3\x7b\x64\x67\x68\x0c\x84\xf8\x08\xfb\x1a\xea\x32\x9a\xd6\x93\xb4\x01\xee\xc3\x2a\xb4\x69\xe0\x25\x65\x94\xc8\x3f\xff\xbc\x54\x2f\x8f\xb5\xb2\x8a\x70\x0d\xe3\x3f\x59\x61\xa5\xb4\xb3\xb6\x96\x42\x19\xeb\xf8\xfc\xf5\x35\x7c\x28\x95\x36\xd4\x40\x0f\x25\x41\xad\x95\x26\x2a\xc8\x3f\x3e\x99\x4b\x1a\x24\x82\x3e\x92\x4e\xa1\xdb\x91\x4a\x5d\xd7\x25\x1d\xa5\x89\x8d\x90\x08\x30\x96\x58\x1e\xa3\xbb\x56\xc5\x9b\x8b\x9d\x5d\x1e\x4c\xdf\xe9\x4b\x1b\xe8\xe2\x14\x50\xdc\x58\x99\x03\xaa\xde\xe4\x9f\x9b\xfc\xef\x1c\x1b\x8b\xda\xbf\x03\x00\x00\xff\xff\x19\xeb\xf2\x03\xa6\x13\x00\x00")
+var _assetsTemplatesHomeLayoutHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x6d\x53\xdb\x38\xd7\xfe\xce\x0c\xff\x41\xd5\x33\xb3\x1b\x86\xda\x4e\x4a\x4a\x69\x89\xb3\xc3\x16\xba\x40\x4b\x79\x0b\x94\x76\xfa\x45\xb1\x8e\x6d\xa5\xb2\xe4\x48\x72\x42\x60\xf3\xdf\x9f\x91\xed\x84\x24\x98\x40\x5f\xee\xce\x3d\x73\xc7\x99\xb1\x7d\x7c\x5e\xaf\x73\x49\x96\xdc\x7a\xb6\x7b\xfc\xb6\xf3\xf9\x64\x0f\xc5\x26\xe1\xed\xd5\x95\x96\x3d\x23\x4e\x44\xe4\x63\x10\xb8\xbd\xba\x62\x65\x40\x68\x7b\x75\x05\x21\x84\x5a\x09\x18\x82\x82\x98\x28\x0d\xc6\xc7\x17\x9d\x77\xce\x16\x9e\x7b\x26\x48\x02\x3e\x1e\x30\x18\xa6\x52\x19\x8c\x02\x29\x0c\x08\xe3\xe3\x21\xa3\x26\xf6\x29\x0c\x58\x00\x4e\x7e\xf3\x1c\x31\xc1\x0c\x23\xdc\xd1\x01\xe1\xe0\x37\xdc\xfa\xbc\xaf\xd8\x98\xd4\x81\x7e\xc6\x06\x3e\xbe\x72\x2e\x76\x9c\xb7\x32\x49\x89\x61\x5d\x0e\x33\x8e\x19\xf8\x40\x23\x98\x9a\x1a\x66\x38\xb4\x3f\x02\x8b\xe2\xae\x54\xba\xe5\x15\x82\xf2\x29\x67\xe2\x1b\x52\xc0\x7d\x4c\xb8\x01\x25\x88\x01\x8c\xcc\x28\x05\x1f\x93\x34\xe5\x2c\x20\x86\x49\xe1\x11\x23\x93\xf5\xeb\x84\x63\x94\x5b\xfb\xf8\xc0\x40\xa2\x91\x00\xa0\x40\x91\x14\x68\xea\x1f\xa3\x58\x41\xe8\x63\x2f\x04\xa0\xda\x63\x56\xcf\xb5\xe6\xf8\x7e\x48\x6d\x46\x1c\x74\x0c\x60\x26\x56\xb6\x44\xfd\xc6\xf3\xb4\x21\xc1\xb7\x94\x98\xd8\xed\x4a\x69\xb4\x51\x24\x0d\xa8\x70\x03\x99\x78\x53\x81\xd7\x74\x37\xdc\x86\x17\x68\x7d\x27\x73\x13\x26\xdc\x40\x6b\x5c\xc4\xb2\x07\x13\x06\x22\xc5\xcc\xc8\xc7\x3a\x26\x1b\x5b\x4d\x27\x8a\x8e\x47\x67\x75\x76\xf5\xb6\x7b\x74\x3a\xd8\xb8\x62\x69\x42\x36\x9a\x47\xbb\xeb\x74\xdf\x6b\x84\xa7\xaf\xb6\x9a\x5e\x6f\x33\xf8\xec\xb1\xc3\xce\xe9\xc5\x71\x1c\x7c\x52\xaf\xae\x5f\x1f\x0e\xe4\xd9\x75\xe7\xc5\xd1\x97\x61\xa3\x83\x51\xa0\xa4\xd6\x52\xb1\x88\x09\x1f\x13\x21\xc5\x28\x91\x99\xb6\x15\xb6\xbc\x92\x1e\xab\x2b\xad\xae\xa4\xa3\x49\xd1\x82\x0c\x50\xc0\x89\xd6\x3e\x16\x64\xd0\x25\x0a\x15\x27\x07\xae\x53\x22\xa8\x93\xd0\x89\x80\x12\xf5\x0d\x75\xa3\xfc\x3c\xc1\xcc\x1e\x2d\x32\xef\xc0\xe9\x2a\x22\xe8\x14\x6e\x3c\xdb\x62\x32\x6b\xd7\xcd\x8c\x91\x62\xc1\xd8\xc8\x28\xe2\xa0\x26\xbd\x2e\x74\x30\xa2\xc4\x90\xf2\x99\x8f\x03\xc9\x39\x49\x35\x4c\xc4\x44\x45\x96\xe5\xff\x57\xb8\xd0\x7b\xd7\x24\x49\x39\xec\x42\x48\x32\x6e\x66\x10\xb7\x7f\xa2\x18\x71\x2c\x27\x95\xe4\xd3\xa8\x8b\x26\x85\x56\x81\x00\x50\x1f\x87\x84\xdb\x68\xb9\x94\x93\xae\x65\x65\x27\xcf\xc5\x62\xc3\xa2\x9c\x8a\xb3\x90\xd8\xa3\xa5\x53\xf2\x40\x71\x0e\x0b\xa4\xc0\xed\x96\x67\x55\x66\x11\xf1\x8a\x72\xf3\x2e\x4d\x85\x94\x4d\x3b\x34\x29\x7c\xd2\x92\x3b\x20\x18\x7d\xa0\x16\x7d\x2f\xaf\x8c\x2f\x64\x65\x29\x90\x28\x87\x64\x46\x2e\x2a\x97\xe3\x62\xc6\xc0\xb1\x03\x07\x91\xc0\xb0\xc1\x74\x28\x2f\x1e\x73\x8c\x70\xec\x48\x9e\x61\xc3\xbe\x4c\x60\x1e\x1c\xad\x1c\x29\xf8\x08\xb7\x6b\x41\xa6\x14\x08\xb3\x56\x22\x33\xcf\x97\xc9\xaf\xe5\x71\xf6\xc4\x34\xa9\x92\x29\x95\x43\xf1\xf4\x44\xa7\x26\x65\xaf\x26\x89\xf7\xc8\x80\xe8\x40\xb1\xd4\xbc\x41\x03\xc9\x68\xad\xbe\xb6\x5d\xa2\x3e\x61\xb7\x33\x8d\x56\x1d\xcc\x1e\x73\x34\x9e\xea\x17\x74\x8b\x89\x4e\x65\x9a\xa5\x3e\x36\x2a\x83\x07\x38\xd8\xce\xe7\xb7\x6a\x60\x16\xd9\x32\xf1\xef\x24\x20\xb2\x59\xf6\x72\xa0\xdd\x51\x65\xe6\x0f\x78\x9d\xc7\x6a\xea\xd7\x62\x3c\xed\xac\xbd\xd1\x1e\x6e\x5f\x32\x18\xa2\x9d\x01\x61\x9c\x74\x39\x2c\xc9\xd4\xa3\x6c\xf0\xdf\xdf\x5e\x1d\x83\x7d\xff\xfc\x9e\xe6\x9e\x28\x19\x32\x0e\xbf\xa6\xbd\xf7\x32\x7f\xc0\xa7\xfd\xdf\xde\xb2\x10\xb9\x17\x1a\xd4\x39\x68\xcd\xa4\x18\x8f\x1f\x51\x8e\xcc\x9c\x7e\x7e\x7d\xb0\x8b\xea\xe3\xf1\x8f\x53\xa8\xcc\x58\x7b\xb7\xb7\x15\xbe\xc7\xe3\x82\x5c\x0f\xa3\x33\x4d\x0e\xfa\xf7\x93\xeb\x8c\x52\x40\x1b\x3f\x93\x1e\xa1\x09\x13\x1e\xc9\x28\x33\x1e\x6e\xef\xd8\x33\xfa\x20\xa3\xe5\xf9\x3c\xcd\x29\x05\x0e\x06\xa8\x87\xdb\xbb\xc5\x15\xda\x09\x02\x99\x09\xb3\xf0\xce\xfc\x31\xef\x1a\x8c\x61\x22\xb2\xa3\xf3\x9c\x19\x40\xe7\xe5\xfd\x72\xdf\xb7\xb7\x20\xe8\x4f\xe0\x35\x3b\xa6\xb8\x8c\x64\x66\xf2\x51\xd5\xfe\x90\x5f\x3f\x1a\x9b\x6b\xf8\x99\x66\xe9\x82\x3b\x1e\x97\x11\x13\x5e\x1e\x95\x89\xe5\x41\x9f\x4c\x4f\x01\x43\x0f\xb7\xcf\x20\x62\xda\x80\xfa\x49\x14\xff\x47\x4a\xfd\x8e\xe9\xbe\xe5\x65\x7c\x46\x34\x6b\xd9\xf2\x04\x19\x4c\x97\x47\xad\x84\x30\x81\x94\xb4\x6b\x42\x7b\x89\x27\x45\xd9\xf5\x1d\x61\x02\x94\x13\xf2\x8c\xd1\xd9\xf7\xc3\xed\xad\x81\x24\xe5\xc4\x00\xca\x6d\x9c\x72\x7f\x82\x91\x3b\x49\xbd\xe5\xd9\x07\x77\x51\x0a\x12\x23\xad\x82\xbb\x7d\x40\x20\x29\xb8\xbd\x7e\x06\x6a\x94\x2f\xfe\x8b\x4b\x67\xc3\xae\xfc\x5d\xcd\x59\x92\x2f\xf8\x7b\xcb\xd7\xfb\xfd\x2d\xe6\x5d\xad\xbf\xde\x7c\xb9\x7b\x73\x5c\x57\x9d\x57\xa4\xfb\xbe\xd9\x38\x3c\x37\xa7\x07\x3b\xfd\xcb\xe8\xec\xf2\x26\xed\xde\xc8\x97\x3a\xb9\x7a\x9f\x36\x3f\x87\x67\x83\xfd\xf5\x2d\xd2\x35\x9d\xbd\xc6\x09\xdb\xec\xb1\x1b\x39\xe3\xfc\xa1\x85\x7f\xcb\x2b\xb2\x6f\x2f\xab\x85\x8a\x9e\x76\x03\x2e\x33\x1a\x72\xa2\x20\x2f\x88\xf4\xc8\xb5\xc7\x59\x57\x7b\xa9\x4c\x53\x50\x6e\x4f\x7b\x0d\xb7\xd1\x74\x5f\x79\x59\x42\x27\xc2\x27\x14\x79\x71\xfc\x02\x3a\xf5\xb7\xe9\x7e\x9f\x9e\x1f\x9e\x6e\xc6\x87\x66\xf4\xf2\xfd\x65\x1a\x9b\x93\xf8\xe6\x53\xef\xf5\xa7\xe3\x46\xc0\xf7\x3b\x47\xff\x90\x8d\xc3\xdd\x2f\x43\x25\x4e\xfb\x4d\xfd\x6e\x6b\x93\x1e\xec\x7f\xdc\xbd\xa9\x7f\x6a\xfc\xa2\x22\xbf\x63\xe3\xd6\x5b\xdc\xb7\x3d\x52\xe1\x61\xef\x3c\xb9\x8c\x46\xb4\x9e\x6e\xa4\x57\x7f\x37\xd4\x19\xeb\x7e\xb9\xd8\xf9\x2c\x0f\x0e\x46\x9b\xc7\xea\x74\xf3\x52\xf5\x0e\xf6\xc8\xbb\xd0\x13\x87\xff\xdc\x1c\x5c\xbf\xdb\xd5\x61\xf3\xba\x7e\x7d\x70\xb4\xfe\x77\xfd\x55\xef\xec\xe8\xc7\x2b\x2c\xf6\x48\x06\xae\x8d\x77\x37\xdb\xce\xb2\x7d\x40\x54\x39\xf7\x22\x1f\x85\x99\x08\xec\x4e\x05\xd5\xd6\xd0\xed\x9d\xce\x44\x4f\x41\x1f\xf9\x48\xc0\x10\x5d\x1d\x7d\xd8\x37\x26\x3d\x83\x7e\x06\xda\xd4\xd6\xb6\xef\x2b\xc7\x32\x81\x13\x12\x01\xf2\xd1\x90\x09\x2a\x87\x2e\x97\xc5\x96\xdc\x2d\xd2\xdf\x5e\x5d\x99\xb7\x52\xd0\x77\x65\x0a\xa2\x86\x77\xf7\x3e\xec\x75\xf6\xf0\xf3\x3b\x27\xeb\xe8\xcf\xd9\x59\x4c\x66\xe6\xcf\xc5\xa0\xb9\xb9\x50\x40\xe8\x48\x1b\x62\x20\x88\x89\x88\x60\x79\x55\xf6\x60\x21\xaa\x59\xdb\xdc\xf2\xdc\x5a\x22\xdf\xf7\x51\x13\xfd\xf1\x07\xb2\x72\xeb\x2c\xd3\xb9\xec\x45\xbd\x59\xe9\xc2\xfe\x17\x8a\x44\xfe\x34\xf9\xed\x6a\x03\x05\x26\x53\x02\xe5\xab\xf6\x0a\x95\x31\xb2\xb3\xfe\x53\xb3\x7b\xf6\x48\x76\x84\x83\x32\x35\xfc\x8e\x30\x0e\x14\x19\x69\x7b\x8e\x64\x66\x9e\xe1\xb5\xed\x6a\x8b\xc7\xd2\x9b\x17\x8d\x2b\xbb\xa9\x41\xd0\x39\x72\xcc\xab\xe5\x34\x21\x82\x72\xd8\xd1\x23\x11\x9c\x81\x4e\xa5\xd0\xf3\x2d\x53\xd0\x7f\x8e\x14\x50\xa6\x20\x30\x1f\x4a\x70\x9f\xa3\x4c\x90\xcc\xc4\x52\xb1\x1b\xa0\x47\xa0\x35\x89\xe0\x5e\xed\x15\xd0\x59\x98\xaa\x41\x5a\x52\xee\x78\x75\xa5\xda\xf1\x1c\x33\xea\xe8\xdf\x7f\xef\xf3\xa5\x51\x29\xad\x4e\xe1\x3e\x83\x16\xeb\xde\xfe\xe5\x79\x37\xeb\x1b\x95\xb9\x18\x35\x5a\x4e\xa5\xc3\xf3\xe3\x8f\x6e\x6a\xbf\x1e\x96\x18\x17\xbd\x5b\x73\x29\x18\xc2\xb8\x2d\xbb\xaa\x47\x15\x15\x8c\x51\x40\x4c\x10\xa3\xda\xfd\x0e\x4e\x7e\x45\xc8\xa7\xfa\xfb\x0f\x80\x54\xd5\xdc\x66\xbd\xf9\xbd\xd0\x59\xc2\xa7\x4a\x76\x39\x24\xc8\x47\x0f\x41\xb8\xbd\x0c\x84\xd2\xdc\x05\xa5\xa4\xd2\xe8\x2f\x34\x2f\x70\x13\x92\xd6\xee\x46\x4f\xae\xb5\x86\x6e\x27\x08\xe4\xf7\x6e\x52\x0c\x98\x6d\x34\x5e\x73\x7b\x92\x89\x1a\xfe\x2a\xf0\x1a\x7a\x33\xf5\x55\xf4\xf0\xa7\x9a\x85\x4f\x38\x10\x0d\x28\x64\x9c\xdb\x89\x06\x11\x7b\x0e\x91\x89\xc1\x02\x99\x31\x05\x14\x85\x0c\x38\xd5\x2e\xfe\x5d\x6d\x7c\x5d\x99\xb4\xed\x4a\x20\x45\xc8\x59\x60\xbe\xaf\x2d\x36\xc8\xc4\xd2\x2d\x3f\x4d\xe5\xa1\x32\x41\x21\x64\x02\xe8\x23\x28\x4d\x8d\x97\x21\x3e\x7d\x0f\x58\x6d\xa6\x92\xbb\x90\xe5\x58\x5b\x47\xf8\xab\xf8\x2a\xce\x80\x4b\x42\x73\x84\x53\xfb\xce\x34\x12\x69\x80\xfc\xde\xae\x66\xb5\x41\x03\x50\xf6\x0d\xfa\x17\xfa\x2c\x33\x65\x3f\xfe\x8b\x08\x34\x1a\xda\x16\x75\x01\x71\xa9\x8d\x8b\xd7\x1e\x4c\x79\x61\x7e\x72\x55\x1e\xaf\xf6\x7b\x9a\xf7\xb2\x5e\xaf\x4c\xac\x64\xdb\x4e\xc9\x6d\x24\x83\xbc\x0f\xd4\x45\x07\x28\x26\x03\x40\x61\xf1\xca\x1b\xc9\xcc\x75\x5d\x14\x4a\x55\x02\xa2\x0d\x32\x2c\x81\x6a\xf2\x2d\x4f\x76\x7a\x59\x3e\x5a\x58\x7b\xcd\x6e\x20\x8a\x07\x0b\x5b\x88\x96\x57\x7e\x5f\x5f\x5d\x69\x79\xb1\x49\x78\xfb\xff\x07\x00\x55\x7b\xf2\x4e\xbf\x19\x00\x00")
 
 func assetsTemplatesHomeLayoutHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -168,12 +220,12 @@ func assetsTemplatesHomeLayoutHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/home/layout.html", size: 5030, mode: os.FileMode(511), modTime: time.Unix(1603506893, 0)}
+	info := bindataFileInfo{name: "assets/templates/home/layout.html", size: 6591, mode: os.FileMode(420), modTime: time.Unix(1792437082, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesHomeUnauthorizedHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\xb1\x4e\xc3\x30\x10\x86\xf7\x48\x79\x87\xc3\x0b\x0b\x6e\x60\x63\xb0\x23\xa1\x02\x23\x30\xb4\x12\x1d\x2f\xf1\x29\x3e\xc9\xb1\x4d\x7c\x4d\xc5\xdb\xa3\x84\x82\xda\xe9\x74\xff\xe9\xff\xa4\xef\xcc\xcd\xf3\xfb\x76\x77\xf8\x78\x01\x2f\x63\x68\xeb\xca\x2c\x13\x02\xc6\xc1\x2a\x8a\xaa\xad\xab\x25\x23\x74\x6d\x5d\x01\x00\x98\x91\x04\xa1\xf7\x38\x15\x12\xab\xf6\xbb\x57\xfd\xa8\xae\x6e\x11\x47\xb2\x6a\x66\x3a\xe5\x34\x89\x82\x3e\x45\xa1\x28\x56\x9d\xd8\x89\xb7\x8e\x66\xee\x49\xaf\xcb\x1d\x70\x64\x61\x0c\xba\xf4\x18\xc8\x3e\x6c\xee\xaf\x59\x5e\x24\x6b\xfa\x3a\xf2\x6c\xd5\xa7\xde\x3f\xe9\x6d\x1a\x33\x0a\x77\x81\x2e\xc0\x4c\x96\xdc\x40\xff\x55\x61\x09\xd4\xbe\x11\x0f\xbe\x4b\x53\x31\xcd\x6f\x50\x57\xa6\x39\x9b\xd4\x95\xe9\x92\xfb\xfe\x2b\xe4\xf6\x90\x8e\xe0\x52\xbc\x15\xf0\x38\x13\x64\x9a\x46\x2e\x85\x53\x04\x49\xb0\xb8\x80\x78\x2e\x90\x71\xa0\x8d\x69\xf2\xca\x3a\x13\x56\xec\xf2\xbc\x9f\x00\x00\x00\xff\xff\x31\x24\xdd\xe8\x4c\x01\x00\x00")
+var _assetsTemplatesHomeUnauthorizedHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\x3d\x4b\x04\x31\x10\x86\xfb\x85\xfc\x87\x31\x8d\x8d\xb9\xd5\xce\x22\x09\xc8\xa9\xa5\x5a\xdc\x81\x57\x66\x37\xc3\x66\x20\x5f\x6e\xe6\xf6\xf0\xdf\xcb\xea\x29\x5e\x35\xcc\x3b\xbc\x0f\x3c\xa3\xaf\x1e\x5f\xb7\xbb\xc3\xdb\x13\x04\x4e\xd1\x8a\x4e\xaf\x13\xa2\xcb\x93\x91\x98\xa5\x15\xdd\x9a\xa1\xf3\x56\x74\x00\x00\x3a\x21\x3b\x18\x83\x9b\x1b\xb2\x91\xfb\xdd\xb3\xba\x97\x17\xb7\xec\x12\x1a\xb9\x10\x9e\x6a\x99\x59\xc2\x58\x32\x63\x66\x23\x4f\xe4\x39\x18\x8f\x0b\x8d\xa8\xbe\x97\x1b\xa0\x4c\x4c\x2e\xaa\x36\xba\x88\xe6\x6e\x73\x7b\xc9\x0a\xcc\x55\xe1\xc7\x91\x16\x23\xdf\xd5\xfe\x41\x6d\x4b\xaa\x8e\x69\x88\xf8\x0f\x4c\x68\xd0\x4f\xf8\x57\x65\xe2\x88\xf6\x05\x69\x0a\x43\x99\x9b\xee\x7f\x02\xd1\xe9\xfe\x6c\x22\x3a\x3d\x14\xff\xf9\x5b\xa8\xf6\x50\x8e\xe0\x4b\xbe\x66\x08\x6e\x41\xa8\x38\x27\x6a\x8d\x4a\x06\x2e\xb0\xba\x00\x07\x6a\x50\xdd\x84\x1b\xdd\xd7\xf5\x51\xfd\x99\x20\x3a\xdd\x07\x4e\xd1\x7e\x0d\x00\x31\x24\xdd\xe8\x4c\x01\x00\x00")
 
 func assetsTemplatesHomeUnauthorizedHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -188,12 +240,12 @@ func assetsTemplatesHomeUnauthorizedHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/home/unauthorized.html", size: 332, mode: os.FileMode(511), modTime: time.Unix(1581215359, 0)}
+	info := bindataFileInfo{name: "assets/templates/home/unauthorized.html", size: 332, mode: os.FileMode(436), modTime: time.Unix(1604454525, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesItemsEditHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x4d\x73\xdb\x36\x10\xbd\xeb\x57\x6c\x71\x91\x34\x8d\xa9\x71\xdb\x93\x4d\xb2\xe3\xd8\x4a\xab\xc6\x1f\xa9\x15\x4d\xd3\x23\x44\xac\x44\xc4\x24\x40\x03\xa0\x1c\x45\xc3\xff\xde\x01\x41\x4a\xa4\xac\xaf\x34\x33\xad\x0f\x36\x4d\xec\xbe\xdd\xb7\x8b\x5d\xbe\xd5\x8a\xe1\x8c\x0b\x04\x92\x52\x2e\xce\x22\x29\x0c\x0a\x43\x8a\xa2\xe3\xc7\xe7\xe1\x24\x63\xd4\x20\x8c\x0c\xa6\xf0\x88\xcf\x39\x6a\xe3\x0f\xe2\xf3\xb0\xe3\x4f\x55\xd8\xf1\x67\x52\xa5\xc0\x59\x40\x90\x71\xf3\x4e\xaa\x94\x84\x1d\x00\x00\x9f\x8b\x2c\x37\x60\x96\x19\x06\x24\xe6\x8c\xa1\x20\x20\x68\x8a\x01\xe1\x8c\xc0\x82\x26\x39\x06\xab\x95\x67\x71\xbd\xd1\x4d\x51\x1c\x75\xd3\x31\x26\x06\xd5\xe8\x95\xf7\xb8\x3a\x38\x09\x84\xa6\x54\x71\x43\xc5\x0e\x98\xf5\xd1\x06\x88\xf1\x05\x44\x09\xd5\x3a\x20\x96\xe8\xd9\x5c\xc9\x3c\xab\x18\x96\x06\x09\x9d\x62\x02\x33\xa9\x02\xc2\x0d\xa6\xd7\xd4\xe0\x5c\xaa\x25\x09\xeb\x27\x7f\x50\x9a\x34\x5c\x34\x26\x18\x99\xb2\x66\x2d\x97\x56\x20\xdb\x04\x25\x93\x3a\xed\x68\x8d\xbb\xc6\x29\xb1\x64\x66\xb8\x14\x15\x11\x32\x7e\xb8\x7e\x3f\x26\xe1\x58\x46\x4f\xda\x1f\xb8\xb3\x83\x0e\x93\xfb\x9b\xe1\xe3\x5f\xc3\xab\x47\x12\x4e\x04\x43\xf5\x82\x54\x9d\xe4\xf8\xf6\xf6\xea\xfe\xfd\xf0\xe3\x98\x84\x6f\x13\x2a\x9e\xd0\xec\x88\xe7\x0f\x1c\xd3\xaa\x94\x03\xc6\x17\x7b\xaa\x1a\xc5\x18\x3d\x35\xab\xda\xec\x9f\xa2\x8c\x4b\xf2\xda\xfe\xac\x34\xaa\x0b\x34\x47\x9b\x7f\xdd\x52\xf2\x6e\x78\x77\x75\x3b\x24\xeb\x22\xff\x56\x1e\xbf\xc3\x94\x26\x78\xa0\x7d\x2d\xb3\x1d\x21\x4b\x73\x12\x3a\x83\x56\x67\xff\x5b\x7a\xbb\xc8\xdd\x9d\x42\xed\xee\x30\xb1\xbb\xff\x97\xd6\xe4\x7e\x34\x1e\x7e\xda\x26\x36\x11\x5c\xe3\x97\x3d\xd4\xe0\x95\xdd\x7e\x76\xce\xe0\x64\x7e\xc7\x66\x7d\xcc\xbf\x22\x09\xed\xef\xd7\x33\xde\x2c\x85\xc1\x2f\xe6\xe0\x70\x6b\x0b\xb4\xbd\x8c\xf8\x57\x2c\x8a\x75\x25\x5c\xac\xef\x4e\xf9\xcf\x9c\x0a\xc3\xcd\x92\x84\xf5\xd3\xe1\xd4\x45\x9e\x4e\x6d\x7f\x0e\x24\xff\x5c\x43\x6e\x11\xa8\x03\x34\x48\x6c\xa2\x7f\x7f\xed\x0d\x35\xb9\x26\xa1\xfb\x7b\x74\xc7\x56\xe6\x07\x9b\x50\x21\x1e\xda\x7a\xe7\x24\x7c\xc8\x50\x9c\xb4\x21\x7f\x22\xe1\x75\x42\x79\x8a\xec\x24\xf3\x9f\x49\x78\x83\x09\x5f\xa0\x3a\xd1\xe1\x17\x12\x3e\x62\x84\x7c\xb1\xcb\x7e\xff\xea\x9d\xe6\xc6\x48\x51\xb5\xd7\xfd\xb3\x2e\xcb\xd4\x08\x98\x1a\x71\x96\x29\x9e\x52\xfb\x41\x92\x22\x4a\x78\xf4\x14\x90\xbc\xd4\x00\xb6\xaf\xbd\x3e\x69\x2a\x02\x7f\xe0\x30\xc2\x8e\x3f\xb0\x55\x0d\x3b\xab\x15\x0a\x56\x14\x9d\xce\x46\x55\xe8\x48\xf1\xcc\xb4\x74\x85\x7b\xd5\x18\x90\xc1\x67\xba\xa0\xee\x6d\xd5\x83\x05\x55\xb0\x89\x0b\x01\xcc\x72\x11\x95\x15\xe8\xf5\x61\xb5\xa6\x6a\xcd\x14\x3e\x43\x00\x02\x5f\xe0\xd3\xdd\xed\xef\xc6\x64\x95\x4e\xe9\xf5\x2f\x5b\x76\x36\xc3\x61\x82\x29\x0a\xa3\x21\x00\x26\xa3\xdc\x3e\x7b\x73\x34\xd5\xeb\xb7\xcb\x11\xeb\x75\x6b\x29\xd3\xed\x7b\x58\x99\xb7\x81\x32\xaa\x4a\x84\x17\x2e\x98\x7c\xf1\x12\x19\x51\x9b\x99\x97\x51\x13\xdb\xeb\xe4\xe9\x2c\xe1\xa6\x47\x06\xa4\x91\x41\xe9\xe4\x65\x32\xdb\x4e\x2b\xcb\xcd\x07\x6a\xe2\x1d\x78\x52\xf1\x39\x17\xf0\x63\xe5\xfb\x59\x72\xb1\x05\x6a\xfd\xab\x1c\xab\xb6\x04\x8d\xe2\xd8\x9f\x5a\x5f\x5c\xb4\xe8\x7b\x36\x4d\x56\x76\xb4\x5b\x8b\x8b\x6e\xdf\x2b\x2f\xd7\x9b\x96\xbf\x5b\xae\xfb\xbd\xdd\x0e\xdf\xed\x5b\x4f\xfc\x05\xdc\x97\x9b\xa4\xb7\x0f\xa4\xde\x22\x35\x4c\xbf\x8d\x63\xd7\xdf\xfe\x0c\xec\xfe\xdc\x1d\xdf\xcd\xfd\xd1\xe8\x6e\xf6\xf7\xc4\x1e\xdd\x1c\xf5\xe7\x6c\x5f\xde\xb5\x32\x3d\x9e\x42\x2d\x6e\x77\x20\x15\x97\x9d\x56\xbf\x37\x1a\xf6\x06\x82\xa3\xc0\x1b\xc1\xbb\x86\xde\x5c\x9f\xd6\xd5\x69\x2a\x60\x08\x5a\x61\x7c\x38\x87\x5f\x41\xe4\x49\x02\x17\xcd\x83\x46\x66\x0a\x9f\x3d\x99\xa1\xe8\x91\x0f\x93\x8f\xe4\x4d\x7d\xab\x1b\xc1\x4a\x0b\xa1\x90\xb2\xa5\xad\x38\x46\x31\x15\x73\xdc\x3b\xd9\xce\xc5\xe4\x4a\x40\x4c\x05\x4b\xf0\x4a\x2f\x45\xf4\x88\x3a\x93\x42\x63\x4f\xe1\xf3\x3a\xc8\x1b\x20\x7f\xcb\x1c\x98\x14\x5d\x03\x31\x5d\x20\x64\xa8\x52\xae\xb5\x05\x35\xb2\xda\x23\x60\x62\xae\x4b\xc1\xf0\x43\x73\x84\x8a\x2d\x12\x1a\x05\xeb\xfd\x31\x7e\xb8\xf7\xb4\x51\x5c\xcc\xf9\x6c\xd9\x6b\xd5\xa9\xdf\x6f\x79\x94\x19\xce\x68\xa2\xd1\x61\xd6\x78\xb6\x55\x6e\x38\x20\x80\x6e\xfd\x5d\x74\xe3\x54\x14\x5d\x67\xac\x5f\xb8\x89\x62\xe8\x39\xc3\x26\xfd\x88\x6a\x04\xa7\xf4\x2e\x5a\x35\xd9\xbb\xb6\xda\x32\xaf\xdb\xf7\x4a\xf5\x83\x0c\x02\x30\x2a\xc7\xcb\x16\xca\x54\x21\x7d\xba\xdc\x8a\x56\xc9\xe6\x6f\x8e\xe7\x04\xf1\xbf\x89\x58\x49\xbe\x6f\x8e\xe8\xb4\xdc\xe9\x11\xeb\xa6\x1c\x84\x1e\xb7\xf6\x40\xb3\x6b\xee\x64\xdd\xb5\x83\x28\xd7\x5b\xeb\xb4\x89\x53\x9f\x95\x48\xfe\xc0\x7d\xf0\xd6\x1f\xcd\x7f\x02\x00\x00\xff\xff\x19\xc5\xcf\xf6\x89\x0f\x00\x00")
+var _assetsTemplatesItemsEditHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5d\x73\xe2\xbc\x15\xbe\xe7\x57\x9c\xea\xe2\x05\xa6\xc1\x40\x76\xb7\x9d\x61\xb1\x3b\xc9\x86\xb4\xec\x2e\x49\x1a\x42\xbb\xed\x9d\xb0\x0f\x58\x1b\x5b\x72\x64\x99\x24\xf5\xf8\xbf\x77\xe4\x0f\xb0\x09\x06\xef\x6e\xa7\xef\xe0\x49\x8c\xf4\x9c\xe7\x7c\x49\x47\x3a\xc4\xb1\x83\x2b\xc6\x11\x88\x4f\x19\xef\xd9\x82\x2b\xe4\x8a\x24\x49\x6b\xec\x0e\xad\x45\xe0\x50\x85\x30\x55\xe8\xc3\x3d\x3e\x45\x18\xaa\x71\xdf\x1d\x5a\xad\xf1\x52\x5a\xad\xf1\x4a\x48\x1f\x98\x63\x12\x74\x98\xba\x16\xd2\x27\x56\x0b\x00\x60\xcc\x78\x10\x29\x50\xaf\x01\x9a\xc4\x65\x8e\x83\x9c\x00\xa7\x3e\x9a\x84\x39\x04\x36\xd4\x8b\xd0\x8c\x63\x43\xf3\x1a\xd3\xab\x24\x39\x29\x16\xba\xe8\x29\x94\xd3\x37\xd2\xf3\x7c\xa2\x11\x09\xf5\xa9\x64\x8a\xf2\x03\x34\xdb\xa9\x26\x44\x1b\x94\x21\x13\x7c\x9f\xe4\x1f\xd9\xf0\x96\xc0\x61\x1b\xb0\x3d\x1a\x86\x26\xd1\x91\xea\xad\xa5\x88\x82\x3c\x44\xfa\x19\x7b\x74\x89\x1e\xac\x84\x34\x09\x53\xe8\x7f\xa2\x0a\xd7\x42\xbe\x12\xab\x78\x1b\xf7\x53\x48\x49\x24\x44\x0f\x6d\x95\x06\xbd\x22\x52\x51\xa4\xb3\x28\x85\x57\x98\x6b\x17\xa0\x1d\x8f\xfe\x8c\x45\xa0\x98\xe0\xb9\x13\x64\x7e\xfb\xe9\xcb\x9c\x58\x73\x61\x3f\x86\xe3\x7e\x36\x77\x54\x60\x71\x73\x35\xb9\xff\xe7\xe4\xe2\x9e\x58\x0b\xee\xa0\x7c\x46\x2a\x1b\x09\x5e\x7e\xbd\xb8\xf9\x32\x79\x98\x13\xeb\xd2\xa3\xfc\x11\xd5\x01\x7d\xe3\x7e\xe6\x69\x1e\xca\xbe\xc3\x36\x35\x51\xb5\x5d\xb4\x1f\xcb\x51\x2d\xe7\x4d\x52\x87\x09\xf2\x16\xdf\x4b\x41\x45\x80\xd6\xa8\xed\x2f\xd2\x49\xae\x27\xb3\x8b\xaf\x13\xb2\x0d\xf2\x5f\xd3\xe9\x6b\xf4\xa9\x87\x47\xd2\x57\x81\x1d\x50\x99\xc2\x89\x95\x01\x2a\x99\xfd\xff\xba\x77\xc8\xb9\x59\x13\xd7\x66\xc7\x1d\x9b\xfd\xbe\x6e\x2d\x6e\xa6\xf3\xc9\xb7\x7d\xc7\x16\x9c\x85\xf8\x52\xe3\x1a\xbc\xc1\xd5\x7b\x97\x01\x1a\xfb\x77\x6a\xaf\xcf\xd9\x7f\x90\x58\xfa\x6f\x85\xf2\x4d\x28\x14\xbe\xa8\xa3\x9b\x3b\xd4\x44\x7b\x85\x48\xd3\x26\xc9\x36\x12\xfa\x2b\xf9\x75\x93\xff\x1e\x51\xae\x98\x7a\x25\x56\xf1\x76\xdc\x74\x1e\xf9\x4b\x94\x47\x8d\x7f\x2a\x28\xf7\x1c\x28\x14\x94\x9c\x28\x86\x08\xf8\x8c\x9b\x64\x48\xc0\xa7\x2f\x26\x19\x0e\x06\x83\xc1\xff\xc0\xb9\x05\x67\x2a\x4d\xb2\x3a\x59\x73\x35\xe8\xa8\x57\x91\x06\x1c\xad\x80\x48\x6d\x97\x58\x13\x6a\xbb\x8d\x0a\x66\x40\x99\x24\xd6\x1d\x65\xb2\x59\x65\x0e\xa8\xde\x5b\x77\xb4\x69\x25\x5f\x8a\x17\x62\x5d\x8a\x17\x6c\x06\xf7\x96\xc4\xba\x13\x11\x77\x7e\xa9\x6e\x9f\xca\xc8\x5c\x51\x15\x85\xc4\xca\xfe\x9f\xcc\x4a\x0e\x3f\x96\x97\x30\x67\x3c\xe6\xdb\x90\x58\xb7\x01\xf2\x46\x71\x38\x27\xd6\x27\x8f\x32\x1f\x9d\x46\xf0\x77\xc4\xba\x42\x8f\x6d\x50\x36\x14\x78\x4f\xac\x7b\xb4\x91\x6d\xd0\xf9\x81\x40\xc7\x31\x5b\x81\x71\x25\x45\x70\xbb\x5a\xcd\x3d\xa1\xc2\x24\xf9\xb9\x0c\xe4\x1c\xc4\xd2\x2f\x3d\xb1\x5a\xc1\x03\xf3\xf1\x64\x26\x0a\xb1\x63\xa9\x70\x0a\xea\x2d\x8b\x7e\xe2\xf8\x99\x29\x77\x6b\x7c\x92\x54\x66\xf7\xa2\x43\xac\x2f\x88\x01\xc4\xb1\x31\x57\x54\x2a\x43\xdf\x3f\xa9\x02\x32\x13\x1c\x3e\x53\x0e\xe7\x67\xf0\x6e\x34\x78\x0f\x77\x33\x92\x24\x87\xe3\x1d\xc7\xe8\x85\x78\x4a\xcd\x1d\xb3\x1f\x81\x82\x62\x3e\xc2\xb3\x8b\x5c\xfb\xc5\x7c\xc6\xd7\xb5\xa4\xdc\xd9\xe3\x8c\x63\x49\xf9\x1a\x0f\xa6\xa5\x46\xef\x1b\xbf\xce\x07\x83\x3f\xf5\x06\xc3\xde\xe0\xfc\x61\xf8\x61\x34\x78\x3f\x1a\x7c\xf8\xf7\xe0\xcf\xa3\xc1\x80\x24\x09\xb1\x9a\x85\x01\x94\xd0\x01\x9b\x70\x67\x8b\xcb\x27\x61\x36\x7f\x20\x49\x02\x9d\x38\x36\xee\x51\xf7\x02\x8c\xaf\x93\x04\x3c\x5c\xa9\x6e\x33\x47\xab\x2b\xb2\x9c\xcf\x29\x57\xf4\x11\xe7\xb6\x8b\x4e\xe4\xa1\x31\xe5\xa1\x92\x91\xad\x19\xc3\x24\x19\x87\x3e\xf5\xbc\xca\x62\xd1\x87\x1e\xe8\x3f\x3d\x3f\x52\xe8\xa4\xde\xe9\x1c\xa6\x48\xab\xac\xb7\xb2\xee\x4b\xc3\xcb\x48\x29\xc1\xf3\x83\x28\xfb\xb2\x5d\x8f\x4b\xc5\x61\xa9\x78\x2f\x90\xcc\xa7\xfa\xea\x2c\xb8\xed\x31\xfb\xd1\x24\x51\xda\xee\xe8\x13\xa8\xd3\x25\xe5\xe6\x67\xdc\xcf\x38\xac\xd6\xb8\xaf\x2d\xb4\x5a\x85\xb6\xd6\xae\x81\x0a\x6d\xc9\x02\x55\x69\xa1\xb2\xa1\xdc\x0c\xed\x50\xff\x3b\xdd\xd0\x6c\x34\x5f\xfb\x1b\x2a\x61\xa7\x17\x4c\x58\x45\x3c\x0d\x0d\x74\xba\x10\x6f\x43\xa9\x61\x12\x9f\xc0\x04\x8e\xcf\xf0\x6d\xf6\xf5\x6f\x4a\x05\x79\x4b\xd6\xe9\x7e\xac\xe0\xb4\x85\x13\x0f\x7d\xe4\x2a\x04\x13\x1c\x61\x47\xfa\xdd\x58\xa3\xca\x87\x2f\x5f\xa7\x4e\xa7\x5d\x74\x6d\xed\xae\x81\x39\xbc\x4a\x14\x50\x99\x32\x3c\x33\xee\x88\x67\xc3\x13\x36\xd5\x96\x19\x01\x55\xae\xde\xc7\x46\x18\x78\x4c\x75\x48\x9f\x94\x2c\x48\x85\x8c\x40\x04\xfb\x66\x05\x91\xba\xa3\xca\x3d\xc0\x27\x24\x5b\x33\x0e\x7f\xcc\x65\xbf\x0b\xc6\xf7\x48\xb5\x7c\x6e\x63\x9e\x16\xb3\x14\x1c\xfd\x14\x9d\xd0\xa8\xe2\xbe\xa1\xcd\x74\x74\x64\x3b\xed\xa2\x0d\x6a\x77\x8d\x74\x8f\x9d\x55\xe4\xb3\x6b\x60\xbd\x74\x76\xdb\x3c\x2c\x5b\xdc\x4d\x46\x70\x93\xde\x79\x3a\x75\x24\xc5\x7d\xa7\xa0\xe9\x56\x79\xf4\xcd\xa2\xde\x02\x7d\xad\x38\xac\x5f\x5f\xf0\xea\xe5\xf4\x0d\xb1\x46\x2e\x3d\x10\x4f\x5a\x9d\x9d\x9b\x35\x36\x4f\xaf\x4e\xca\x33\xa7\x46\x76\xdb\xbc\x9f\x36\x21\x47\xd6\x31\xe5\xad\xf7\x49\x9e\xbc\x73\x3f\xc0\x92\x7c\x6c\x6d\xdf\xf5\x6a\xcb\x4f\x28\xbd\x21\x6b\xb8\x72\x44\xbb\xb4\x4c\xd9\x0a\x3a\x85\xe0\x6f\xbf\x15\x1c\xb9\xae\xbd\x05\x5b\x59\xce\xc5\xc1\xa0\x0f\x58\x30\xab\x82\x3b\xfa\xa4\x6a\x62\xb8\xfb\xd1\x02\xcc\x93\x11\xdc\x82\x77\x31\xfc\xd8\x3a\x6c\xcc\xbc\x42\x5c\x56\x33\x86\x21\xfc\x05\x78\xe4\x79\x30\x2a\xeb\x2f\x05\x4f\xe2\x93\x21\x02\xe4\x1d\x72\xb7\x78\x20\x67\xc5\xb6\x2f\x29\x4b\x11\x5c\x22\x75\x5e\xf5\xd2\x42\xdb\x4d\x0f\xc7\xba\xd2\xa7\x3f\x12\x55\x24\x39\xb8\x94\x3b\x1e\x5e\x84\xaf\xdc\xbe\xc7\x30\x10\x3c\xc4\x8e\xc4\xa7\xad\x92\x33\x20\xff\x12\x11\x38\x82\xb7\x15\xb8\x74\x83\x10\xa0\xf4\x59\xa8\x7f\x96\xd1\x67\x5f\x56\x68\x41\xb9\x2c\x4c\x7b\xbf\x3f\x94\x6b\x4c\x79\x05\x68\x13\x43\xe4\x4e\xe7\xf3\xfc\xf6\xc6\x08\x95\x64\x7c\xcd\x56\xaf\x9d\x4a\x9c\xba\xdd\x8a\x44\x6a\xe1\x8a\x7a\x61\x9e\xb1\x82\x4f\xa7\x2a\xab\x1e\x60\x42\xbb\x68\x71\xb2\x7a\x93\x24\xed\x0c\x1c\x3e\x33\x65\xbb\xd0\xc9\x80\x65\xf7\x6d\x1a\x22\x64\x4d\xfb\x68\x3b\xa8\x9f\xda\xba\xbe\xeb\x6a\x75\x4b\xde\xee\x1a\x69\x9b\x8e\x0e\x98\xa0\x64\x79\x3d\xe9\xcf\x52\x22\x7d\xdc\x0d\x65\xda\xae\x27\x3f\xa7\x2f\xfb\x6d\xe3\x67\x34\xe6\xdd\xfb\x0f\x6b\xcc\xda\xf2\xe6\x1a\x8b\xa4\x1c\xa5\x9e\x57\x0a\x5e\x39\x6b\xd9\xcc\x36\x6b\x47\x59\x8a\x13\xe9\x10\x4f\x31\xd7\x8c\x69\x51\xaa\xfc\x65\x16\x3d\x9e\x32\x8c\xfb\xd9\x9d\xc2\x6a\xc5\x31\x72\x27\x49\xfe\x3b\x00\x33\x66\x51\x59\xd7\x15\x00\x00")
 
 func assetsTemplatesItemsEditHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -208,12 +260,32 @@ func assetsTemplatesItemsEditHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/items/edit.html", size: 3977, mode: os.FileMode(511), modTime: time.Unix(1586223492, 0)}
+	info := bindataFileInfo{name: "assets/templates/items/edit.html", size: 5591, mode: os.FileMode(436), modTime: time.Unix(1792433132, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesItemsImportHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\xdb\x6e\xdb\x38\x13\xbe\xd7\x53\xcc\xaf\x1f\xa8\x64\xc4\x91\xda\xc5\xde\x45\x32\xd0\xa6\xdd\x6d\x16\xe9\x61\xeb\x76\x51\xa0\xe8\x05\x2d\x8e\x2d\x36\x12\xa9\x90\x54\x1c\xd7\xf5\xbb\x2f\x46\xa2\x14\xfa\xd0\x66\x21\xc3\x12\x87\x73\x3e\x7c\xe4\x76\xcb\x71\x29\x24\x42\x58\x33\x21\xcf\x0b\x25\x2d\x4a\x1b\xee\x76\x41\x56\x3e\x9b\x5d\xd5\x8d\xd2\x16\xae\x2c\xd6\xf0\x01\x6f\x5b\x34\xd6\x64\x69\xf9\x6c\x16\x64\x0b\x3d\x0b\xb2\x66\x16\x00\x00\x7c\x6a\x2a\xc5\x38\x30\xb8\x9c\xff\x03\x4a\xc3\xe7\xeb\xf9\x67\x30\x8d\x46\xc6\x4d\x89\x68\x61\x2d\x6c\x09\x0c\x4a\x64\x1c\x35\x68\xb5\x06\xc9\x6a\x21\x57\x60\x4b\x84\x4b\x66\x71\xa5\xf4\x66\x0a\x7f\xa2\xe4\xa8\xa7\x30\x17\xdf\x11\x98\xe4\xf0\x77\xcb\xa4\x15\x76\x03\x85\xaa\xda\x5a\x9a\x69\x67\x8e\x76\x54\x63\x85\x92\xac\xaa\x36\xc0\xe0\x93\x14\xd6\xb1\x24\xf0\x41\xad\x4d\x6f\xb0\xd1\x6a\x51\x61\x6d\x80\x69\x04\x73\x23\x9a\x06\xf9\x14\x8c\x82\xa2\xc4\xe2\xa6\xb3\xdd\x68\xbc\x13\xb8\x86\x05\x2e\x95\x46\x10\x5d\xbc\x42\xae\x92\x2e\xae\xe7\x12\x32\x06\xa5\xc6\x65\x1e\xa6\xc2\x62\x6d\x52\xbc\x27\x8e\x70\xd6\xbf\xb3\x94\xcd\x40\x2d\x61\xa3\x5a\x0d\x1d\x03\x14\x4c\xc2\x02\x01\xb9\xb0\xc8\x3b\x57\x7b\xa5\xb4\x58\x31\x21\x93\x20\x4b\x9b\x59\x90\x2d\x95\xae\x41\xf0\x3c\xec\xb7\xff\x50\xba\x0e\xfb\x6c\x66\x5c\xdc\x41\x51\x31\x63\xf2\x90\xb8\xce\x57\x5a\xb5\x8d\xdb\xa4\x5f\x56\xb1\x05\x56\xb0\x54\x7a\x94\x16\x15\x86\xb3\xf9\x43\xc6\xb3\xb4\xe3\xf1\x64\x84\x6c\x5a\x0b\x76\xd3\x60\x1e\x2e\x89\x7d\xcf\x04\xd5\x5d\xab\xea\xbc\xdf\x91\xac\x1e\xb9\x3c\x17\xbb\x35\x2b\x0a\x6c\x6c\x1e\x26\x85\xb9\x9b\x26\xf7\x95\xb9\x0f\x41\xc9\xa2\x64\x72\x85\x79\xe8\xf2\xd9\xf7\x4d\x3c\x19\x22\x4a\xb9\xb8\x73\x9f\x8b\xd6\x5a\x25\x9d\x23\xfd\xc2\x37\xf2\xc2\x51\x94\x2c\x2a\x51\xdc\x0c\x64\xea\x40\x13\x4f\x46\xa7\x17\x56\xc2\xc2\xca\xf3\x46\x8b\x9a\xe9\x4d\x08\x5c\x18\xb6\xa8\x90\xfb\x2d\x6b\xb2\xb4\x37\x30\x0b\xb2\x94\xc2\x1c\xda\xd6\x12\xeb\xa0\xaa\x5f\x74\xff\xe7\xc6\x6a\xd1\x20\xf7\x1d\x7a\xdf\x47\x14\x42\x29\x38\x47\xe9\xa2\xb0\xd4\xca\xa3\x06\x5a\x9c\x73\xa6\x6f\xfc\x22\xd9\x72\x76\x2d\x24\x66\xa9\x2d\xf7\xa9\x43\xbf\x1f\xef\xf4\xfd\x7f\x4c\x9f\x8b\xef\x27\xf4\x0c\xc3\x71\xbc\x43\xf3\x70\x4c\x7d\xef\xc6\xe1\x61\x87\xbe\x90\xf1\x21\xa6\x85\xe2\x9b\x59\x96\xf6\xef\x20\x4b\xbb\x9c\xcc\x82\xed\x16\x25\xdf\xed\x82\xe0\x01\x2b\x4c\xa1\x45\x63\xf7\xd0\xa2\x27\xb9\xba\x5a\xbc\xb7\xe9\x37\x76\xc7\x7a\xaa\x4b\xcb\x1d\xd3\xd0\x76\x50\xe1\x8a\x94\xc3\xb2\x95\x05\x0d\x32\xc4\xae\x73\xa6\xa0\xe4\xbc\x2d\x0a\x34\x66\x02\xdb\xd1\x7f\x12\xd5\x78\x0b\x39\x48\x5c\xc3\xe7\x37\xd7\xaf\xad\x6d\x1c\x22\xc5\x93\x8b\x3d\x3e\xaa\xb4\x63\xa4\xa9\x7a\xc9\x2c\x8b\xb9\x2a\xda\x1a\xa5\x4d\x56\x68\x5f\x55\x48\x9f\x2f\x36\x57\x3c\x8e\x5c\x6b\x2b\x5d\x47\x13\x4f\x8f\x58\x8e\x1e\xf9\x6e\xd0\x43\xea\x13\xd6\x34\x28\x79\x1c\x39\x9e\x68\x0a\x91\xd5\x2d\x46\x9e\x8a\x5d\x30\x7e\x6a\xbc\x4d\x54\x83\x32\x0e\xdf\xbf\x9b\x7f\x0c\xa7\xb0\x16\x92\xab\x75\x52\xa9\x82\x51\xf4\x89\xd2\x62\x25\x24\x9c\x41\xe4\x60\xa6\x77\xcb\x57\xd7\xe9\x90\x34\xe0\x1b\x63\x99\xc5\x7e\xe2\xf6\x52\x78\xe8\x29\x05\x41\x62\x9d\xd0\x9c\x84\x20\xcf\x73\xf8\x1d\x9e\x3c\x01\xa2\x93\x9e\xd6\x74\xb4\xdf\x9e\x3e\x3d\x94\xa6\x67\xac\x45\xfc\xd7\xfc\xdd\xdb\xa4\x61\xda\xa0\x53\x69\x1a\x25\x0d\xfa\x39\xa3\x67\x07\x58\x19\x3c\xa1\xa9\x64\x92\x57\xf8\xdc\x6c\x64\xf1\xc1\xc9\x92\xa2\xe3\x54\x10\xda\x4e\x21\x7c\x27\xab\x0d\x98\x12\x2b\x8b\xba\x07\xd5\x3e\x25\x3d\xca\xfe\x2f\x3c\xb4\x3b\xae\x76\x0f\x1b\xe4\xa8\xa1\x32\x51\xc9\x9c\xc0\xee\x22\x18\x7b\xd1\x15\xef\x44\x33\xfa\xb9\xf0\x3b\x36\xa6\x22\x4f\x3d\x46\x8d\xa6\xad\xec\x61\xea\x48\x39\x0d\x11\xe4\x30\x36\xde\x6d\x8b\x7a\x33\xc7\x0a\x0b\xab\x74\x1c\xfd\x7f\x0f\x5d\xa0\x9b\x39\xbf\xdc\x83\x9a\x9e\x8d\x06\x11\x72\x78\xba\xbf\x4f\x32\x89\x90\x12\xf5\xeb\x8f\x6f\xae\x21\x87\x28\xda\x67\xe8\xbd\x4b\xe8\x44\x4c\x96\x4a\xbf\x62\x45\x19\x7b\xbe\xab\xf5\xa1\xe3\x83\x55\xab\x7d\xd7\x0b\x8d\xcc\xa2\x1b\x9b\x38\xb2\xfa\xd0\xd1\x41\x6c\x3c\x70\xf3\x4e\x7b\xf2\x4a\x6b\xa5\x0d\xfc\xf8\x01\x5f\xbe\x4e\x92\x9a\x35\x9e\x75\xa4\xbd\x09\x6c\x41\xa3\x6d\xb5\x84\x6e\x9d\xbc\x41\x63\xd8\x0a\x2f\x60\x37\x49\xbe\x29\x21\xe3\xe8\x02\x4e\x59\xfb\x42\xea\x09\x5e\xa7\x74\x9b\x48\x08\xed\x93\x01\x57\x3d\xd2\x70\xa1\x18\x09\x84\xa4\xde\x72\x80\x50\x8f\x44\xd8\x39\x1d\x23\xf9\x7a\x22\x6f\x77\xac\x6a\xf1\x54\xe6\xc6\xec\xf1\x5f\x65\x8f\x9f\x8a\x87\x1e\xcb\x13\xc2\xcd\xcb\x1e\x54\x21\x87\xce\xd0\x4f\x78\xb5\x83\xa0\xcb\x52\x54\x3c\xb6\xfc\x84\xce\xdd\x09\x1a\x41\xc2\x10\xdb\xcf\x42\xb0\x3a\xe9\x0e\xb6\xb7\xac\x46\x6a\xaa\xee\x18\x38\xe7\x04\x37\xfa\xa0\xc1\x7e\x39\xf2\xf4\x7b\x68\xdf\xb3\xb3\x13\xa2\x47\x94\xae\xa5\xf7\x22\xd3\x07\x51\x50\x54\x7b\x04\xca\xb8\xbb\x54\xe4\xf0\x08\xce\xf7\xb7\x8b\xc3\x02\xf4\xd2\x07\xc9\x8f\x1c\x2c\x44\x70\xe6\x0f\xe1\x19\x44\x74\xe1\x23\xaa\x3f\x5d\x15\xca\x95\x2d\x09\xc0\xbb\xcb\xb2\x89\x4e\x5a\x18\x2e\x29\x90\xfb\x2a\xf3\xfc\x68\xb2\x1f\x09\xc3\xa1\x46\x34\x49\xfa\x4b\x09\x61\x17\xab\x0c\x5e\x04\x87\xb5\xf7\xe1\xce\xbb\x49\xfd\x37\xb0\xeb\x74\x3e\x8e\x76\xac\x42\x6d\x63\x97\x2f\xe4\x7e\x6e\xc8\xd8\xd5\xcb\xbd\xf4\x74\xf8\x9d\x1c\x96\xe0\xe0\x14\x80\xfc\xf1\x23\x32\x3a\x19\x6d\x96\xf6\x57\x8e\x59\xb0\xdd\xa2\xe4\xbb\x5d\xf0\xef\x00\x4a\xb2\x56\x0f\xe2\x0c\x00\x00")
+
+func assetsTemplatesItemsImportHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesItemsImportHtml,
+		"assets/templates/items/import.html",
+	)
+}
+
+func assetsTemplatesItemsImportHtml() (*asset, error) {
+	bytes, err := assetsTemplatesItemsImportHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/items/import.html", size: 3298, mode: os.FileMode(420), modTime: time.Unix(1792431885, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesItemsItemHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x6f\xdb\x3c\x0c\xbe\xe7\x57\xf0\xd5\xa5\x09\xde\xc6\x3e\xf5\xb2\xda\x01\x8a\x25\xd8\x0a\x74\x87\xb5\xc3\xb0\x1d\x15\x8b\x89\xb5\xca\x94\x2b\xd1\xc9\x3c\xc3\xff\x7d\x50\xec\x7c\x38\x6d\xd7\x9e\x12\x3f\xe2\xf3\x41\x52\x6a\x1a\x85\x2b\x4d\x08\xa2\x90\x9a\xa6\x99\x25\x46\x62\xd1\xb6\xa3\x44\xe9\x0d\x64\x46\x7a\x9f\x8a\x4c\x3a\x25\x66\x23\x00\x80\x73\x78\x9a\xa3\x54\xe8\xc4\xec\x96\xb1\x80\x39\xb2\xd4\x26\x89\x95\xde\xbc\x52\xbe\xb4\xaa\xee\xa5\x76\xe7\xf9\xd5\xe0\x98\x35\x1b\x14\xb3\xa6\x89\x82\x5e\xf4\x51\x32\xae\xad\xab\xdb\x36\x89\xf3\xab\x13\x5a\x39\x64\xe1\x6f\x16\xb3\xaf\x95\x24\xd6\x5c\x7f\x80\x3d\x7d\x8f\x04\x7a\xf9\x06\xfb\x13\x92\x42\x77\xe4\x76\xdf\xef\x61\x3e\xe8\x3f\x78\xe4\x85\xaf\x77\xb1\x58\x72\xe5\x03\x0f\xfc\xee\xef\x8d\x7f\x60\xa7\x69\x0d\xbd\xce\x0e\x3c\x57\x92\x90\x3b\x5c\xa5\x22\x8a\xf7\x7e\xb7\xf3\xb6\x8d\x51\x69\x16\xe0\xac\xc1\x54\x2c\x2b\x66\x4b\x62\x6f\xb9\x64\x82\x25\xd3\xb4\x74\xba\x90\xae\x86\x5d\x04\xa3\xe9\x51\xcc\x16\x4a\x73\x12\xcb\x17\xe4\x63\x9f\xa3\x61\x74\xfe\x60\xf3\xd0\x01\xc1\xed\x0d\x23\x8f\x99\x25\x75\x66\xf5\x5d\xe3\x16\x7a\x8d\xa1\x65\xa7\x02\x96\x32\xa3\xb3\xc7\x54\x28\x34\xc8\x18\x3c\xc7\x93\x67\xda\x4a\xd2\x1a\xdd\xa9\xf0\x7c\x57\x9e\xc4\x9d\x4c\x7f\xe9\xfe\x71\xff\x56\xd6\x32\x3a\x08\x3b\x98\x16\x15\xa3\x12\x2f\xb5\xaf\x19\x0b\x1f\x8b\x01\xf3\xa4\x91\x1b\x63\x20\x24\xf4\x87\x56\x7a\xcb\xfe\xa7\x69\x90\x54\xdb\x8e\x46\xc7\xe7\xe5\x33\xa7\x4b\x1e\x3c\xb0\x0e\x02\xae\x4b\x4c\x45\x08\x14\xff\x92\x1b\xd9\xa1\x7d\xaa\x8d\x74\x70\x1c\x08\xa4\xb0\xaa\x28\x63\x6d\x09\xc6\x13\x68\x0e\xc1\x43\x99\xc3\x27\x48\x81\x70\x0b\x3f\xbe\xdc\x7d\x66\x2e\xef\xf1\xa9\x42\xcf\xe3\xc9\xf5\xa1\xce\xe1\x53\x64\x4b\xa4\xb1\x98\x2f\xee\x16\xdf\x16\xe2\x12\xb6\x9a\x94\xdd\x46\xc6\x66\x32\xe8\x4e\x86\xb5\xe4\x50\xaa\x3a\xdc\x4f\xcc\xf2\x30\xfb\x57\x23\x74\x14\xae\x1c\x41\x2e\x49\x19\xbc\xf1\x35\x65\xf7\xe8\x4b\x4b\x1e\xc7\x83\xba\x5e\xfe\xf2\x19\x78\x16\x26\xb2\x4e\xaf\x35\xc1\xff\x70\xd1\x6d\xe4\xe2\x39\x45\xfc\xb4\x15\x28\x4b\x17\x0c\xb9\xdc\x20\x94\xe8\x0a\xed\x7d\xc8\xc7\xb6\x9f\x1d\x70\xae\x3d\x04\x85\xff\xc4\x40\xe0\x64\x34\xed\xf5\x68\xd0\xba\x47\x52\xe3\x6e\x1a\xed\x28\x89\xbb\xad\x1c\x36\xfb\x37\x00\x00\xff\xff\x0c\xaa\x3c\x2e\x37\x05\x00\x00")
+var _assetsTemplatesItemsItemHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x6d\x73\xdb\xb8\x11\xfe\xee\x5f\xb1\x87\xc9\x9c\xa4\x89\x45\x66\xd2\xdc\x7d\x38\x4b\x9a\x49\x2d\xf7\xea\x6b\xd2\x24\x96\xd3\x69\xfb\x0d\x22\x96\x22\x2e\x24\xc0\x00\xa0\x1c\x95\xc3\xff\xde\x59\xbe\x53\xa2\x6c\xcf\x5d\x5f\x12\xce\x88\x04\xb1\xcf\x3e\xd8\x77\x3a\xcf\x05\x86\x52\x21\xb0\x84\x4b\x35\x0f\xb4\x72\xa8\x1c\x2b\x8a\x8b\x85\x90\x7b\x08\x62\x6e\xed\x92\x05\xdc\x08\xb6\xba\x00\x00\x38\x5e\x9e\x47\xc8\x05\x1a\xb6\xba\x75\x98\xc0\x1a\x1d\x97\xf1\xc2\x17\x72\x7f\x66\xfb\x56\x8b\x43\x0d\x45\xd7\x22\xfa\x61\xf0\xda\x49\x17\x23\x5b\xe5\xb9\x47\x78\xde\x35\x77\xb8\xd3\xe6\x50\x14\x0b\x3f\xfa\xa1\x27\x96\x0e\xa5\xf0\x9b\x63\xab\x4f\x19\x57\x4e\xba\xc3\x4f\xd0\x88\xff\x49\x9b\x84\x3b\x87\xa2\x79\x45\x38\xe9\x13\x30\x3f\xa3\x12\x68\x3a\x90\xea\xf9\x39\x92\x1b\xf9\x2f\xec\xe4\xe8\xe9\x59\x52\x8e\xbb\xcc\x92\x1c\xd8\xf2\xf6\xad\xdd\x38\x23\xd5\x0e\x6a\x9c\x72\xf1\x08\x29\xcf\x1f\xa4\x8b\xc0\x5b\x1b\x9d\x7e\x08\xc3\xa2\x18\x85\xa6\xb7\x73\x1d\x86\x04\xee\x6d\x1c\x37\xae\x36\x09\xb0\xf7\x5a\x09\x7e\xb8\x84\x5f\xb8\xca\xb8\x39\xc0\x6b\x08\x8d\x4e\xe0\x0f\x3f\xbd\x7a\x03\x1f\xdf\xb3\xa2\x00\xa7\x49\xea\x46\x89\x56\xa6\x7e\x09\xef\x37\xf7\x6c\x9c\xcf\x0b\xef\x56\x39\xfe\x05\x37\x41\x84\x22\x8b\xd1\xbb\x55\xd6\x99\x2c\x70\x52\x2b\x3b\x4a\x12\xc8\x08\xf3\x24\x73\x28\x4a\xb7\x57\xb8\x79\x8e\x4a\x14\x45\xfd\xd3\xd3\x22\x43\xe0\x4a\xc0\x91\x1a\x98\x2a\xed\x6a\x73\x5d\xc7\x5c\x26\x6b\xe4\x22\x96\x0a\xbd\x5b\xfb\x4f\x34\x7a\xf6\x88\x7d\x40\x87\x21\x6c\xcb\xa0\x99\x8e\x22\xa8\x63\x6d\xde\x3b\x1d\x70\x3a\xd1\xec\xbc\x35\xd9\xe0\x1c\xc7\x66\xf2\xd6\x18\xcb\x3d\x9a\xc3\x1d\x06\x28\x53\x77\x8e\x5e\xbd\x0b\x02\xad\x42\x69\x12\x14\xc0\x1d\xc8\xf2\xe8\xc4\x37\x2c\xb5\x7f\x56\xf2\xdb\xbd\x4c\x10\xbc\x12\x6d\x8f\x82\x9e\xc6\xf5\x2f\x38\x44\x06\xc3\x25\xf3\xfc\x26\x4c\x6f\xd7\x45\xe1\xa3\x90\x8e\x81\xd1\x31\x2e\xd9\x36\x73\x4e\x2b\xd6\xf0\xd9\x3a\x05\x5b\xa7\xe6\xa9\x91\x09\x05\x4a\xc9\x2f\x96\xea\x0b\x5b\xdd\x08\xe9\x16\x3e\x5f\x9d\xc2\xfb\x36\xc2\xd8\xa1\xb1\xad\x9a\x4d\xb5\x40\xda\x9e\x50\x64\x31\x20\x6b\x0e\x55\xfd\x4d\xe2\x03\xd4\x18\x43\x95\x15\x0a\x68\x15\xc4\x32\xf8\xb2\x64\x02\x63\x74\x48\x3a\xa7\xb3\x13\x6c\xc1\xd5\x0e\x4d\x1f\x78\x5d\x6e\x5f\xf8\x15\x4c\x87\x9b\xe7\x32\x04\xfc\xda\x73\x95\x8e\x11\x98\xe5\x09\x37\xd2\x71\xc5\x8a\xe2\x3c\x87\x1d\xba\x46\xec\x5a\x0b\x1c\x21\x62\xb3\x20\x40\x6b\xfb\x4c\x7e\x46\x07\x8d\x14\x90\xd8\x18\x29\x8c\x2d\xc2\x38\xb3\xca\x38\x6c\xcc\xdb\xbe\x74\x98\x58\xdf\x54\xf1\xf1\x94\xfd\x4f\xa9\x5d\x57\xf1\xd7\xd2\x1b\x78\xa0\x1f\x63\xbd\xca\xff\x5c\x03\x8e\x76\x08\xd8\x6a\x23\xd0\xcc\x9d\x4e\xab\x02\x11\xa0\x72\x68\x18\x48\x51\x7a\xb8\x35\x2d\x83\x48\x0a\x81\x3d\x1b\x8d\x66\xd2\x26\xd2\x0f\xe0\x22\x69\x21\xd0\x02\xa9\xb4\xb9\x08\xa1\x36\xd9\xc4\x52\xe1\x0d\x43\x78\x88\x50\xc1\x41\x67\x20\xa8\x2e\xd0\x0e\xb2\x1b\x15\x08\x0f\x6e\x1d\x04\x9c\x5c\x1c\x1f\x60\x8b\x90\x59\x14\x14\x73\xe8\x0d\xca\xe0\x42\x26\xbb\x01\xc7\x4f\x77\xe4\x49\x06\x3c\x76\x4b\xd6\x58\x02\x3e\xdd\x95\x3c\x06\x7d\xf0\xcd\xc9\xd9\xee\x89\x7a\x73\x98\xd2\x0a\x89\x56\xda\xa6\x3c\x40\xb6\x5a\xf8\xd1\x9b\xd5\xa9\xcd\x3b\x57\x94\xf6\xd7\x06\xbc\xb7\xce\xf1\x20\x4a\x50\x39\xdb\x7f\xf8\x8b\x54\xe2\x99\x2e\x18\xf0\xfc\x71\xb0\xd3\x66\xdb\xb2\x65\x43\xb2\x9d\xbf\x1e\xd4\xf2\x9e\xda\x85\x1f\xfd\xd8\x41\xe4\xb9\xa1\x2c\x1c\x10\xab\x89\xd0\xb5\x08\xe5\x2e\x33\xd8\x68\xa9\x9f\x12\x33\x7f\xdd\xa3\x31\x8c\x6e\xde\x01\x95\xf5\x66\x5d\x14\x6c\x55\xba\xc2\x9a\x60\xfc\xbd\xef\xa2\x2c\xd9\x2a\x2e\xe3\xd6\xc2\x95\xa6\x39\x89\xc9\x64\x37\xef\x6d\x28\x9d\x97\xe7\x5e\x65\x32\xb6\x1a\x84\x7f\xc3\x39\xe0\x29\xf5\x84\x23\xb4\x7a\x95\xad\xda\x74\x20\x10\x60\x65\x2a\xa6\x34\x68\x35\x3d\x1a\xea\xa5\x2a\xc7\x8b\xe2\xe6\x1b\x4f\xd2\x18\x21\x8d\xb4\xd3\x7d\xc7\xf6\xff\x95\xa8\x2f\xbc\xcf\x16\xcd\x06\xad\x95\x5a\x15\xc5\x99\x8a\xd8\x59\x7b\x5a\xdb\xe0\xb4\x2a\x51\xb6\x57\x35\x20\x61\xab\x3b\x4c\xf4\xbe\x2b\x42\x63\x14\x16\x7e\x77\xf0\xce\x22\xe5\x6a\x66\xb0\x5b\x39\xd7\x06\x47\xa3\x91\xae\x05\xb5\xb6\x32\x21\x3a\xdf\x51\xaf\xed\x9c\xa5\x4d\x32\x97\x8a\x7a\xfc\x71\x58\x48\x95\x66\x0e\xdc\x21\xc5\x25\xab\x8a\x03\x03\xc5\x13\x5c\x32\x6a\x0a\xb7\x6b\x06\x7b\x1e\x67\xb8\x64\x79\xfe\xa2\x6b\x81\xcf\x47\x21\xaa\x3d\x0c\xef\x71\xd9\x50\xc6\x38\x64\x4d\x33\xb6\xd1\xf1\xbc\x7a\x53\x61\x56\xf7\x3c\x08\x30\x75\x4b\x26\x13\xbe\x43\xff\xd7\x14\x77\x97\xd5\x6d\xaa\x9a\xbb\x9d\x0c\x8f\xb5\xd5\xee\xae\xd4\x35\x35\xbd\x75\x3e\x17\xa2\xe7\xf9\x53\x97\xeb\xcc\x91\x11\x9b\xf6\xde\x0b\xd4\x7e\x90\x56\x10\x70\x37\x0c\xd1\x7a\xf5\x63\x2f\x42\x4f\x7b\xd6\xc2\x27\x5f\x8e\xc7\xc2\x99\xd2\x75\x52\x8f\x42\xad\x1d\x9a\x41\x79\xb9\x38\x2d\x03\x54\xac\xad\xdf\x9e\xb0\xd7\xbf\xca\xe9\xe1\x6d\x1c\x03\xb9\xdb\xb6\xe9\x5b\xab\xaf\x7f\x1a\x06\x17\xdd\x37\x91\x0d\x8c\x4c\xdd\xe0\xab\xa8\x5a\xaa\x9d\x4b\x84\xfc\x5f\xf9\x9e\x57\xab\x35\xab\x3d\x37\xd0\x4d\x21\xb0\x84\x30\x53\xe5\x04\x0c\xd3\x19\xe4\x2d\x71\xda\x66\xf0\x2b\x2c\x41\xe1\x03\xfc\xfd\xfd\xbb\x3f\x3b\x97\xde\xe1\xd7\x0c\xad\x9b\xce\xae\xda\x7d\x06\xbf\x7a\x3a\x45\x35\x65\xeb\x9b\x77\x37\xf7\x37\xec\x12\x1e\xa4\x12\xfa\xc1\x8b\x9b\x39\x74\xb8\x57\x19\xe4\xe2\x40\xdf\x12\x18\x44\x65\xa9\x3d\x47\x81\xfe\x1b\x74\x99\x51\x10\x71\x25\x62\x7c\x6b\x0f\x2a\xb8\x43\x9b\x6a\x65\x71\x3a\xd8\x57\xc3\x5f\x9e\x2c\x1e\x91\xf1\xb4\x91\x3b\xa9\xe0\x25\x4c\xba\x29\x70\x02\x2f\x61\x64\x12\x3c\x05\x63\xff\xa0\xf6\xab\xd5\xc4\x41\xc4\xf7\x08\x29\x9a\x44\x96\x85\x8d\x9a\x76\x65\xd5\xaa\x91\x93\xb7\xbf\x63\x03\x80\x9e\xd1\x8a\xab\x8b\xf6\x9e\x0c\x68\x51\x89\xe9\xac\x5c\x2a\x2e\x5a\x2f\x1d\xcd\x69\xff\x41\x57\x7d\xfc\xb0\xb9\x67\x97\x8f\xd9\x86\xf8\x0f\x0d\x43\x16\x29\xcd\xd6\x1f\x02\x26\xb3\xab\xdf\xe3\x5d\x19\xc2\x94\xce\x5f\x0a\x6d\x28\x24\x60\xb9\x5c\xc2\x1b\xf8\xfe\xfb\x12\x8e\x70\x32\x5b\xae\xbd\x7e\xf5\xea\x58\xba\x39\x7b\x43\x08\x96\xf0\xcb\xe6\xc3\x5f\xbd\x94\x1b\x8b\x35\x6e\x15\x2b\xb3\xab\x13\x41\xa1\x83\x8c\x8a\x8e\xb7\x43\x77\x13\x23\xdd\xfe\xf1\x70\x2b\xa6\x93\x06\xad\x9a\x8d\x26\x33\xcf\x9a\x00\x96\xad\x12\xaf\x5a\xff\x0d\x80\xcd\xd0\x34\x99\x79\x94\x99\xd7\x55\xda\xf6\xa1\x69\x87\xe7\xf4\xe7\x34\x45\x73\xcd\x2d\x4e\x67\xbf\x51\xcd\x64\xe6\x55\x5d\x81\x62\x86\xc7\xf6\x88\x6e\x01\xd4\xc4\x47\xac\x39\x96\x66\x94\x55\x27\x91\x42\x35\xed\x12\xd8\x07\x15\x1f\xca\x49\xb4\x9d\x9b\xe1\x21\xd2\x54\xe2\x24\x7d\x0e\xb6\x99\x50\x0e\xa7\x3b\x74\x20\x9d\x6d\x8f\x5b\x0e\x99\xdf\xb1\xa3\x33\x76\x5d\xb6\xb8\x1a\xc9\x91\xab\xe3\x24\x19\x74\x90\xff\x69\x8a\xf4\xe7\xb6\xdf\x99\x07\x8f\x54\xb9\xc7\xcc\x4f\xd5\x28\xe0\x54\x8d\x2a\x2e\x40\x8d\xda\x52\x29\xea\xd5\xa0\xd9\xd5\xa3\x16\xa5\xea\x4e\xb3\xcb\x9a\x3b\x3e\x3d\x1b\x5c\xc3\x39\x67\x32\x9b\x9d\x3a\xe2\x78\x8e\x1b\x1c\x58\x8a\xff\x4e\x6f\x39\xeb\x0f\x78\x09\x52\xfc\x5f\x9c\xd2\xe6\x44\x96\xc6\x9a\xfe\xf8\x08\xda\xf4\xbf\xe7\xca\x5c\x30\xe5\xf4\x5a\xb5\x8a\x8e\xf7\x93\xce\xaa\x5f\x17\x17\x0b\xbf\xea\xe9\xab\x8b\x3c\x47\x25\x8a\xe2\xdf\x03\x00\x17\x0e\x92\xd3\x2a\x15\x00\x00")
 
 func assetsTemplatesItemsItemHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -228,12 +300,12 @@ func assetsTemplatesItemsItemHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/items/item.html", size: 1335, mode: os.FileMode(511), modTime: time.Unix(1581215359, 0)}
+	info := bindataFileInfo{name: "assets/templates/items/item.html", size: 5418, mode: os.FileMode(436), modTime: time.Unix(1792433600, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesItemsItemsHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xc1\x8a\xdb\x30\x10\xbd\xfb\x2b\x06\xb1\xc7\x3a\x62\xaf\x45\x16\x2c\x2d\x94\xbd\x14\xda\x40\xef\x72\x34\x89\x45\xe5\x71\x2a\x8d\x49\x53\xa1\x7f\x2f\xf6\xda\x5e\x27\x69\x43\x2e\x46\xf3\xe6\x69\x46\xf3\x9e\x27\x25\x8b\x7b\x47\x08\xa2\x35\x8e\xca\x5d\x47\x8c\xc4\x22\xe7\x42\x35\xcf\xfa\xc5\x7b\x78\x65\x6c\xe1\x3b\xfe\xea\x31\x72\x54\xb2\x79\xd6\x85\xaa\x83\x2e\x14\x9b\xda\x23\xec\xbc\x89\xb1\x12\x6f\xc1\xf8\x2d\x23\x07\x77\x44\x2b\x74\x01\x00\xa0\xb8\x41\x63\x17\xde\x10\x94\xd6\x84\x9f\x53\x7a\xa2\xe8\x4f\x86\xf1\xd0\x85\xb3\x92\xdc\x5c\x66\xbe\x20\x59\x0c\xb7\xf8\xd6\xfd\xc1\x5b\xf4\x5b\x6f\x88\x1d\xff\xa3\xce\x96\x0d\xf7\xf1\x16\x5f\x82\x11\x30\xf3\x4b\x6b\x26\xa8\x99\xca\xd8\x1a\xef\xc7\x53\xd7\xb3\x77\x84\xe5\x31\xb8\xd6\x84\xb3\x80\xd0\x79\xac\x44\xdd\x33\x77\x24\xa0\x09\xb8\xaf\x84\x74\x8c\x6d\x94\x84\x27\xa1\xbf\xe2\x69\x94\x4f\x49\xb3\x6a\xb9\x3c\x60\x38\xa1\xb1\xb3\x4c\x75\x67\xcf\xef\xb4\x94\x82\xa1\x03\xc2\x93\x23\x8b\xbf\x3f\xc0\x13\x7a\x6c\x91\x18\x3e\x56\xb0\x19\x8a\x46\xc8\x79\x35\x47\xb8\x9a\x83\xad\x4e\x69\xb9\xb5\x99\xe5\x85\x9c\x95\x64\x7b\x9f\xfc\xa6\xf8\x43\xd4\xc1\x84\x87\x88\xb3\x2f\xf7\xc8\x71\x74\xe8\x25\x6e\x39\x38\x3a\xac\x9a\x8c\xf8\x7f\x6f\x2a\x33\x69\xbf\x91\xeb\x8e\xaf\x9f\x21\xe7\x6b\x93\xae\xcc\x75\xb4\xef\x84\xfe\xe1\xf0\x34\x78\x74\x59\x5e\xc9\xb5\xa8\x29\x21\xd9\x49\x71\x25\x27\xb3\x94\x1c\xff\x78\x5d\xcc\xd9\xe2\x7d\x9f\xe2\x2e\xb8\x23\xaf\x36\x6a\xe2\xfc\x0d\x00\x00\xff\xff\x4a\x0c\x7d\xca\x72\x03\x00\x00")
+var _assetsTemplatesItemsItemsHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xdd\x6a\x1b\x3d\x10\xbd\xdf\xa7\x18\x44\x2e\x3f\x5b\xe4\x36\xc8\x02\xf3\x95\x94\xdc\x14\x1a\x43\xef\x65\x6b\x6c\x8b\x6a\x47\xae\x34\x66\xeb\x0a\xbd\x7b\xd1\x66\x7f\x9c\xb8\x09\x61\x97\x65\xe7\xcc\x99\x1f\xce\x91\x72\xb6\xb8\x77\x84\x20\x5a\xe3\x68\xb1\x0b\xc4\x48\x2c\x4a\x69\xd4\xf1\x5e\xaf\xbd\x87\x27\xc6\x16\x9e\xf1\xd7\x19\x13\x27\x25\x8f\xf7\xba\x51\x27\xfd\x18\xbc\x0f\x1d\x10\x76\x10\x87\x1c\x38\x02\x03\x7b\x44\x0b\x11\x8d\xc5\xf8\x00\xca\xc0\x31\xe2\x7e\x25\x64\x85\x93\x74\x8c\x6d\x5a\x1a\x0e\xad\xd0\x6b\x0e\xad\x92\x46\x43\x88\xef\xf0\x62\x4a\x42\x3f\x6f\x36\x95\xb5\x54\xf2\xa4\x1b\xc5\x66\xeb\x11\x76\xde\xa4\xb4\x12\x2f\x41\xff\x5d\x24\x8e\xee\x84\x56\xe8\x06\x00\x40\xf1\x11\x8d\x9d\x78\x35\x58\x58\x13\x7f\x0e\xe9\x81\xa2\xff\x37\x8c\x87\x10\x2f\x4a\xf2\xf1\x75\xe6\x2b\x92\xc5\x78\x8b\x6f\xdc\x1f\xbc\x45\xbf\x9f\x0d\xb1\xe3\x7f\xf4\xd9\xb0\xe1\x73\xba\xc5\xa7\xa0\xbe\xca\x8c\x9b\x6e\x99\x60\xcb\xb4\x48\xad\xf1\xbe\xff\x0b\x67\xf6\x8e\x70\x71\x8a\xae\x35\xf1\x22\x20\x06\x8f\x2b\xb1\x3d\x33\x07\x12\xa3\x6a\xbd\xae\x92\xb0\x13\xfa\x1b\x76\xbd\x65\x55\xb4\x79\xe4\xb4\x40\x5d\x05\x8d\x1d\x65\xda\x06\x7b\x99\x69\x39\x47\x43\x07\x84\x3b\x47\x16\x7f\xff\x07\x77\xe8\xb1\x45\x62\x78\x58\xc1\xb2\x36\x4d\x50\xca\xdc\x94\xe3\x5c\x5a\x1f\xc5\x56\xe7\x3c\x55\x2d\x47\x79\xa1\x14\x25\xd9\x7e\x4c\x7e\x51\xfc\x53\xd4\x6a\xc2\xa7\x88\x8f\x21\xb6\x86\x19\xed\x68\xd0\x47\x55\xa9\xb7\x6a\x9d\x36\x1c\x1d\x1d\xae\xa6\xf5\xf8\xbb\x95\xd3\xd1\x5d\xca\xeb\xd1\x4f\x5f\xa0\x94\xb7\x6e\xbd\x71\xd9\xd1\x3e\x08\xfd\xc3\x61\x57\xcd\x7a\xdd\x5e\xc9\x6b\x75\x73\x46\xb2\x83\xf4\x4a\x0e\xae\x29\xd9\x1f\x7d\xdd\x8c\xd9\x66\xbe\xcc\x69\x17\xdd\x89\xaf\xae\x73\xce\x48\xb6\x94\xbf\x03\x00\x0d\xc7\x6f\x90\xef\x03\x00\x00")
 
 func assetsTemplatesItemsItemsHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -248,12 +320,12 @@ func assetsTemplatesItemsItemsHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/items/items.html", size: 882, mode: os.FileMode(511), modTime: time.Unix(1579052048, 0)}
+	info := bindataFileInfo{name: "assets/templates/items/items.html", size: 1007, mode: os.FileMode(436), modTime: time.Unix(1792433591, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesItemsNewHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x5f\x6f\xe3\x36\x0c\x7f\x2f\xd0\xef\xc0\xe9\x25\x36\xae\xb5\xd1\xd7\x9e\x6d\xa0\xed\xa5\x5b\x77\xfd\xb3\xd5\x57\xec\xf6\xa8\x58\x4c\xa2\xd5\x96\x5c\x49\x4e\x2f\x0b\xfa\xdd\x07\xc9\x56\x1c\x37\x49\xbb\x7f\xc0\x96\x07\x5b\xa2\xc9\x1f\x7f\xa4\x48\x31\xab\x15\xc3\x29\x17\x08\xa4\xa2\x5c\x1c\x17\x52\x18\x14\x86\xbc\xbc\x1c\x1e\x24\xf3\x93\xec\xca\x60\x05\x17\x0a\xa9\xe1\x52\xc0\xa5\x54\x55\x12\xcf\x4f\xb2\xc3\x83\x64\xa2\xec\x73\x2a\x55\x05\x9c\xa5\xa4\xb0\x3a\x68\x15\x48\x76\x78\x00\x00\x90\x30\xbe\x80\xa2\xa4\x5a\xa7\xc4\xaa\x1d\xcf\x94\x6c\x6a\xff\xd5\x69\x94\x74\x82\x25\x4c\xa5\x4a\x09\x37\x58\x5d\x50\x83\x33\xa9\x96\x24\xf3\xab\x24\x76\x2a\x9b\x36\x1a\x4b\x2c\x8c\xf3\x39\xb0\x19\xb8\xb2\x61\x28\x59\x12\x10\xb4\xc2\x94\x14\x6b\xe0\x1e\xc8\x81\xc9\xda\xc5\xb5\xa0\x65\x83\x29\xc9\xef\x2e\x3e\xe7\x24\xcb\x65\xf1\xa8\x93\xb8\xfd\xf6\xb6\xc5\xc3\xed\xa7\xf1\xfd\x2f\xe3\xb3\x7b\x92\x3d\x08\x86\xea\x19\xa9\xfa\x73\x96\xe7\xd7\x67\xb7\x9f\xc7\x5f\x72\x92\x9d\x97\x54\x3c\xa2\xd9\xe5\x31\x89\xdb\x68\x7d\x46\x63\xc6\x17\x7e\x3d\xe5\x58\x32\x8d\xe6\xbd\x14\xe3\x0c\x05\xcb\xbe\x47\x4b\x2f\x89\xbb\xed\x86\xc2\xeb\x53\x2a\xe6\x58\x3c\x6e\x25\x8a\x8b\xba\x31\xdb\x7a\xc7\x4e\x4e\xc0\x2c\x6b\x4c\x89\xa2\x8c\x4b\x9f\xf3\x99\xf3\x48\xd6\x07\xd5\x32\xb8\xc4\x8a\x96\x48\x7c\x16\x2e\xc7\x37\x67\xd7\xe3\x2d\x6f\x6d\x5d\x6c\x7b\x73\x72\xd2\x17\xcc\x00\x33\x6b\xdf\x3b\x4a\x66\x23\x6d\xff\x41\xc8\x37\x9b\x01\xff\x0b\xe1\x3a\xbc\xec\xe6\xff\x18\xea\x83\xe0\x1a\xbf\x91\xbe\x3b\xae\xf2\xf1\xd7\x7f\x18\x6e\x87\x99\xb5\xef\xb7\x43\x4e\x62\xdf\x15\x7f\xfb\x0e\xca\xf9\xef\x48\x32\xfb\xdc\xe1\xaa\xcd\x53\x9b\x0e\x83\xdf\xcc\x9b\x97\x8e\xb6\x48\x50\x97\xb4\xc0\xb9\x2c\x19\xaa\x94\xb8\xdb\xd4\x79\x58\x27\xae\xf5\xb7\xa3\xbf\xff\x32\xf3\x9f\x1b\x2a\x0c\x37\x4b\x92\xf9\xd5\x3b\x11\x88\xa6\x9a\xd8\x33\x7c\x23\x86\x27\x8f\xb9\x23\x8e\xb5\xbf\x75\x2c\x3d\x83\x1d\xf1\x4c\x1a\x63\xa4\xe8\x5c\xb7\x1b\x02\x52\x14\x25\x2f\x1e\xfd\xf8\xb0\xb8\x41\xb8\x66\x34\x31\x02\x26\x46\x1c\xd7\x8a\x57\xd4\x8d\x05\xa7\x05\x56\x2d\x89\x5b\x0c\x3b\x84\x62\x4b\x3d\x3b\x3c\x58\xad\x50\x30\x3b\xb9\xec\xd2\x8f\x35\x5d\x28\x5e\x9b\xe1\x60\x6b\x65\x1b\x07\x19\xff\x46\x17\xb4\x95\x7a\xf2\x0b\xaa\xa0\x67\x05\x29\x4c\x1b\x51\xb8\x1b\x3c\x08\x61\xd5\xa7\xd4\xea\x29\x7c\x82\x14\x04\x3e\xc3\xd7\x9b\xeb\x1f\x8c\xa9\xef\xf1\xa9\x41\x6d\x82\xf0\xe3\x50\xd1\x12\x1d\x97\x58\xa1\x30\x1a\x52\x60\xb2\x68\xec\x3a\x9a\xa1\xe9\xc4\xe7\xcb\x2b\x16\x8c\xfa\x69\x3a\x0a\x23\xec\x0c\x5e\x61\x75\xe2\x87\x9a\xd9\x9c\xa4\x9b\x9c\xec\xcf\x0f\xc6\xd3\x81\xd3\xc8\x9e\x2b\x73\x79\x1e\xf9\xa9\x38\x0a\x23\xd7\xb0\x47\x43\x80\xb6\xfd\xf6\x9b\xb7\x57\xc0\x1e\x63\x5f\x0a\xa7\x70\xeb\xaa\x2c\xd8\x87\xe2\x2b\xcc\xe3\x84\xaf\x80\x6c\x7f\xec\xe7\x60\x7b\x6c\x0f\x83\xdc\x50\xd3\xe8\x53\x38\xe9\xc5\x2f\x1f\x6d\x69\xf8\x9d\xc2\xa7\x48\xd6\x28\x02\xf2\xd3\x5d\xfe\x85\x1c\xc1\x33\x17\x4c\x3e\x47\xa5\x2c\xdc\xbf\x9d\x48\x2a\x3e\xe3\x02\x3e\xc0\x28\xb6\xd5\xad\xe3\xd1\xe6\x71\x3a\x73\xa1\x90\xb2\xa5\x36\xd4\x60\x31\xa7\x62\x86\xfb\xeb\xc4\xfe\xe6\x54\xb0\x12\xcf\xf4\x52\x14\xf7\xa8\x6b\x29\x34\x06\x43\x8d\x0e\xf9\x68\x5b\xfa\x3e\x3b\xf8\x00\x3f\xe6\x77\xb7\x51\x4d\x95\xc6\xc0\xf2\x53\x9d\x93\x30\xba\xfa\xb4\x03\x92\xfc\x2a\x1b\x60\x52\x8c\x0c\xcc\xe9\x02\xa1\x46\x55\x71\xad\x2d\x77\x23\xbb\xe2\x07\x2a\xc0\xe2\x7f\x47\x86\xf6\x9b\xa9\xd8\xce\xab\x46\xc1\x02\x47\x46\x1b\xc5\xc5\x8c\x4f\x97\xc1\xa0\x5e\xc3\xf0\x95\x8d\x69\x94\x80\x29\x2d\x35\x76\xc0\xb6\x4f\xe3\xb6\x25\xfb\xd6\xfe\x23\x00\x00\xff\xff\x0a\xf9\x5a\x1f\xae\x0a\x00\x00")
+var _assetsTemplatesItemsNewHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x5d\x6f\xea\x38\x13\xbe\x47\xe2\x3f\xf8\xf5\x0d\x41\xa7\x4d\xda\xdb\x36\x89\xd4\x0f\xfa\x6e\xf7\xf4\x6b\xcb\x41\x7b\x56\xab\xbd\x30\xf1\x00\xde\x26\x76\x6a\x3b\x14\x16\xf1\xdf\x57\x93\xc4\x40\x5a\xa0\xad\xf6\x48\xbb\x02\x91\x64\x32\xf3\xcc\x3c\xf6\xcc\x78\x58\x2c\x38\x8c\x84\x04\x42\x33\x26\xe4\x61\xa2\xa4\x05\x69\xe9\x72\xd9\x6e\x85\x93\xe3\xf8\xda\x42\x46\x2e\x34\x30\x2b\x94\x24\x57\x4a\x67\x61\x30\x39\x8e\xdb\xad\x70\xa8\xf1\x77\xa4\x74\x46\x04\x8f\x68\x82\x3a\x80\x0a\x34\x6e\xb7\x08\x21\x24\xe4\x62\x4a\x92\x94\x19\x13\x51\x54\x3b\x1c\x6b\x55\xe4\xee\x2d\x7e\xc2\x94\x0d\x21\x25\x23\xa5\x23\x2a\x2c\x64\x17\xcc\xc2\x58\xe9\x39\x8d\xdd\x5d\x18\x94\x2a\x9b\x36\x06\x52\x48\x6c\xe9\xb3\x61\xd3\x70\x85\x34\xb4\x4a\x29\x91\x2c\x83\x88\x26\x4e\x69\x03\x08\xbf\xa1\xca\x4b\x5e\x53\x96\x16\x10\xd1\xfe\xfd\xc5\xd7\x3e\x25\x9c\x59\x76\x58\x48\x61\x23\x9a\x33\xa1\x69\xdc\x57\xc9\x93\x09\x83\x4a\x79\x3f\xc4\xe0\xee\xb2\xf7\xf8\x6b\xef\xec\xb1\x01\x03\x2c\x99\xd0\x78\x20\x39\xe8\x17\x60\xfa\x63\x50\xe7\x37\x67\x77\x5f\x7b\xdf\x9a\x01\x55\x48\xe7\x29\x93\x4f\x60\xb7\xc5\x14\x06\xd5\x02\xd5\xa2\x30\xe0\x62\xea\xee\x47\x02\x52\x6e\xc0\xbe\xb7\x2b\x30\x06\xc9\xe3\xff\x03\xc6\x1b\x06\xf5\xe3\x86\xc2\xeb\x8d\x4d\x26\x90\x3c\xbd\x59\x5b\x21\xf3\xa2\xe9\xaa\xd4\x3b\x2c\xe5\x94\xd8\x79\x0e\x11\xd5\x8c\x0b\xe5\xb6\x09\xfd\x80\xa6\xab\xbd\xad\x22\xb8\x82\x8c\xa5\x40\xdd\xb2\x5c\xf5\x6e\xcf\x6e\x7a\x6f\xbc\x95\x79\xb2\xc5\x5b\x29\xa7\xeb\x1c\x6b\x60\xc6\xd5\x75\x4b\x96\x6d\x2c\xdb\xbf\x40\xf9\x76\x93\xf0\x0f\xa0\x5b\xe2\xc5\xb7\xff\x45\xaa\x03\x29\x0c\xcc\x56\x64\x07\x77\xd7\xfd\xde\xf7\x7f\x48\xb7\xc6\x8c\xab\xeb\x7e\xca\x61\xe0\xaa\xc2\x09\x3e\xdd\xb6\xfa\xe2\x2f\xa0\x31\xfe\x6e\x71\x55\x66\x7b\xbd\x1c\x16\x66\x76\x6f\x9f\x32\x88\x44\xf2\x94\x25\x30\x51\x29\x07\x1d\xd1\xb2\x01\x23\xf6\x7a\xe1\xca\xa7\xda\x47\x83\xca\xa7\x23\xff\xa5\x60\xd2\x0a\x3b\xa7\xb1\xbb\x7b\x87\x81\x2c\xb2\x21\xe8\xbd\x1c\x9e\x1d\xe6\x16\x1e\x2b\x7f\x2b\x2e\x6b\x49\x26\x64\x44\x8f\x29\xc9\xd8\x2c\xa2\xc7\x47\x47\x47\x47\x3f\x86\xe3\x40\x0a\x8b\xbd\x57\xd8\xf7\x0f\x13\xd4\xda\x4b\x0e\x9b\x30\xdd\xdf\xb6\xab\x0e\xdd\x63\xc9\xe4\x63\x6d\xbe\x3a\x62\x1e\x98\xd0\x1f\x3c\x62\x72\x86\xe5\xf8\xc0\x3e\x7c\x26\x0d\xd5\x8c\xc6\xe7\x6a\x06\x1f\xd4\x4f\x87\x34\x7e\x50\x85\xe4\x9f\x3d\x5f\x86\x85\xb5\x4a\xd6\xa9\x52\x3d\x50\xa2\x64\x92\x8a\xe4\xc9\x4d\x08\x98\x07\x5e\x77\xb5\xc8\x43\x2b\xc9\xd0\xca\xc3\x5c\x8b\x8c\x95\x27\x7f\x39\x47\x10\x54\x0b\x83\x0a\x03\xe7\x8c\x00\x37\x3b\x6e\xb7\x16\x0b\x90\x1c\x87\x93\x76\x6b\x3d\xb9\x98\x44\x8b\xdc\x36\x67\x97\x4a\x56\xc7\x82\x85\x17\xfc\xc9\xa6\xac\x92\xba\x1d\x9c\x32\x4d\xd6\x51\x91\x88\x8c\x0a\x99\x94\x7b\xe3\x75\xc9\x62\xcd\x19\xf5\x34\x3c\x93\x88\x48\x78\x21\xdf\x6f\x6f\x7e\xb2\x36\x7f\x84\xe7\x02\x8c\xf5\xba\xa7\x4d\x45\x0c\xb4\x97\x42\x06\xd2\x1a\x12\x11\xae\x92\x02\xef\xfd\x31\xd8\x5a\x7c\x3e\xbf\xe6\x5e\x67\x3d\x30\x75\xba\x3e\xd4\x06\xaf\xb0\x6a\xf1\x20\xe7\xb8\x26\xd1\x66\x4c\xf8\x71\xb3\xcf\x49\xc3\xa9\x8f\xbd\x84\x23\x23\xaf\xe3\x06\x9f\x4e\xd7\x2f\x37\xf7\xa0\x09\x50\x1d\x86\xbb\xcd\xab\x03\x79\x87\xb1\x2b\xdd\x13\x72\x57\x76\x05\x6f\x17\x8a\xeb\x08\x0e\xa7\xfb\x0a\x08\xab\x6e\x77\x0c\x58\x72\x3b\x22\xc0\x46\xb8\xdb\x10\x9b\xe9\x2e\x43\xcb\x6c\x61\x4e\xc8\xf1\x5a\xbc\x3c\x6d\xb7\xd6\x4f\x1a\x9e\x7d\x95\x83\xf4\xe8\xc3\x7d\xff\x1b\x3d\x20\x2f\x42\x72\xf5\xe2\xa7\x2a\x29\x27\x61\x5f\x69\x31\x16\x92\x7c\x21\x9d\x00\x1b\x87\x09\x3a\x9b\x79\x50\x9a\x4b\x0d\x8c\xcf\x8d\x65\x16\x92\x09\x93\x63\xd8\x9d\x60\xf8\x9d\x30\xc9\x53\x38\x33\x73\x99\x3c\x82\xc9\x95\x34\xe0\x35\x35\x6a\xe4\x57\x5c\xf0\xfb\x7e\x74\xe4\x0b\xf9\xb9\x7f\x7f\xe7\xe7\x4c\x1b\xf0\x30\x3e\x5d\x3b\xe9\xfa\xd7\x97\x5b\x20\xe9\x6f\xaa\x20\x5c\xc9\x8e\x25\x13\x36\x05\x92\x83\xce\x84\x31\x58\x1c\x56\xd5\x55\x43\x98\x24\xc8\xfe\x7f\xb4\x69\xdf\x3d\xdd\xb7\xae\x06\x24\xf7\xca\x60\x8c\xd5\x42\x8e\xc5\x68\xee\x35\x12\xbd\xdb\x7d\x65\x63\x0b\x2d\xc9\x88\xa5\x06\x6a\xe0\xa5\x7b\x8f\x05\xe7\x72\x7c\x5f\xb1\x6d\xfe\x53\x58\xed\x14\x1a\x57\xcd\xec\x12\x46\xac\x48\x2d\x26\xe2\xee\x4d\xda\x0b\x3e\xd8\xc8\x52\x12\xad\x62\xf2\xab\x5e\x6f\x7e\x5f\x09\x2a\x87\xc0\xaf\x25\x87\xd9\x1f\x3e\xfe\x49\x30\x60\x7d\xcc\x72\x47\xae\xbe\xae\x4c\x18\xe7\xbd\x29\x48\x7b\x23\x8c\x05\x09\xda\xeb\x54\xf9\xd4\x39\x78\x1b\xbe\xe3\xf6\xe6\x45\xd9\xa7\xc2\xa0\x6a\x80\x71\xbb\xb5\x58\x80\xe4\xcb\xe5\xdf\x03\x00\x16\x6a\x6f\xbf\xff\x0d\x00\x00")
 
 func assetsTemplatesItemsNewHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -268,12 +340,32 @@ func assetsTemplatesItemsNewHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/items/new.html", size: 2734, mode: os.FileMode(511), modTime: time.Unix(1581215359, 0)}
+	info := bindataFileInfo{name: "assets/templates/items/new.html", size: 3583, mode: os.FileMode(436), modTime: time.Unix(1792431656, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesLoginLoginHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x41\x6f\x1a\x3d\x10\xbd\x23\xf1\x1f\x26\x3e\x84\x45\x49\x76\x93\x4f\xdf\xa9\xc1\x1c\xda\x26\x6a\xaa\x34\x89\x42\x2b\xf5\x6a\xd6\xb3\xe0\xd6\x6b\x6f\xec\x59\x28\x42\xfc\xf7\xca\xbb\x2c\x2c\x84\xd0\x4a\xf5\x21\xf1\xda\xf3\xde\x9b\x37\x33\x66\xb9\x94\x98\x29\x83\xc0\x72\xa1\xcc\x45\x6a\x0d\xa1\x21\xb6\x5a\x75\x3b\x83\xe9\xd5\x70\x34\x45\x4d\xe8\xe0\xde\x4e\x94\x19\x24\xd3\xab\x61\xb7\x33\x18\xbb\xf0\x37\xb3\x2e\x07\x25\x39\xd3\xe1\xee\xd6\xba\x9c\x0d\xbb\x1d\x00\x80\x81\x54\x33\x48\xb5\xf0\x9e\xb3\x10\x75\x31\x71\xb6\x2c\x9a\xdb\x2a\x42\x8b\x31\x6a\xc8\xac\xe3\xcc\xd7\x12\x0f\x22\x47\xb6\xd1\x0b\x5f\x83\xa4\x8a\x6a\xc3\x94\x29\x4a\x02\x5a\x14\xc8\x19\xe1\x2f\x62\x55\x02\x6d\x86\x1d\xdd\xe0\xc6\x59\xcd\xc0\x88\x1c\x39\x33\x55\x40\xa1\x45\x8a\x53\xab\x25\x3a\xce\x6e\x4c\x50\x6b\xab\x6e\x4c\x24\x52\xcd\xfe\xc5\xd0\x93\xf0\x7e\x6e\x9d\x64\xc3\x66\xf7\x07\x43\x45\x03\x68\x9b\xda\xb0\x1c\x33\xb6\x45\xee\x98\xdb\x66\x70\xc0\xd2\xb8\x24\xb2\x66\x2d\x5d\x7f\x6c\x34\xc6\x64\x60\x4c\xe6\xa2\x70\x2a\x17\x6e\xc1\xc0\x9a\x54\xab\xf4\xe7\xba\xd7\x51\x9f\x0d\xd7\x03\x51\x03\xc3\x38\x24\x21\xb1\xb0\x11\x30\x75\x98\x71\x96\x78\xf4\x5e\x59\x93\x38\xf4\x48\x6c\xf8\x1c\xfe\xc1\xb6\x16\x62\xd8\xed\x2c\x97\x68\x64\x98\xb5\xb0\x6d\x06\xd1\xa7\x4e\x15\xb4\x3b\x8a\xf5\x59\xab\xf3\xc9\x0f\x31\x13\xf5\x69\x63\x6f\x26\x1c\x54\xf9\x01\x87\xac\x34\x29\x29\x6b\x20\xea\xc3\x72\x5b\xef\x10\xe2\xf0\x05\x38\x18\x9c\xc3\xf7\x2f\xf7\x9f\x88\x8a\x67\x7c\x29\xd1\x53\xd4\xbf\xde\x06\x3a\x7c\x89\xe7\x8a\xa6\x1f\x1c\x4a\x34\xa4\x84\xf6\xc0\x81\x5c\x89\xd7\xbb\x6c\xc1\xf5\x8d\xc6\x1c\x0d\x85\x08\x69\xd3\x32\xec\xe3\x09\xd2\xfa\xf8\xfd\xe2\x4e\x46\xbd\xcd\x23\xe9\xf5\x63\x5c\xc7\xef\x51\xad\x8f\xbf\x15\x52\x10\x02\x6f\xe7\x1d\x56\x18\xce\x77\x3b\x7a\x71\xe8\xbe\xbc\x23\xcc\xa3\x5e\xd8\xf6\xfa\xf1\x4c\xe8\x12\xcf\x77\x81\x4d\xc9\xdf\x06\x37\xf3\x73\x80\x60\x75\x1d\x9a\xd3\x2e\x8b\x2d\xd0\x44\xec\xe9\x71\xf4\x95\x9d\xc3\x5c\x19\x69\xe7\xb1\xb6\xa9\x08\xe5\x8e\xad\x53\xa1\x01\x67\xd0\xdb\xb4\xbf\x72\x9e\xf4\xf6\xab\x6b\x8d\x43\x21\x17\x9e\x04\x61\x3a\x15\x66\x82\x6f\xb7\x2d\x2c\x95\x41\x14\x70\x15\x6a\x44\x55\x89\x38\x87\xff\xe1\xf4\xb4\xe2\x0b\x44\xa5\xaf\xce\xfe\xbb\xbc\x7c\x05\xdf\x76\xdf\x17\xd6\xf8\x20\xf6\x79\xf4\xf8\x10\x17\xc2\x79\x5c\x13\xd7\x37\xed\x44\x9b\xb5\x67\x13\xf8\x51\xe3\xf5\xcb\xf5\x49\x0f\xce\x36\x7a\xf1\xdd\xc7\x03\xbc\x0e\xa9\x74\x06\x32\xa1\x3d\xee\x5d\xaf\x00\xb5\xc7\xbf\xb5\x7d\x72\xc4\xb6\xd0\xe8\x28\x62\xb7\x42\x69\x94\x40\xb6\x7e\x24\x27\xec\x90\xd1\x63\x09\x1d\x1b\x0a\x8f\x46\x46\x55\x41\x3d\x39\x65\x26\x2a\x5b\x44\x3b\xf3\xdc\xef\xef\x61\x5e\xe9\x04\xd2\x41\x52\x3f\xe9\xed\x4f\xc3\xef\x00\x00\x00\xff\xff\xb2\x97\x04\x55\xa0\x06\x00\x00")
+var _assetsTemplatesItemsReceiveHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\xcd\x72\xe4\x34\x10\xbe\xfb\x29\x1a\x1d\x76\x66\x6a\x13\x7b\x97\xe2\x44\x6c\x57\xc1\xee\x52\x84\x02\x02\xc9\x1e\xb8\x6a\xac\xf6\x58\x60\xb7\x94\x56\x7b\x06\xe3\xf2\xbb\x53\xb2\x9d\xc9\x24\x40\x51\xd2\x41\xea\xbf\xaf\xbb\xf5\xb5\xc6\xd1\x60\x6d\x09\x41\x75\xda\xd2\x75\xe5\x48\x90\x44\x4d\x53\x92\x37\xef\xcb\x0f\x8e\x6a\xcb\x1d\x68\xf8\x88\xad\x3d\x22\x0f\x79\xd6\xbc\x2f\x93\x7c\xcf\x65\x92\xfb\x32\x01\x00\xf8\x44\x82\x0c\xd2\x20\x98\xd5\x08\x2a\x67\x70\x96\x04\xdd\x69\xb6\xa2\x09\x42\xe3\x4e\x01\x06\xd7\x5f\x81\x63\x08\x95\xa6\x68\x60\x19\x7e\xbd\x5f\xcc\x4f\x56\x9a\xa8\x67\xf0\x8d\x23\xdc\x04\xa8\x74\x87\xac\xd3\x19\xe4\x73\x83\x60\x05\xbb\xe8\x34\x6c\x18\xc1\xb0\xf3\xde\xd2\x01\x5c\x5d\x83\x0d\xd0\x69\xfe\x03\x0d\x30\x56\x68\x8f\x68\xd2\x24\xcf\x7c\x99\xe4\xb5\xe3\x0e\xac\x29\xd4\xaa\xf8\xce\x71\xa7\xc0\x51\xe8\xf7\x9d\x95\xb3\xf8\x56\xb0\xdb\xee\x6e\x80\x51\x7a\x26\xa8\x75\x1b\xf0\x46\x2d\xf5\xe5\xc6\x1e\xa1\x6a\x75\x08\x85\x8a\xe1\xae\x0f\xec\x7a\xbf\x2a\xe3\xce\x5b\xbd\xc7\x16\x6a\xc7\x85\x7a\x6a\xc1\x07\x67\x50\x95\x4f\x5d\x83\x78\xcd\xb3\xd9\xee\xc2\xcf\x92\xef\x05\x64\xf0\x58\x28\xc1\x3f\x45\xbd\x80\x89\x6f\xc1\xae\x85\xa8\xb9\xee\x1c\xb9\xe0\x75\x85\x6a\xae\xe6\x05\x0c\x90\xee\xb0\x50\xcb\xf9\xa8\xdb\x1e\x0b\x35\x8e\x69\xbc\x4f\x93\x02\xdd\x8b\xab\x5c\xe7\x5b\x14\x2c\x94\xab\xeb\x55\xa4\xbd\x15\xdd\xda\xbf\xb0\x50\x55\xa3\x59\x57\x82\x1c\x14\x30\x3e\xf6\x96\xd1\xac\xc5\x67\xc6\x1e\xd7\xe3\xbe\x17\x71\xb4\xe6\xbb\x34\xf0\x9c\xf1\x5e\x08\xf6\x42\xd7\x9e\x6d\xa7\x79\x50\x67\xea\x3c\x13\x67\x71\x2f\x93\x3c\x8b\xe5\x95\xc9\x38\x22\x99\x69\x4a\x92\x67\x0e\x86\x8a\xad\x97\x17\x2c\x5c\x44\x17\x4d\xca\x7e\xd7\x47\xbd\x48\xd7\x37\x38\x6a\x7e\x7a\xf7\xf8\x8e\x50\x40\xdd\x53\x25\xd6\x11\x6c\x77\x30\x9e\xfb\xbd\xd8\x3d\x42\x01\x84\x27\xf8\xed\xa7\x1f\xbf\x17\xf1\xf7\xf8\xd8\x63\x90\xed\xee\xe6\x6c\xc7\xf8\x98\x3a\x8f\xb4\x55\xbf\xdc\x3d\x7c\x56\x57\x70\xb2\x64\xdc\x29\x6d\x5d\xa5\x63\xd4\xd4\xb1\x3d\x58\x82\xb7\xb0\xc9\x22\x27\x43\xb6\xa2\x6f\x5e\x07\x21\x46\x6d\x86\x20\x5a\xb0\x6a\x34\x1d\xf0\x3f\x73\x8b\xdb\xd6\xb0\x8d\xd8\xb3\xd3\x43\x74\x82\xa2\x28\xe0\x2b\x78\xf3\x66\x0e\x17\xe3\xf4\x61\x96\x7d\xf9\xee\xdd\x6b\xef\xb8\x5e\x25\x0a\xc5\xff\xa7\xbe\x81\xb7\xf0\xc3\xc3\xdd\xcf\xa9\xd7\x1c\x70\xc5\x0f\xde\x51\xc0\x5d\x7a\xfb\xf1\xb9\xa0\xb8\x26\xc0\x36\xe0\xbf\x00\x37\x9a\x4c\x8b\xdf\x84\x81\xaa\xfb\xd5\x3b\x86\xfa\x67\xeb\x1a\xc6\xfa\x0a\xd4\x1d\xb5\x43\x1c\xe5\x79\xa6\x37\x01\x42\x83\x6d\xfc\x45\xe2\xb7\x50\xad\xcc\xb1\x12\xce\x3f\xca\x17\xea\xa2\xb5\x71\x4f\xe7\xdb\xf4\xac\x88\xc9\x07\x24\xb3\x9d\x0b\x0a\xc2\x96\x0e\xb6\x1e\xb6\x63\x1c\x85\xaf\xc1\xb8\xaa\xef\x90\x24\x3d\xa0\x7c\x6a\x31\x1e\xbf\x1d\x6e\xcd\x76\x73\x39\x4d\x9b\x5d\x3a\x8f\xd0\xb4\x5b\x11\xa7\x24\xcf\x16\xba\x95\xc9\x38\x22\x99\x69\x4a\xfe\x1e\x00\xee\x92\x20\x17\x36\x05\x00\x00")
+
+func assetsTemplatesItemsReceiveHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesItemsReceiveHtml,
+		"assets/templates/items/receive.html",
+	)
+}
+
+func assetsTemplatesItemsReceiveHtml() (*asset, error) {
+	bytes, err := assetsTemplatesItemsReceiveHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/items/receive.html", size: 1334, mode: os.FileMode(420), modTime: time.Unix(1792432536, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesLoginLoginHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\xdb\x6e\xdb\x38\x13\xbe\x37\xe0\x77\x98\xf2\xa2\x96\xd1\x5a\x6a\x8b\xe2\x07\xfe\xc6\x32\x90\xcd\x26\x68\x16\xdd\x36\x48\xd2\x3d\x5c\xd2\xe2\xc8\xe2\x86\x22\x15\x92\xb2\x57\x30\xfc\xee\x0b\xea\x64\xcb\xa7\x26\x6d\x80\x5d\xa4\x2a\x1c\x79\x34\xa7\x6f\x66\xf8\x8d\xbc\x5c\x32\x8c\xb9\x44\x20\x29\xe5\x72\x14\x29\x69\x51\x5a\xb2\x5a\xf5\x7b\xe3\xe4\xed\xe4\x26\x41\x61\x51\xc3\x27\x35\xe3\x72\x1c\x24\x6f\x27\xfd\xde\x78\xaa\xdd\x67\xac\x74\x0a\x9c\x85\x44\xb8\x67\x17\x4a\xa7\x64\xd2\xef\x01\x00\x8c\x19\x9f\x43\x24\xa8\x31\x21\x71\x5a\xa3\x99\x56\x79\xd6\x3c\x75\xd7\x58\xd0\x29\x0a\x88\x95\x0e\x89\xa9\x42\x7c\xa6\x29\x92\xc9\x79\x4a\xb9\x00\xa5\xe1\xab\x41\x2d\x69\x8a\xe3\xa0\x54\xdd\xb4\xe5\x32\xcb\x2d\xd8\x22\xc3\x90\x58\xfc\xdb\x92\x32\x8b\x4d\x37\x9d\xe0\x0e\x92\x56\x82\x80\x73\x17\x12\xf7\x49\x20\x13\x34\xc2\x44\x09\x86\x3a\x24\xe7\xd2\x41\xdc\x09\xdd\xc2\x09\x18\x9f\xff\x08\xb4\x2b\x6a\xcc\x42\x69\x46\x26\xcd\xdd\x37\x50\x65\x8d\xc1\x26\xb2\xd6\xcb\x31\x74\x6b\xcb\x0e\xc2\xd6\xb6\x8e\xd8\x81\x34\xcd\xad\x55\xb2\x0e\x5d\x7d\x69\x2b\x38\xb5\x12\xa6\x56\x8e\x32\xcd\x53\xaa\x0b\x02\x4a\x46\x82\x47\x77\x75\xd7\xbd\x21\x99\xd4\xa3\x51\x19\xba\xc1\x08\x5c\x62\x9d\x09\xb1\x0b\x75\x41\x23\xab\x74\x39\x25\x60\x6c\x21\x30\x24\x8c\x9b\x4c\xd0\xe2\x03\x48\x25\xd7\xc5\xde\xac\x43\xc2\x19\x43\xd9\x60\x8b\x12\x2a\x04\xca\x19\x92\xef\x6a\x46\x9b\xc4\x99\x62\x48\x26\xa7\xb9\x4d\x50\x5a\x1e\x51\xcb\x95\x04\x27\x7c\xf0\xac\x75\x5d\x1d\xeb\x47\x54\x2a\xd0\xdc\xaa\x48\xa5\x99\x40\x8b\x21\x51\x12\x47\x96\xa7\x38\x2a\x1f\xae\x83\xb9\xab\xd3\xb5\xff\x8d\x18\x9f\x71\x0b\x4e\xcf\x9d\x09\x8d\x91\x9a\xa3\x2e\x4a\xc1\x13\xb6\x72\x8e\x9a\xc7\xc5\x6d\x03\xca\x1b\x92\xc9\x6f\xa5\x68\x6f\x57\x29\x24\x1a\xe3\x90\x04\x06\x8d\xe1\x4a\x06\x1a\x0d\x5a\x32\xb9\x76\x7f\xa0\x99\xb4\x71\x40\x27\xfd\xde\x72\x89\x92\x39\x2e\xe9\xf7\xd6\x44\x63\x22\xcd\x33\xdb\xa5\x9a\x4a\xb6\x51\xe8\xe0\x2f\x3a\xa7\x95\xb4\x41\x3a\xa7\x1a\xca\xa9\x83\x10\xe2\x5c\x46\x65\xdf\xbc\x21\x2c\xd7\x25\x74\x2a\x1a\xef\x21\x04\x89\x0b\xf8\xe3\xd7\x4f\x1f\xad\xcd\xae\xf1\x3e\x47\x63\xbd\xe1\xc9\x5a\x51\xe3\xbd\xbf\xe0\x36\x39\xd3\xc8\xdc\x14\x50\x61\x20\x04\xab\x73\xdc\x50\x72\xde\x5c\x53\xcf\x05\xa6\x28\xad\xd3\x60\x2a\xca\xdd\xbd\x3f\x43\x5b\x8b\x7f\x2a\x2e\x99\x37\x68\x49\x70\x30\xf4\xb1\xd6\xdf\x72\x55\x8b\xbf\x66\x8c\x5a\x84\x70\x33\x6f\x77\x5d\x96\x89\xc4\x1c\xf5\x87\x4e\x54\xdf\x4d\x12\xbb\xb4\x98\x7a\x03\x77\x3b\x18\xfa\x73\x2a\x72\x7c\xdd\x35\x6f\x0a\x7f\xd8\xb8\xe1\x86\x3d\x0e\x56\x27\xfd\xde\xfa\x9b\x2b\x8e\xca\x50\x7a\xe4\xea\xcb\xcd\x2d\x79\x0d\x0b\x2e\x99\x5a\xf8\x42\x55\x87\xc5\x57\x9a\xbb\x36\xbc\x82\x41\x3b\x04\x25\xfe\x60\xb0\x5d\x63\x25\x35\x52\x56\x18\x4b\x2d\x46\x09\x95\x33\x3c\xdc\x3c\xf7\x9f\xc7\xe0\x39\xbb\xd2\xea\xc6\x59\x41\x18\x86\xf0\x1e\x5e\xbe\x04\x27\x77\x8e\x72\x53\xca\xde\xbd\x79\xb3\x63\xde\x94\x5a\xa3\xc9\x94\x34\x2e\xd8\x2f\x37\x5f\x3e\xfb\x19\xd5\x06\x6b\xc7\xd5\x93\xe1\xc9\xae\x65\x15\xbc\x7a\xee\xb7\xc7\xc1\x8d\x0f\xd7\xc8\xf6\x06\x6b\x02\xb6\x8c\xe0\x18\xee\xd8\x9c\x74\x14\x3b\xe5\xda\xbc\x3a\x5a\x3e\xee\x69\x66\x4b\x86\x4d\x37\x21\x84\xdd\xdc\xcf\x1a\xad\x03\x71\x1e\x34\xce\x25\x5d\xfb\x35\x5b\x43\x08\x03\xc7\xd7\x83\x07\x65\xbe\x63\x3a\x15\x2a\xba\x3b\x64\xab\xd1\xe6\x5a\x42\x4c\x85\xd9\x97\xf0\xaa\xdf\xdb\x15\x76\x7a\x76\x1a\x45\x2a\x97\xf6\x1a\xdd\xf4\x1e\xe9\x18\x15\xa8\xad\x47\x7e\x47\x11\xa9\x14\x61\x4a\xa3\xbb\x17\xf0\xa7\xca\x35\xd0\xca\x03\x24\xd4\xc0\x14\x51\x82\xae\x7d\xf9\x64\xf8\x5d\x29\xb5\xad\x38\x97\x5a\x09\xe1\x8a\xfd\xcd\x81\xda\x3a\x6e\x10\x3e\xe4\x00\xbe\x8b\x69\xf0\x94\x85\x7d\x5c\x12\xd5\x2b\x8a\x09\x06\xf0\x6a\x3d\x86\x97\x3f\xef\x09\x76\x24\x97\x15\xa0\x30\xf8\x18\x0e\x78\xff\xee\xff\x7b\xab\x58\x37\xf8\x56\x29\x48\xa9\x2c\xea\xb5\x41\xad\xc5\x34\xb3\xc6\x87\x2b\x81\xd4\x20\x58\x5d\x00\x9d\x51\x2e\x81\x4b\x20\x65\xea\xf7\xee\x28\x5c\xd7\x08\x3e\x22\x65\xa8\x3d\x72\x8d\x56\x17\xa3\xd3\xd8\xa2\x26\x43\x78\x05\x04\x0c\x46\x4a\x32\xb3\x7f\x2a\x9e\x0c\xe2\x8b\x23\x34\x57\x43\xbc\xa0\x5c\x20\x03\xab\x2a\x8c\x2f\x1e\x9d\xd0\xb1\x25\x60\x50\x32\xaf\x24\x50\x63\x35\x97\x33\x1e\x17\x5e\x67\x8b\x0d\x87\x5b\x36\x3b\x71\xd6\x4e\x1d\x4d\x6e\xbd\x69\xfc\x17\x17\x79\x87\xc0\x0e\x2f\xf3\x12\x49\xf3\xf2\xb8\xb3\xcb\x5b\xde\x3d\xbc\x8d\x77\x08\x7c\x6b\x9f\xbb\x17\xd2\x23\xd6\x8a\xed\x33\xfc\xf1\x3d\xee\x68\xa4\xc4\x56\x3c\xa3\x5d\xfe\x6f\xec\x85\xe6\xb6\xf9\xf7\xac\xf8\xf4\x39\x32\xe9\x6d\x42\xeb\x9f\x5a\x8c\x33\x39\xb0\xb0\x50\xfa\xce\x87\xcb\x18\x0a\x95\xc3\x1d\x62\x06\x06\x91\xcb\x19\xd8\x84\x9b\xd7\x8e\x6f\x1d\xd8\x72\x7f\x3c\x3e\xfb\x63\x67\x76\x1f\xed\x6e\xf2\xcd\xc3\x58\x77\x1c\x54\x3f\x9f\x26\xfd\xde\x72\x89\x92\xad\x56\xff\x0c\x00\x5b\xd9\xc8\x76\xec\x11\x00\x00")
 
 func assetsTemplatesLoginLoginHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -288,12 +380,12 @@ func assetsTemplatesLoginLoginHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/login/login.html", size: 1696, mode: os.FileMode(511), modTime: time.Unix(1603935137, 0)}
+	info := bindataFileInfo{name: "assets/templates/login/login.html", size: 4588, mode: os.FileMode(436), modTime: time.Unix(1792428106, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesLoginResetHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\xcd\x6e\xdb\x3c\x10\xbc\x1b\xf0\x3b\x6c\x78\x88\x25\x7c\x89\xf4\xa5\xc8\xa9\x16\x0d\xb4\x40\x82\xa6\xe8\x4f\x90\x34\x40\x7b\xa4\xc5\x95\xcd\x96\x22\x15\x72\x65\xd7\x30\xfc\xee\x05\x29\x2b\xb6\x63\x24\x68\x79\x10\xa8\xe5\xce\xee\xec\x0c\xb9\x5e\x4b\xac\x94\x41\x60\xb5\x50\xe6\xbc\xb4\x86\xd0\x10\xdb\x6c\x86\x83\x62\x7e\x31\xb9\x15\xde\x2f\xad\x93\x70\x87\x1e\xa9\xc8\xe7\x17\x93\xe1\xa0\x98\xba\xf0\xad\xac\xab\x41\x49\xce\x5c\x38\xbb\xb6\xae\x66\x93\xe1\x00\x00\xa0\x90\x6a\x01\xa5\x16\xde\x73\x16\xb2\xce\x67\xce\xb6\x4d\x7f\x1a\x33\xb4\x98\xa2\x86\xca\x3a\xce\x5a\x8f\xee\xaa\x16\x4a\xb3\xc9\xad\x46\xe1\x11\xae\x0c\xa1\x83\x1f\xb6\x75\x10\x0f\x8a\x3c\xa6\xef\xe3\x95\x69\x5a\x02\x5a\x35\xc8\x19\xe1\x6f\x62\x91\xc9\xae\xd4\x41\xfb\x30\x95\xb3\x9a\x81\x11\x35\x72\x86\x5d\x46\xa3\x45\x89\x73\xab\x25\x3a\xce\x22\x0a\xde\x49\xe9\xd0\xfb\xa7\x39\x72\xa9\x16\xfd\x7e\xda\x12\x59\xb3\x6d\xd9\xfd\x3c\x75\x99\x92\x81\x29\x99\xf3\xc6\xa9\x5a\xb8\x15\x03\x6b\x4a\xad\xca\x5f\x5b\x6d\x7a\x15\x93\x94\x4d\xa2\x90\xd0\x47\x8a\xbc\xab\x14\xf4\xcc\x03\xd7\xc9\x70\xb0\x5e\xa3\x91\xc1\x80\xb0\xed\xdd\xf1\xa5\x53\x0d\x1d\xfa\xd3\xc5\xf6\x44\xc8\x7f\x8a\x85\xe8\xa2\xfd\x08\x0b\xe1\xe0\x80\x03\x70\xa8\x5a\x53\x92\xb2\x06\x92\x14\xd6\x3b\x4d\xbb\xd4\x47\xe0\x60\x70\x09\xdf\x3f\x7f\xfa\x40\xd4\xdc\xe1\x63\x8b\x9e\x92\x74\x7c\x98\x18\xb8\x5e\x69\xac\xd1\x90\x07\x0e\xd2\x96\x6d\xd8\x67\x33\xa4\x6d\xf8\xfd\xea\x46\x26\xa3\xa7\xbb\x31\x4a\x33\xdc\xe6\x8f\x9f\xf7\xf4\x48\x0f\x8d\x14\x84\xc0\xf7\x09\x85\x15\x7d\x79\x7b\xd0\x2e\x0b\x2e\xca\x1b\xc2\x3a\x19\x45\x2b\x47\x69\xb6\x10\xba\xc5\xb3\x1d\x74\x33\x0e\xf2\xf5\x7f\x0e\x1f\x33\xdb\xa0\x49\xd8\xed\xc3\x37\x76\x06\x4b\x65\xa4\x5d\x66\xda\x96\x22\xe8\x90\x59\xa7\x66\xca\xc0\x7f\x30\xca\x3d\x7a\xaf\xac\xc9\x23\xa9\x7c\xb4\x3f\x76\xac\x62\x1c\x0a\xb9\xf2\x24\x08\xcb\xb9\x30\x33\x7c\x59\xcf\xb0\x54\x05\x49\xc0\x45\xd4\x3d\xc5\x11\x39\x87\x4b\x38\x3d\x8d\xf5\x42\xa1\xd6\xc7\xd8\x9b\xff\x2f\x8f\xe0\x61\x3d\x23\x0b\xfc\x05\xfa\xe3\x63\xa8\x43\x6a\x9d\x81\x4a\x68\x8f\xcf\x8e\x37\x80\xda\xe3\xdf\xf2\x3b\x79\x85\x9f\xd0\xe8\x28\x61\xd7\x42\x69\x94\x40\xb6\xf3\x13\x9a\xed\x7d\x3b\x61\xe9\x3f\x32\x7b\xcd\x45\x8f\x46\x26\x1f\xef\xbf\x7e\xc9\x3c\x39\x65\x66\xaa\x5a\x25\x7b\xf7\x27\x3d\xf4\xeb\xa8\x47\x28\x58\xe4\xdd\x0b\xd9\xbd\xb4\x3f\x01\x00\x00\xff\xff\xe3\x1b\xa0\xc9\x04\x05\x00\x00")
+var _assetsTemplatesLoginResetHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x5b\x6b\xdb\x4c\x10\x7d\x37\xf8\x3f\x4c\xf6\x21\x96\xf9\x12\xe9\x4b\xc8\x4b\x6b\xad\xa1\x85\x84\xa6\xf4\x12\x72\x81\xf6\x71\xad\x1d\xdb\xdb\xae\x66\x95\xdd\x91\x5d\x63\xfc\xdf\xcb\xca\x56\x6c\xe7\x06\x81\x22\x21\xa4\xd9\x39\x67\xce\x9c\x19\x2d\x97\x1a\xc7\x86\x10\x44\xa9\x0c\x1d\x17\x8e\x18\x89\xc5\x6a\xd5\xed\xe4\xd3\x93\xe1\x95\x0a\x61\xee\xbc\x86\x6b\x0c\xc8\x79\x36\x3d\x19\x76\x3b\xf9\xc8\xc7\xe7\xd8\xf9\x12\x8c\x96\xc2\xc7\xb3\x0b\xe7\x4b\x31\xec\x76\x00\x00\x72\x6d\x66\x50\x58\x15\x82\x14\x31\xeb\x78\xe2\x5d\x5d\xb5\xa7\xf1\xca\xad\x1a\xa1\x85\xb1\xf3\x52\xd4\x01\xfd\x79\xa9\x8c\x15\xc3\x2b\x8b\x2a\x20\x9c\x13\xa3\x87\x9f\xae\xf6\xd0\x1c\xe4\x59\x93\xbe\x8b\x37\x54\xd5\x0c\xbc\xa8\x50\x0a\xc6\x3f\x2c\x1a\x25\x5b\xaa\xbd\xf2\xb1\x2b\xef\xac\x00\x52\x25\x4a\x81\x91\x53\x40\x65\x55\x81\x53\x67\x35\x7a\x29\x1a\x14\x7c\xd0\xda\x63\x08\x0f\x7d\x64\xda\xcc\xda\xf7\x51\xcd\xec\x68\x53\x72\xfd\xf1\x50\x65\xc4\x04\x23\xa6\xe3\xca\x9b\x52\xf9\x85\x00\x47\x85\x35\xc5\xef\x8d\x37\xad\x8b\x49\x5f\x0c\x1b\x23\xa1\x8d\xe4\xd9\x9a\x29\xfa\x99\x45\xad\xc3\x6e\x67\xb9\x44\xd2\x71\x00\xdd\xce\x76\x3a\xa1\xf0\xa6\xe2\xfd\xf9\xac\x63\x3b\x26\x64\xbf\xd4\x4c\xad\xa3\x6d\x0b\x33\xe5\x61\x4f\x03\x48\x18\xd7\x54\xb0\x71\x04\x49\x1f\x96\x5b\x4f\xd7\xa9\xf7\x20\x81\x70\x0e\x3f\xbe\x7e\xf9\xc4\x5c\x5d\xe3\x7d\x8d\x81\x93\xfe\x60\x3f\x31\x6a\x3d\xb7\x58\x22\x71\x00\x09\xda\x15\x75\x7c\x4f\x27\xc8\x9b\xf0\xc7\xc5\xa5\x4e\x7a\x0f\xbb\xd1\xeb\xa7\xb8\xc9\x7f\x44\xd5\xa4\xdc\x55\x5a\x31\x82\xdc\x15\x14\xaf\x66\x2e\xef\xf7\xca\xa5\x71\x8a\xfa\x92\xb1\x4c\x7a\xcd\x28\x7b\xfd\x74\xa6\x6c\x8d\x47\x5b\xe8\x6a\xd0\xed\x6c\xbf\x3c\xde\xa7\xae\x42\x4a\xc4\xd5\xdd\xad\x38\x82\xb9\x21\xed\xe6\xa9\x75\x85\x8a\x3e\xa4\xce\x9b\x89\x21\xf8\x0f\x7a\x59\xc0\x10\x8c\xa3\xac\x11\x95\xf5\x76\xdb\x6e\x58\xc8\xa3\xd2\x8b\xc0\x8a\xb1\x98\x2a\x9a\xe0\xcb\x7e\xc6\xdb\x8c\x21\x89\xb8\x06\x75\x13\x51\x20\xa5\x84\x33\x38\x3c\x84\x18\x8f\x44\x75\x68\x62\xa7\xff\x9f\x3d\x81\xc7\xfb\x91\x58\x90\x2f\xc8\x1f\x3c\x85\x7a\xe4\xda\x13\x8c\x95\x0d\xf8\xe8\x78\x05\x68\x03\xbe\x45\xdf\xd9\xe9\xbb\x67\xf5\x29\x8b\x9e\x13\x71\xeb\x1c\x94\x8a\x16\x50\xb5\x8b\xd6\x58\x18\x59\xe2\x02\x85\x14\x36\x3f\x37\xfb\x05\xa8\x89\x32\x04\x56\x31\xfa\x54\xf4\x07\x4f\x49\xff\x99\xf2\x83\x57\x9c\xdd\x28\xbf\x50\xc6\xa2\x06\x76\x1b\xc5\x6d\x03\x07\x6f\x56\xf6\xda\xfe\x05\x24\x9d\x7c\xbe\xf9\xfe\x2d\x0d\xec\x0d\x4d\xcc\x78\x91\xec\x6c\x7e\x7f\xb7\xd6\x33\x35\x22\x61\x9e\xad\xff\xed\x61\xb7\xb3\x5c\x22\xe9\xd5\xea\xef\x00\x4b\x42\xc7\x8d\xbe\x05\x00\x00")
 
 func assetsTemplatesLoginResetHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -308,12 +400,52 @@ func assetsTemplatesLoginResetHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/login/reset.html", size: 1284, mode: os.FileMode(511), modTime: time.Unix(1604111810, 0)}
+	info := bindataFileInfo{name: "assets/templates/login/reset.html", size: 1470, mode: os.FileMode(436), modTime: time.Unix(1792427368, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesLoginTwofactorHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x98\xdd\x8f\xdb\xb8\x11\xc0\xdf\xfd\x57\x4c\xf5\x70\xb6\x71\xb1\x94\x1c\x8a\x3e\x24\x92\x80\xbb\xc4\x41\x53\xa4\x97\x74\xbd\x01\xfa\x4a\x93\x23\x8b\xbb\x14\xa9\x25\x29\xfb\x0c\xc3\xff\x7b\x31\xa2\x6c\x4b\x5e\xfb\x6e\xf3\xd5\x0f\xa0\x89\xb1\xb0\x24\xce\xf7\x6f\x34\xa4\x77\x3b\x81\x85\xd4\x08\x51\xc5\xa4\x9e\x71\xa3\x3d\x6a\x1f\xed\xf7\xa3\xb4\x7c\x91\xdf\x6e\xcc\xec\x2d\xe3\xde\x58\xf8\xb9\xf1\x25\x6a\x2f\x39\xf3\xd2\xe8\x34\x29\x5f\xe4\xa3\x74\x69\xf3\xd1\x6e\x27\x0b\x88\xe7\xda\x1a\xa5\x2a\xd4\xfe\x06\x1f\x1a\x69\x51\x90\x0a\x21\xd7\xc0\x15\x73\x2e\x8b\x98\x42\xeb\xa1\xfd\x3b\xdb\x30\xab\xa5\x5e\x45\x60\x8d\xc2\xee\x51\x94\x8f\x00\x00\xc8\x62\x11\x2c\xb2\x81\x45\x90\x0e\x6c\xa7\x1a\x0a\x63\xc1\x95\xa8\x3c\x5a\x60\x9c\x9b\x46\x7b\x17\xc3\x47\x85\xcc\x21\x38\xf4\x20\x3d\x34\x35\x78\x03\x14\x90\xd4\x0d\x42\xc5\xee\xa5\x5e\x01\x2f\x99\x5e\xa1\x8b\x47\x69\x22\xe4\x9a\xbc\x47\x4d\xae\x86\x28\x6e\x37\x26\x84\x3b\xd7\x6c\xa9\x42\x0c\x75\xfe\xbb\x3e\xa5\xce\x5b\xa3\x57\x39\x06\x89\x34\xe9\xae\x5b\x1f\xb7\xa6\x39\x3a\x18\xa7\x49\x9d\x8f\xd2\xc2\xd8\x0a\xa4\xc8\xa2\x8a\x69\xb6\xc2\xb7\xc6\x56\x5d\xe8\xfd\x6c\xd1\xaa\xd9\xca\x9a\xa6\xee\x1e\xd2\x27\x55\x6c\x89\x8a\x14\x1f\xa4\x5f\x1b\x81\x51\x3e\x2c\x0d\xd0\xcd\x34\x69\xd7\xf6\x64\xa5\xae\x1b\x0f\x7e\x5b\x63\x16\x79\xfc\xcd\x47\x3d\x27\x48\x22\x1a\x98\xa6\xb4\x59\xa3\x22\xd0\xac\xc2\x2c\xe2\xed\x02\xd6\x78\xc3\x4d\x55\x2b\xf4\x98\x45\x46\xe3\xcc\xcb\x0a\x67\xed\xc3\xa3\x21\xfa\xd4\x8a\x71\x2c\x8d\x12\x68\xb3\x68\xae\xdb\x2a\x01\x6f\xac\x45\xed\x81\x96\x53\x65\x2a\x76\x8f\x87\x72\x1c\x32\x10\x6a\x42\x2a\xd2\x65\xe3\xbd\xd1\x9d\xc3\xe1\xe2\xe8\xe2\xd2\x6b\x58\x7a\x3d\x73\xc8\x8d\x16\xcc\x6e\x23\x30\x9a\x2b\xc9\xef\xb3\xc8\xe2\x0a\x35\x5a\xe6\xf1\x06\xb9\x59\xa3\xdd\x52\x74\x6e\x32\x8d\xf2\x5f\x71\x03\x87\x9b\x6d\x96\x5c\x9a\x04\xcd\x9f\x61\x53\x10\x3f\xb6\x67\x50\x48\x47\x95\x3f\xa2\x43\x96\x6e\x1b\xab\xe1\x43\x51\x9c\xf4\xa7\x09\xe5\xb5\xe5\x4d\x39\x0c\x5c\x7d\xb4\xc6\x23\xf7\x03\x4a\x60\xb9\xed\x30\x27\x5a\x59\xc8\x56\x61\x4d\x05\x4c\xf7\xe9\x33\x16\x58\x5d\xc3\xa6\x44\x4d\xf2\xa0\xcc\x0a\xa4\xee\x18\xbb\x18\x07\x95\x1b\xdb\x2e\xfd\xe5\x72\x64\xb5\x95\xd5\x30\x97\x4b\x5c\x49\x7d\xea\xec\xc9\x34\xca\x17\xe8\xe1\x53\xdd\x8b\xeb\xc8\x33\x37\xba\x90\xb6\x6a\x81\x06\xe7\xb7\xd4\xd9\x42\xba\x5a\xb1\xed\x4b\xd0\x46\xe3\xa1\xc8\x75\xbe\xe0\x4c\x83\x2f\xa5\x0b\xe1\x6d\xa4\x2f\xbb\x24\x9c\x07\xf8\x0c\x8c\x05\x6c\x09\xf2\x25\xc2\x3d\x6e\x21\x6d\x65\x4e\xd1\x90\x67\x0b\xe4\x16\x7d\x94\xa7\x09\x3d\xcc\x29\x89\x25\xd3\x22\xa4\xe3\xd8\x5b\x43\x99\x7f\xdc\x10\x02\x51\x3e\x80\xce\xf6\x96\x3f\xb5\x15\xbb\xc0\xbf\xba\x17\xfb\x7a\xbe\x57\x33\xfe\x65\x26\xe4\x4a\x76\x4d\xd8\x62\x15\xf2\x5e\xd7\x5f\xd8\x82\x8f\xa1\xe9\xc2\x18\x62\x13\x1a\x42\x5f\xec\x07\x7d\x1c\x15\x54\x20\xdb\xef\xda\xdf\x07\xe9\x58\xad\x3a\x5f\xb0\x35\x82\x2f\xd1\x21\x1c\x14\xb4\x31\x3a\x70\xa6\xc2\x4d\x89\x16\xc1\xb1\x02\x63\x98\x33\x5e\x82\xd1\x08\xc4\xe0\x12\xa1\x71\x28\xc8\x79\x04\x59\x74\x9d\xe4\xf0\x0a\x8d\x3d\x9e\x1a\xf5\xc8\xdb\xf7\xd2\xb5\x08\x36\x5d\xa9\x53\x06\xa5\xc5\x22\x8b\x92\x6e\x54\xb9\x64\xb7\x8b\x3f\x39\xb4\x0b\x74\x4e\x1a\xdd\x7e\x7f\xf7\x66\xbf\x3f\x0c\xc2\x3f\xc8\x71\xfe\xc6\x68\x4c\x13\x96\x9f\x0f\xaf\xd1\x69\x8a\x3b\x6e\x65\xed\x07\x73\x3c\xdc\x02\x67\x79\x16\x95\xde\xd7\xee\x65\x92\x70\xa1\xef\x5c\xcc\x95\x69\x44\xa1\x98\xc5\x98\x9b\x2a\x61\x77\xec\xb7\x44\xc9\xa5\x4b\x1e\x2c\x25\xef\xce\x25\x2f\xe2\xe7\xf1\xf3\xee\x32\xae\xa4\x8e\xef\x1c\x85\x18\x54\xe6\x47\xdd\x27\x94\x93\x3b\xb6\x66\xe1\x6e\x57\xa6\x35\xb3\xe0\x4f\x73\x55\xd4\x46\x6a\x0f\x19\x6c\xa4\x16\x66\x13\x2b\x13\x5a\x25\x36\x56\xae\xa4\x86\x1f\x61\x9c\xb8\x90\x9f\xe4\xa7\x82\x25\xe3\x57\xa3\xa3\x1a\x57\x9a\xcd\xe0\xad\x0e\x19\x14\x8d\xe6\x24\x0f\x13\x8b\x0f\x53\xd8\x1d\xd9\x27\xbb\x4a\x3a\x32\x25\x0c\x6f\x88\xc4\x78\x85\x7e\xae\x90\xbe\xfe\xb2\x7d\x27\x26\xe3\xf3\xf2\x8d\xa7\xaf\x8e\xf2\x7f\x5b\x7c\xf8\x35\xae\x99\x75\x48\x9a\x63\x8b\xae\x36\xda\xe1\x34\x1e\x78\x10\x17\xc6\x12\x52\x93\x93\x1f\x94\xba\xbe\x23\x07\x67\xa4\xc7\xaa\xef\x0c\xb7\xc8\x3c\x76\xfe\x4c\xc6\x4a\xf6\xad\xd3\x7f\x12\x88\x29\xa9\xaf\x43\x35\x21\x6b\x99\x1e\x2e\xa2\x10\x63\x56\xd7\xa8\xc5\xeb\x52\x2a\x31\x21\xa9\x9e\xa2\x7d\xef\xfb\x93\xf2\xe0\xc6\xd3\xb8\xed\xba\xb8\x6b\x3a\xc8\x60\xbc\x54\x86\xdf\x8f\x83\xa6\x7d\xaf\x22\x67\x13\x62\x50\x8f\xf3\x62\x58\x7c\x80\x0c\x34\x6e\xe0\x9f\x7f\x7f\xff\x57\xef\xeb\x1b\x7c\x68\xd0\xf9\x49\xcf\x43\xca\xb4\xa9\x51\x4f\xa2\x8f\x1f\x16\xb7\xd1\xb3\x0b\xe4\xfc\x08\xe3\xf0\x12\xef\xa7\xab\x95\xd3\x16\x99\xd8\x3a\xcf\x3c\x86\x7d\xc5\x55\x77\xe8\x23\x0b\xe8\x0a\xcb\xc4\x76\x41\x42\x90\x65\x19\xfc\x19\x7e\xf8\x81\x26\x70\x4c\x7a\x1a\xd7\xde\xfb\xe9\xf9\xf3\x73\xe9\x43\x50\xa7\x79\x02\xd9\x55\x64\x5e\x8d\xce\x24\xaf\x13\x79\xd2\x17\x66\xda\x78\x7a\x46\xc0\x69\x41\x1c\x56\x3c\x56\x4e\x29\x0e\xd3\x6d\xf2\x04\x3b\x61\xe5\x78\xfa\xac\x17\x4b\xfc\xd1\x9a\xb5\xa4\x1e\x94\x7a\xf5\xe9\xe6\xdd\xe7\x47\x10\xf6\x18\x97\x50\xa2\xad\x40\x47\xd2\x93\xf4\x75\xf3\x84\xf6\x15\x7f\x44\xe6\xe1\xdf\x1e\x50\x39\xbc\x5a\xe1\x4b\xb5\x6c\xcf\x20\x93\xe8\x2d\x93\x0a\x05\x6d\x4f\x9d\x67\xd6\x83\x3f\x6d\xfd\x1d\xfa\xa6\xfe\x53\x74\x96\x8a\xfd\xe0\xca\xa2\xa7\x41\x57\x30\xe5\xf0\xb4\xee\xd0\x30\x07\x50\x1d\x6a\x31\x84\xfe\x5c\xaa\xdf\x62\x8f\xe6\xe9\x57\x37\x59\xd0\x2a\x10\xb2\xa7\x66\x1d\xc3\x23\x17\xd3\x0e\x44\xbc\xf3\x58\xd1\x0a\xa2\x26\x5e\x33\xd5\xe0\xe7\x36\x70\xa7\xfd\x3f\xde\xc1\x5f\x0a\xdd\x15\x86\x1f\xcd\x27\x72\x6f\xfa\xad\xd8\xbc\x2d\x59\xb7\x71\x13\x52\xe8\xb1\x87\x8d\xb1\xf7\x31\xbc\x2e\x91\xdf\x77\x1b\x96\xba\x06\xa6\x05\x78\xbb\x05\xb6\x62\x52\xc7\xdf\x0c\xd7\xf6\xd5\xe6\xbc\x95\x7a\x25\x8b\xed\x64\xd7\xee\x6c\x5f\x06\x77\xf6\xd3\x27\xc3\x7c\xe5\x74\xf6\x6f\x41\xfa\x74\xe0\xfe\x0e\x44\x1f\x26\xe8\x7f\x2f\xd2\x83\xf0\xff\x4f\xf4\xb7\x22\xfa\xfc\xf8\xff\xbf\x81\xf2\x9b\xf9\xfb\xf9\xed\xfc\x12\xcc\x5f\xc7\x6f\x97\x2b\x3a\x7c\x2b\xfc\xd9\x6d\x35\xbf\xe9\x36\x41\x13\x8b\x0f\x17\xcc\x3d\x83\xe8\xfa\xcf\x6b\x9c\xd1\x6b\x6e\x89\x40\x4a\xe9\x98\x56\x14\x74\xe4\x0e\x3f\x1d\x1c\x7e\x56\x8b\xa6\xdf\xb5\xd0\xa7\xc3\xce\x6e\x87\x5a\xec\xf7\xa3\x7f\x0d\x00\x28\x93\x0b\xcc\x33\x15\x00\x00")
+
+func assetsTemplatesLoginTwofactorHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesLoginTwofactorHtml,
+		"assets/templates/login/twoFactor.html",
+	)
+}
+
+func assetsTemplatesLoginTwofactorHtml() (*asset, error) {
+	bytes, err := assetsTemplatesLoginTwofactorHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/login/twoFactor.html", size: 5427, mode: os.FileMode(420), modTime: time.Unix(1792427623, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesUsersApitokensHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x16\x5d\x6f\xdb\x36\xf0\xdd\xbf\xe2\xca\x87\xce\x46\x2b\x29\x2b\xfa\x14\x4b\x1e\xd2\x36\xc3\x32\x74\x6d\xd1\xa4\xc3\x86\xa2\x0f\xb4\x78\x96\xb8\xc8\xa4\x42\x9e\xec\x78\x86\xfe\xfb\x40\x4a\xb6\x25\xd9\x69\x8a\xb5\xa6\x20\x8b\xbc\xef\x4f\xde\x76\x2b\x70\x21\x15\x02\xe3\xa5\x0c\x48\xdf\xa2\xb2\xac\xae\x47\xb1\x90\x2b\x48\x0b\x6e\x6d\xc2\x52\x6e\x04\x9b\x8d\x00\x00\x86\xc7\x41\x8e\x5c\xa0\x69\xa1\xee\xb9\xf8\x70\x05\x37\x9e\x8d\x3f\x8a\x23\x21\x57\x0f\xd0\xce\xb5\xd8\x74\x28\xe3\xb2\x07\x25\xbc\xa7\x0e\xd4\x3d\xd7\xa9\x91\x25\x59\xe0\x4a\x80\x54\x84\x99\xe1\x24\xb5\xb2\x90\x72\x05\x95\x45\xe0\xe0\x2d\x00\xa9\x2c\x21\x17\xa0\x17\x50\xe8\x2c\x93\x2a\x03\xa9\x60\xbe\x01\x8b\x4a\xb8\x1d\x57\x3d\xc6\x71\xaa\x05\xce\x2e\x2a\xca\xb5\x91\xff\x7a\xa6\xe7\xf0\x0a\xb9\x41\x03\x4f\x0b\x9a\x7a\xae\x4f\x33\x9a\xc6\x91\xc7\x84\xc6\xec\x70\xcf\x24\x8e\xca\x8e\x21\xce\x49\x52\x24\x4c\xe1\xfa\xa2\x94\xde\x1b\x6c\x67\x1b\x2f\xd0\x10\xf8\x77\x60\xab\x34\x45\x6b\x19\x18\x5d\x60\x0b\x62\x90\x4b\x21\x50\xf5\x2d\x7f\xad\xcb\x0d\x50\x2e\x6d\x6b\xa0\xd2\xeb\x10\xae\x08\xd6\x5a\xfd\x44\x30\x47\xb0\xb9\x5e\x2b\xe0\x19\x97\xea\xa0\x95\x5b\x71\x69\x70\xa8\xcc\x9f\xbc\xa8\x90\xcd\xe2\xa8\x34\xd8\x51\xfb\x10\x2a\xb7\xe2\x85\x36\x4b\x4f\xc9\x5b\xb2\x5f\xb5\x59\xee\xed\x70\xd0\x40\xaa\x42\x2a\x1c\x44\x29\x2e\xf8\x1c\x8b\x1d\x9e\x35\x81\x56\xc5\x86\xc1\x42\x9b\x03\xab\x77\x7c\x89\x6c\xe6\xde\x71\xe4\xd1\x07\x2c\xa4\x2a\x2b\x02\xda\x94\x98\x30\x9f\x08\x3d\xb1\xa9\x56\x64\x74\x01\x4b\x13\xbc\x60\x3d\x15\x1d\x47\x06\x65\xc1\x53\xcc\x75\x21\xd0\x24\xac\x39\x32\x78\x57\x49\x83\xa2\x2f\x67\xbb\x35\x5c\x65\x08\xe1\xce\x33\xd7\xa9\x2e\xd1\xd6\x75\x5f\x9b\x4e\xe2\x36\xf2\x73\x4c\x6f\xe1\xf0\x79\xda\x0f\x1d\x43\x8e\x88\x03\x6f\x20\x6b\x2d\xf4\x47\x73\x7d\xcf\x40\xf1\x25\x1e\xac\xf1\xda\x34\x06\x5a\xf7\x19\x6c\xb7\x61\x5d\x33\x58\xb9\xf8\x25\xac\xd9\x9d\x10\xda\x0b\x40\x47\xa8\x3f\x6f\x23\xd1\x65\x38\xf3\x7f\xa7\x23\xd1\x4f\x0a\xb7\xb6\x5b\x54\x62\xe8\xa2\x79\x45\xa4\x55\x6b\x4f\xb3\x61\xa0\x55\x5a\xc8\xf4\x36\x61\xa9\x41\x4e\xb8\x73\xf2\x78\xb2\xcf\xa2\x39\x29\x98\x93\x0a\x4a\x23\x97\xdc\x6c\xd8\xec\xb5\xc7\x6c\x3a\x48\x1c\x35\x8c\x0e\xe2\xe3\xc8\x59\x33\x1b\x0d\x34\x8b\x89\xcf\x0b\xdc\xf1\x6c\x36\xfe\x1d\x58\x32\xb2\x44\x01\xcb\x79\x70\xd6\xf1\x54\x4c\xae\x80\xf7\x04\x6e\x13\x08\x6e\x6e\x07\xce\x8c\x29\x6f\x73\x94\xf2\x63\x88\x8f\x8e\x3d\x0d\x6b\xcc\x10\xa7\x81\x6f\xb9\x25\xf8\x64\x1f\x02\xf7\x4f\xdd\x0e\x79\x27\x6f\x63\x72\x7d\xf3\x91\x3c\x3e\x4a\x61\x32\x7d\x0a\xb7\x62\x12\x2e\xf2\xce\xc2\xba\x8e\x23\x12\x0f\xa1\xb4\xcc\x77\xc5\x11\xdb\x92\xab\x9d\xf3\xe6\x5c\x64\x08\xfe\x1d\x58\x4c\xb5\x12\x3e\x8e\x6d\x46\x39\xcc\xd9\x2e\x63\xbe\x26\xc2\xc5\x95\xd3\x27\x25\xef\x6f\xe4\x12\x21\x6c\x1d\x78\x23\x1f\xd3\x4d\x2e\x20\x74\x0e\x75\xfe\x6c\xb0\x8f\x99\x0d\xe1\x58\x58\xac\xeb\x77\xb8\x42\xf3\xa8\x6a\x47\x87\xee\x69\xc4\x5e\xd9\x8f\xb8\xd2\xb7\x38\x2c\x86\xdd\xaf\x85\x3e\xc0\xa1\x51\xe2\x24\x70\x57\x4d\xfb\xfa\x31\x9e\xd3\xbe\x7e\xb6\xdb\xf0\xea\x4d\x5d\x1f\x97\x91\x5d\xfa\x6a\xd2\x15\xb9\xbe\x1c\x08\x17\x37\xc3\x66\x8d\x22\xc7\xe5\xd4\xfd\xb5\x8e\x38\x82\x1d\x7b\x26\x8e\x86\xc9\x34\xa4\x8d\xa3\x4e\x8e\xc6\x91\x2f\xc5\xd9\xa8\x2d\xd8\xd8\xfa\x5b\xbc\x6d\x15\xae\xb9\x47\xff\xf0\x15\x6f\x4e\xdb\x02\x5c\x71\x03\xfd\x9e\x01\x09\x2c\x2a\x95\xba\x9b\x19\xc6\x13\xd8\xee\x65\x39\x54\xdf\xcb\x2c\x24\xf0\xf9\xcb\x74\x0f\x10\x3a\xad\x96\xa8\x28\xbc\xab\xd0\x6c\xae\xb1\xc0\x94\xb4\xb9\x28\x8a\x31\xf3\xcd\xf7\xb3\xef\xb5\xbd\x56\xfb\xe5\xdc\x77\x62\x14\x6c\x12\x2e\xb4\xb9\xe4\x69\x3e\x3e\x48\xf5\x52\xba\xa2\xdd\xf2\x87\x36\x2c\x2b\x9b\x8f\xfd\x77\xe8\x9b\xf3\xe4\xa0\x47\x3d\x99\x8e\xf6\x1b\xa7\xad\xc1\x3b\x48\x40\xe1\x1a\xfe\xfa\xe3\xed\x6f\x44\xe5\x47\xbc\xab\xd0\xd2\xb8\x43\x64\xf0\x2e\xd4\x25\xaa\x31\xfb\xf0\xfe\xfa\x86\x3d\x87\xb5\x54\x42\xaf\xc3\x42\xa7\x7e\x3a\x09\xb5\x91\x99\x54\xf0\x0c\x58\xe4\x27\x02\x1b\xb1\x21\xb9\x32\xc8\xc5\xc6\x12\x27\x4c\x73\x97\x09\x0f\xfa\xd0\x3d\x72\x01\x63\x27\xd5\x13\x5d\x3b\x22\x78\x92\x24\xf0\x72\x88\xe7\x96\x41\xaa\x8c\x82\x05\x2f\x2c\x1e\x84\xba\x55\x8f\x4e\x32\x75\x4a\x54\x16\x92\x24\x81\x17\x67\x3f\x9f\x62\xb9\x8f\x56\x86\x74\x59\xa0\xfb\x7c\xb5\xb9\x12\xe3\xe3\xb1\x65\x12\xba\x9c\x79\xad\x15\xa1\x22\x48\xe0\xf7\xeb\xf7\xef\xc2\x92\x1b\x8b\xad\xfe\xb6\xd4\xca\xe2\x24\xf4\x8d\x70\xfa\xbf\x24\xb1\x49\xd8\x4c\x61\x90\x9c\xb2\xf2\xab\x6c\x76\x19\xe5\xa7\xa5\x0e\x1f\x32\x15\x4e\x7f\xa8\x2b\x5f\x9e\x9d\x9d\x72\xa5\x1f\x23\xc7\xec\x43\x81\xdc\x22\x64\x72\x85\x40\x39\xb6\x83\x23\xf7\x23\x86\x9f\xa0\x39\x81\x43\x21\xd0\x0a\xa1\xc9\xdd\x6e\x0e\x7d\x8b\x86\x27\xf0\x72\xae\x44\x81\x17\x76\xa3\xd2\x8f\x6d\x28\x5c\x5c\x8e\x32\xf8\x39\xb0\xbf\x75\x05\xdc\xa0\x9b\x60\x79\x3b\x7a\xa3\x00\xd2\x6d\xe9\x37\x1a\xdb\x27\x5d\xa5\xea\x4e\x2d\x79\x6f\xa0\x12\x63\x9f\x01\xee\xa6\x57\x99\x5c\x6c\xc6\x7d\x8f\xb8\xdb\xed\xfc\xf1\x68\x39\x34\x36\x69\x8a\xf7\x79\x8f\x81\xef\x0c\xf6\xbc\xad\xf4\x3d\xa8\x9e\xb4\x6a\xed\x54\x6a\x4a\xbb\xdb\xa7\x7b\xf5\xe6\x8d\xb9\x7a\xd3\x0d\x98\x0b\xe9\x93\x54\xab\x85\x34\xcb\x31\x6b\x9a\x74\x67\xc8\xff\x05\x2e\xd4\x86\x72\xa9\x32\xa8\xac\x7b\x4b\x82\xb5\x2c\x0a\xb0\xa4\x4b\x58\x6b\x73\x2b\x55\x16\xb2\xc9\x30\x07\x4e\x07\xac\xfe\x9e\x26\xf4\xe6\xf2\xed\xe5\xcd\xe5\xb7\xb5\x21\x78\x06\x3b\x5b\xbf\xab\x21\xfd\xc0\x7c\x32\x43\xd7\x3e\x9e\x53\x2d\xbc\x9e\x8e\xe2\xa8\xb9\x9a\x66\xa3\xed\x16\x95\xa8\xeb\xd1\x7f\x03\x00\x93\x56\xd4\x06\x34\x0f\x00\x00")
+
+func assetsTemplatesUsersApitokensHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesUsersApitokensHtml,
+		"assets/templates/users/apiTokens.html",
+	)
+}
+
+func assetsTemplatesUsersApitokensHtml() (*asset, error) {
+	bytes, err := assetsTemplatesUsersApitokensHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/users/apiTokens.html", size: 3892, mode: os.FileMode(420), modTime: time.Unix(1792427914, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesUsersEditHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4d\x6f\xe3\x36\x10\xbd\x07\xc8\x7f\x98\xf2\x62\x19\x4d\x64\xec\x75\xd7\x32\xb0\xdd\xdd\xa2\x29\xda\x34\xd8\x34\x40\xdb\x1b\x2d\x8e\x2d\x6e\x25\x92\x21\x47\x4e\x0d\xc3\xff\xbd\x18\x4a\x4a\x24\x39\xda\x8b\xe3\x83\x4c\x51\xf3\xde\xbc\xc7\x8f\x99\xc3\x41\xe1\x46\x1b\x04\x51\x49\x6d\xae\x73\x6b\x08\x0d\x89\xe3\xf1\xf2\x62\x59\xbc\x5b\x3d\x38\x25\x09\xe1\x21\xa0\x5f\x2e\x8a\x77\xab\xcb\x8b\xe5\xda\xf3\x73\x63\x7d\x05\x5a\x65\x02\x95\xa6\x9f\xad\xaf\xc4\xea\xf2\x02\x00\x60\xa9\x8d\xab\x09\x68\xef\x30\x13\x85\x56\x0a\x8d\x00\x23\x2b\xcc\x84\x56\x02\x76\xb2\xac\x31\x13\x87\x43\xca\x9c\xe9\xcd\xe7\xe3\xf1\x19\xa9\xf4\x0e\xf2\x52\x86\x90\x09\xa6\xbf\xde\x7a\x5b\xbb\xee\x6b\x8c\x28\xe5\x1a\x4b\xd8\x58\x9f\x89\x50\x60\x49\xe8\x6f\x65\x85\x62\xc5\x5c\xc0\xc3\xe5\x22\x86\xf4\x31\x7d\x3d\x84\xff\x91\x18\xe4\x60\xc3\xde\x96\x9d\x44\x7e\x9e\x88\x64\xe2\xe3\x51\x44\xbb\x83\xb4\xad\xee\x85\xd2\xbb\x73\x3c\x7c\xa9\xa4\x2e\xc5\x2a\xfe\x9d\x6b\x00\x23\xd7\xd8\x41\xa4\x1e\x59\x68\xb3\xbe\x91\x87\x7b\xf2\x88\x24\x56\xcd\x3f\x7c\x54\xca\x63\x08\xe7\xba\x09\x0d\xeb\xd8\x4e\x93\x64\xe4\xa7\x53\xf0\x46\x86\x3e\x69\xda\x8b\x15\x3f\xcf\x35\x91\x33\xd3\xd8\x02\x13\x8f\x0c\x34\x19\xdf\x6c\x3f\x24\x21\x6f\x87\xa4\xb3\x2f\x45\x88\x5c\xa7\x9b\x20\x69\x7c\x2d\xda\xac\x6f\xe4\xe1\x1f\xed\xc4\xea\xce\x06\x92\x25\x7c\xb2\xea\x6c\x1f\x2e\x52\x31\xd3\x40\x34\xa7\x19\x9b\xbb\x7b\x0e\xed\xd5\xa7\xb3\x8f\x94\xad\x0d\x79\x3e\x55\xcd\xe0\xec\x83\xd5\xf2\x9d\x9c\xad\x66\x7e\x7c\xbc\xba\xec\xaf\xb8\x59\xd7\x44\xd6\xb4\x79\x9b\x97\xe7\xcc\x6b\x32\xb0\x26\x73\xed\xbc\xae\x24\x67\xb3\x26\x2f\x75\xfe\x6f\x26\xea\xd8\x1c\xee\x1b\xfa\x64\x2e\xba\x6e\xd1\xce\x2c\x17\x0d\x13\xb7\x8b\x05\xeb\x5f\x5d\x5e\x1c\x0e\x68\x14\x37\x17\x1e\x76\x9d\x27\xe4\x5e\x3b\x1a\xf6\x9e\x66\xae\xb7\x12\x8b\x6f\x72\x27\x9b\xd9\xce\xc2\x4e\x7a\x18\x68\x80\x0c\x36\xb5\xc9\x49\x5b\x03\xc9\x1c\x0e\x2f\x0b\xcb\xa1\x1e\x1f\x21\x03\x83\x4f\xf0\xd7\xef\xbf\xfd\x42\xe4\xbe\xe2\x63\x8d\x81\x92\xf9\x87\x61\x20\x6b\xfd\x52\x62\x85\x86\x02\x64\xa0\x6c\x5e\xf3\x38\xdd\x22\xb5\xd3\x3f\xed\x6f\x54\x32\xeb\x5a\xdf\x6c\x9e\x62\x1b\x3e\x62\x72\xd2\x47\x8a\x27\x6d\x94\x7d\x4a\x4b\x9b\x4b\x16\x97\x3a\x49\x05\xef\x61\x1a\x5c\xa9\x29\x11\x0b\xd1\xd7\x10\x51\xa9\xb3\xee\x44\x99\xab\xe9\x4e\x52\xf1\x0a\xa3\xf5\x7a\xab\x0d\xfc\xd8\x82\xbf\x59\x6d\xc6\xb4\x4c\xd0\xea\x6c\x37\x2a\xeb\x2f\x11\xff\xb8\xad\xbd\x1f\xf8\x4f\x59\xa6\xba\x21\xac\x92\x19\x0f\x67\xf3\x34\x9e\xb6\xab\x21\x30\x36\x93\x69\x64\xec\x4a\x13\xd0\xa6\x6e\x4f\x63\x9b\x1e\x30\x01\xe6\x9a\x39\x0d\xe5\xca\x3b\x99\x55\xd2\x77\xac\xc6\x92\x37\x01\x7d\xa9\x0d\xd3\xf8\x97\x52\x33\x25\xbc\xb9\x8d\xdf\xd1\xde\x04\x4c\xc0\x6f\x3e\xbf\x87\xdb\xba\x5a\xa3\x4f\xa6\x08\xb4\xea\xb0\xf3\x1e\xf8\xf8\x81\xef\x5e\xf7\xe6\xf1\x31\xb5\x0e\x4d\x22\xee\x1e\xfe\x14\x57\xdd\xf9\xea\x9f\x9a\x18\x62\x3c\x4a\xb5\x8f\x8b\x92\x17\xd2\x6c\x71\xfa\xa6\x35\x18\xaa\xbd\x81\x42\x1a\x55\xe2\xc7\xb0\x37\xf9\x57\x0c\xce\x9a\x80\xc9\x30\xb0\x4d\x70\x75\x3a\xdb\x2a\x79\xe5\x8b\xf8\xdb\xd6\xa0\xac\x99\x11\x14\x72\x87\xe0\xd0\x57\x3a\x04\x56\x42\xb6\xad\x07\x40\x85\x0e\xd0\x16\xbe\x1f\xc4\x90\xa4\xef\xee\x74\x39\x02\x1a\x95\xfc\x7a\xff\xc7\x6d\x1a\xc8\x6b\xb3\xd5\x9b\x7d\x32\xb8\x35\xf3\xf9\x08\x13\xbd\x6e\x64\x19\xb0\x25\x66\xd2\xe5\xa2\xa9\x55\x2f\x35\xef\xff\x00\x00\x00\xff\xff\x21\x8d\x7a\x1b\x6a\x0b\x00\x00")
+var _assetsTemplatesUsersEditHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x6f\xe3\x36\x10\xbd\x1b\xf0\x7f\x60\x79\xb1\x8c\x6e\x64\xec\x75\x63\x09\xd8\x66\xb7\x68\x8a\x36\x0d\x36\x4d\xd1\xf6\x46\x8b\x63\x8b\x5b\x89\x64\xc8\x91\x53\xc3\xd0\x7f\x2f\x46\xa2\x62\x4b\x8e\xd2\xa2\x36\x64\xc8\x34\xcd\xf7\xe6\x3d\x7e\xcc\x70\xbf\x97\xb0\x56\x1a\x18\x2f\x85\xd2\x57\x99\xd1\x08\x1a\x79\x5d\x4f\x27\xcb\xfc\x7d\xfa\x68\xa5\x40\x60\x8f\x1e\xdc\x72\x91\xbf\x4f\xa7\x93\xe5\xca\xd1\x7b\x6d\x5c\xc9\x94\x4c\x38\x48\x85\xdf\x1b\x57\xf2\x74\x3a\x61\x8c\xb1\xa5\xd2\xb6\x42\x86\x3b\x0b\x09\xcf\x95\x94\xa0\x39\xd3\xa2\x84\x84\x2b\xc9\xd9\x56\x14\x15\x24\x7c\xbf\x8f\x89\x33\xbe\xfd\x54\xd7\xff\x01\xb9\x05\xe7\x95\xd1\x27\xf0\xdf\xda\xfe\x23\x0e\xa9\xb6\x2c\x2b\x84\xf7\x09\x27\x89\x57\x1b\x67\x2a\xdb\xfd\x4b\xcf\xb2\x10\x2b\x28\xd8\xda\xb8\x84\xfb\x1c\x0a\x04\x77\x27\x4a\xe0\x29\x11\x32\x6a\x2e\x17\xcd\x90\x63\xcc\xb1\x32\x84\xbf\x91\xf7\x62\xd0\xa4\x39\x53\x74\x62\xe9\x7d\xa2\x94\x88\xeb\x9a\x37\x53\xd6\x0b\x1b\x74\x2f\xa4\xda\x86\x88\xff\xcb\x03\x05\xd1\x2f\x3e\xf4\x05\x6c\x54\x1d\xe3\xd0\x4a\x17\x60\x60\xa7\xeb\xbe\x98\xa5\xcf\xa5\x50\x05\x4f\x9b\xaf\x73\xcd\x00\x91\x9c\x38\x69\xa8\x07\x36\x42\xd4\x0b\x79\x78\x40\x07\x80\x3c\x6d\xbf\xd9\x47\x29\x1d\x78\x7f\xae\x1b\xdf\xb0\x9d\xd8\x69\x83\x0c\xfc\x74\x0a\x2e\x64\xe8\x46\xe1\x8e\xa7\xf4\x3e\xd7\x44\x46\x4c\x43\x0b\x44\x3c\x30\x40\x5d\x17\x93\xff\x80\x02\x81\x96\x43\xe0\xd9\x07\xc4\x37\x5c\xa7\x8b\x20\x70\x78\x34\x42\xd4\x0b\x79\xf8\x53\x59\x9e\xde\x1b\x8f\xa2\x60\x37\x46\x9e\xed\xc3\x36\x54\xc4\xd4\x13\x4d\x61\x86\xe6\xee\x5f\x86\xd6\xf5\xc5\xfc\xdc\x98\x4a\xa3\xa3\x5d\xd5\x36\xce\xf5\x93\x05\xbe\xa1\xf8\x40\x3f\xdc\x5e\x5d\xf4\x57\xdc\xac\x2a\x44\xa3\x43\xdc\xf6\xc7\x4b\xe4\x15\x6a\xb6\x42\x7d\x65\x9d\x2a\x05\x45\x33\x3a\x2b\x54\xf6\x57\xc2\xab\xa6\x66\x3e\xb4\x4b\x1f\xcd\x79\x57\x44\x43\xcf\x72\xd1\x32\x51\x15\x5d\x90\xfe\x74\x3a\xd9\xef\x41\x4b\xaa\xb9\xd3\xc9\xa1\x20\xfb\xcc\x29\x8b\xfd\x92\xdc\xf6\x1d\xcd\xc4\xe2\xab\xd8\x8a\xb6\xb7\xb3\xb0\x15\x8e\xf5\x34\xb0\x84\xad\x2b\x9d\xa1\x32\x9a\x45\x73\xb6\x3f\x4c\x2c\x0d\x75\xf0\xc4\x12\xa6\xe1\x99\xfd\xfe\xf3\x4f\x3f\x20\xda\x2f\xf0\x54\x81\xc7\x68\x7e\xdd\x1f\x48\x5a\x3f\x17\x50\x82\x46\xcf\x12\x26\x4d\x56\x51\x3b\xde\x00\x86\xee\xef\x76\xb7\x32\x9a\x75\x37\x82\xd9\x3c\x86\x30\x7c\xc0\x64\x85\x6b\x28\x9e\x95\x96\xe6\x39\x2e\x4c\x26\x48\x5c\x6c\x05\xe6\xb4\x86\xb1\xb7\x85\xc2\x88\x2f\xf8\xb1\x86\x06\x15\x5b\x63\x4f\x94\xd9\x0a\xef\x05\xe6\xaf\x30\x1a\xa7\x36\x4a\xb3\x6f\x03\xf8\xab\x51\x7a\x48\x4b\xd6\x82\xce\xb0\x50\xc9\xf1\x14\xd1\x43\xc5\xfb\x43\xcf\x7f\x4c\x32\xe5\x2d\x42\x19\xcd\xa8\x39\x9b\xc7\xcd\x6e\x7b\xd7\x07\x76\x35\x71\x1c\xdc\x55\xd9\x11\x82\xa6\x1a\x8d\xa3\x9b\xb2\x36\x02\x6d\x13\xff\x38\xb6\x2d\x22\x23\x60\x4a\xba\xe3\x50\x4a\xdd\xa3\x51\x05\xbe\x61\xb7\xc9\x99\x23\xd0\x43\x72\x19\xc7\x1f\x72\xd5\x08\x49\x38\xce\xe3\x0c\x21\x3b\x8c\xc0\x6f\x3f\x7d\x60\x77\x55\xb9\x02\x17\x8d\x11\x28\xd9\x61\xe7\x03\x70\xb8\x8d\xfe\x2b\x43\xb8\xcd\xbe\x46\x53\x5f\x4f\x27\x87\x5f\x0e\x9e\x62\x63\x41\x47\xfc\xfe\xf1\x57\xfe\xae\xdb\xe7\xc7\xbb\xb7\x19\xa2\x1d\x08\xb9\x6b\xe6\x36\xcb\x85\xde\xc0\xf8\x89\xa7\xc7\x01\x56\x4e\xb3\x5c\x68\x59\xc0\x47\xbf\xd3\xd9\x17\xf0\xd6\x68\x0f\x51\x7f\x60\x08\x30\xb0\x49\x9f\xa0\xe4\x95\x7f\xf8\x1f\xa6\x62\xd2\xe8\x19\xb2\x5c\x6c\x81\x59\x70\xa5\xf2\x74\x4b\x67\x68\x42\x5e\x62\x98\x2b\xcf\x42\x02\xfe\x86\xf7\x49\xe6\xd7\x6f\x4d\x87\x07\x2d\xa3\x1f\x1f\x7e\xb9\x8b\x3d\x3a\xa5\x37\x6a\xbd\x8b\x7a\xa7\x77\x3e\x1f\x60\x1a\xaf\x6b\x51\x78\x08\xc4\x44\xba\x5c\xb4\x39\x33\x9d\x4e\xf6\x7b\xd0\xb2\xae\xff\x19\x00\x04\xff\x92\x42\x09\x0d\x00\x00")
 
 func assetsTemplatesUsersEditHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -328,12 +460,32 @@ func assetsTemplatesUsersEditHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/users/edit.html", size: 2922, mode: os.FileMode(511), modTime: time.Unix(1583804446, 0)}
+	info := bindataFileInfo{name: "assets/templates/users/edit.html", size: 3337, mode: os.FileMode(436), modTime: time.Unix(1792428718, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesUsersNewHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x51\x6f\xdb\x36\x10\x7e\xf7\xaf\xb8\xf1\xc5\x32\xda\x48\x48\x1f\x17\xc9\x40\x96\xa4\x58\x86\x2d\x0d\x92\x06\xd8\xf6\x46\x8b\x67\x8b\x2b\x45\x2a\x24\xe5\xcc\x08\xf2\xdf\x07\x52\x92\x2d\x3a\x96\x3a\xb7\xc1\xe6\x07\x4b\xa6\xbf\xbb\xfb\xbe\xe3\xf1\x78\xcf\xcf\x0c\x97\x5c\x22\x90\x92\x72\x79\x92\x2b\x69\x51\x5a\xf2\xf2\x32\x49\x8b\xd3\xf9\x7d\x81\xc2\xa2\x86\x3b\x5c\x71\x63\x35\xb5\x5c\xc9\x34\x29\x4e\xe7\x93\x74\xa1\xe7\x93\x74\xa9\x74\x09\x9c\x65\x24\xd7\x48\x2d\x7e\x54\xba\x24\xf3\x09\x00\x40\xba\xe4\x28\x98\x41\x0b\xb9\xa0\xc6\x64\xc4\x41\x4f\x56\x5a\xd5\x55\x8b\xf0\x28\x81\x2b\x94\x6c\x7e\x9e\xe7\xaa\x96\x16\xac\x82\x0b\xef\x29\x4d\xda\x7f\x76\x50\xc6\xd7\x81\xaf\xbc\xc0\xfc\x4b\xcf\x97\x07\x71\x59\xd5\xf6\x35\xec\xc4\xaf\x13\xb0\x9b\x0a\x33\xa2\x29\xe3\x8a\x80\xa4\x25\x66\xc4\x2d\x11\xaf\xa1\x36\xa8\x3f\x6f\x2a\x6c\x45\x13\x58\x53\x51\x63\x76\x1a\x44\x70\x1f\x25\x73\xc1\xf3\x2f\x19\xb1\x6a\xb5\x12\x78\x87\x8f\x35\xd7\xc8\x9c\xfa\x8f\x5e\x75\x34\xdb\xe7\x25\xe8\x02\xc5\x01\x5e\x7e\x9d\xc0\x52\xe9\x96\x49\x97\xf2\x34\xf1\x7f\xf5\xf4\x27\x8c\xaf\xff\x97\x74\xd0\x92\x6a\x6e\xa9\xec\x12\xf2\xe1\x3f\x4e\x48\x17\x1f\xa2\x4b\x25\x95\x9e\x8d\xa5\x26\x4d\xba\xba\x6b\x7f\xef\xa7\xa9\xa9\x40\x2f\xd0\x34\x99\xbe\xa1\x25\x06\x25\xe9\x99\x79\x02\x01\xe2\xc1\xa0\x06\xf7\x0a\x91\xd2\xd0\x9d\x0c\xb7\x70\x80\x51\x93\xf8\x26\xbf\x16\xff\xb6\x24\x94\xaa\xa4\xd5\x4a\x74\x39\x77\xdf\x04\x2a\x41\x73\x2c\x94\x60\xa8\x33\xb2\x0d\xd6\x1d\xa7\x9e\xc4\xaf\x4b\xba\x2a\x29\x17\xe3\x9a\x5a\x88\x7f\x7c\x27\x7d\xf4\xae\x42\xfe\x7d\x06\xc7\x71\xbf\xb7\x1a\xd1\x8e\x93\xef\x30\xcd\x13\xce\x19\xd3\x68\xcc\x37\xca\x80\x82\x33\xa4\x0b\x81\x9d\x1e\xd3\x78\x0f\x05\x85\xa1\xbe\x49\xd9\x2d\x35\xe6\x49\x69\x36\xae\x6d\x87\xea\xde\xc6\x75\x55\x1d\x7e\x6c\x8b\x76\xa0\x40\xd4\x1e\xa3\xe3\xe4\x5c\x70\xbb\x19\x97\xd2\x20\xdc\xf7\x5b\x6d\x4d\xee\x3c\x86\x1a\x7a\x34\x8e\x2d\x34\x6a\xbf\x72\xf0\x5b\x88\x7f\xbc\x5d\x75\x39\x9f\xfb\xc5\xb5\xa3\x72\x9c\x88\x3f\x79\x35\x2e\xc1\x03\x6e\x95\xb1\x54\xc0\x85\x62\x6f\x26\xa3\xf2\x2e\x9d\xc7\xfd\x9a\xda\xc5\x3a\xa0\x68\x51\x5b\xab\x64\x1b\xad\xf9\xb1\x8d\xb7\xb0\x12\x16\x56\x9e\x54\x9a\x97\x54\x6f\xc8\xee\x5a\x69\xa6\x8b\xb6\xe5\xba\xbb\xa4\x99\x47\x50\x83\xeb\x93\x69\xd2\x38\x9a\x4f\xd2\xc4\x71\x9e\x4f\x9e\x9f\x51\xb2\x97\x97\xc9\x64\x37\xdd\x98\x5c\xf3\xca\x06\xf3\x4d\xb3\xd4\x53\x9e\xfc\x45\xd7\xb4\x59\x6d\xa9\xaf\xa9\x86\x20\x38\x64\xb0\xac\x65\xee\xe6\x20\x88\x66\xf0\xbc\x4d\xa3\x43\x6a\x7c\x84\x0c\x24\x3e\xc1\xef\xbf\xfd\xfa\xb3\xb5\x95\xbb\x08\xd1\xd8\x68\x76\xb6\xc5\x69\x7c\x8c\x9f\xb8\x2d\x2e\x34\x32\x94\x96\x53\x61\x20\x03\xab\x6b\x3c\x0b\x7c\x39\x21\x57\x02\x4b\x94\xd6\x01\x98\xca\x6b\xf7\x1e\xaf\xd0\xb6\xcb\x3f\x6d\xae\x59\x34\xdd\x0d\x5e\xd3\x59\x8c\xad\x41\xe8\xaa\x5d\x7d\xa8\x18\xb5\x08\x59\x8f\xb4\xfb\xb8\x4b\xe6\xc7\x20\x5c\xec\x76\x98\x5d\x5b\x2c\xa3\xa9\x7b\x9d\xce\x62\x7f\xf1\xbf\x0f\xec\x7c\x87\x1f\x36\xf4\x97\xc2\x61\xcb\xae\xeb\x0c\x1b\x77\xed\xea\xb0\x7d\xd3\x8a\x87\xad\x9b\xfe\x7d\xd8\xd6\x75\x8b\x61\x4b\xd7\x5e\x86\x62\x52\x3b\x92\x26\x7f\xa8\x07\xd4\x6e\x0f\xca\x88\xde\x2d\xe6\xb0\x8f\x87\x76\x14\x1b\xf6\xe0\x8a\xf8\xb5\xed\xcb\xd9\x24\x28\x3c\x55\xa1\x8c\xc8\xed\xa7\xfb\xcf\xe4\x3d\x3c\x71\xc9\xd4\x53\x2c\x54\xee\xc7\xfa\x58\x69\xbe\xe2\x12\xde\xc1\x34\x69\x7b\x87\x49\xa6\x7b\x95\xab\xa4\x46\xca\x36\x5e\x6e\x5e\x50\xb9\xc2\xc1\x03\xd1\x98\xd8\x5a\x4b\x28\xa8\x64\x02\xcf\xcd\x46\xe6\x77\x68\x2a\x25\x0d\x46\xaf\x66\x48\x8d\x8f\xef\x5f\x2d\xfe\x2b\x8e\xf0\x0e\x7e\xb9\xff\x74\x13\x57\x54\x1b\x8c\x1c\x4d\xdd\x46\x99\xc5\xd7\x97\xaf\x9d\x92\x3f\x54\x0d\x4c\x81\x54\x16\x0a\xba\x46\xa8\x50\x97\xdc\x18\x27\xc1\xaa\xf6\xbc\x03\x85\x36\xc2\x0f\x24\xf0\xd0\xcb\xc8\x7e\x7a\x0d\x4a\x16\x79\x2a\xc6\x6a\x2e\x57\x7c\xb9\x89\x82\xc3\x37\x9b\x05\x16\x3e\x39\x4b\x2a\x4c\x7b\xf6\x3b\x7f\xee\xd0\x0e\x4d\xd2\xa3\x0d\xa8\x6b\xd2\x97\x7c\x1d\x34\x8d\xc7\x1a\xf5\xe6\x1e\x05\xe6\x56\xe9\x73\x21\x22\x12\x6f\xfb\xf9\x2c\x6c\x16\xae\x90\x20\x83\x9b\xba\x5c\xa0\x8e\x8e\x6b\x3b\x43\x05\xd9\x57\xcd\x97\x10\x35\x31\xb2\x0c\x3e\xec\x17\x4c\x5f\x40\xbc\x54\xfa\x8a\xe6\x45\x97\x42\xc8\xe6\x5d\x2b\x8b\x19\x37\x0e\xc6\xda\xd6\xd9\xdf\x13\x40\x61\xf0\xfb\xdd\xfa\x6d\xe9\xfb\x6d\x76\x68\x92\x26\xcd\x05\xb1\xbd\x64\xfe\x09\x00\x00\xff\xff\x3d\x77\x50\x62\x41\x0f\x00\x00")
+var _assetsTemplatesUsersIntakeHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xff\x6f\xdb\xb8\x15\xff\xdd\x7f\xc5\x1b\x87\x9d\xe4\xd5\x91\x9c\x5e\x7b\xc3\x5c\x59\x43\x7a\xbd\xc3\x75\x68\x7b\x87\xa4\xc3\xed\x76\x28\x06\x46\x7c\x8e\x78\x91\x48\x95\xa4\xe2\x33\x0c\xff\xef\x03\x29\x29\x21\x6d\x27\x71\x8c\x0e\xfb\x65\x70\x10\x48\x24\xdf\x57\xbe\xf7\x79\x4f\xe4\x7a\xcd\x70\xc1\x05\x02\xa9\x29\x17\x27\x85\x14\x06\x85\x21\x9b\xcd\x28\x2b\x4f\xf3\xb7\xc2\xd0\x6b\x84\x1f\x64\xab\x74\x96\x96\xa7\xf9\x28\xbb\x54\xf9\x28\x6b\xf2\x11\x00\xc0\x05\xad\xa9\xe2\x86\x0a\x0d\x0d\x2f\xae\x81\x02\x53\xb2\x39\x91\x8b\x05\x18\x5e\x23\x2c\xb9\x29\xb9\x00\x53\xa2\x46\x28\x2d\x13\x58\x96\xe8\x06\x56\x50\x54\x94\xd7\x20\x05\x82\x5c\xc0\x4a\xb6\x0a\xb8\xc1\x5a\x27\xf0\x1d\x2d\x4a\x60\x74\x15\xe9\x9e\x86\x2a\x04\xdd\x54\xdc\x38\xa1\x5c\x18\x09\xba\x92\x46\x4f\x80\x0a\x06\x68\x97\xdb\x77\xb0\xba\x6a\x68\x1b\x30\x12\xb8\xd1\x50\xd0\x86\x16\xdc\xac\xac\x80\x41\x31\x9d\xc0\x3b\xa4\x37\x68\x75\xe8\xd9\x63\xdd\x98\x95\xa5\xb1\xf4\x77\x0b\x81\x8a\x95\x33\x23\x19\x65\x69\x93\x8f\xb2\x85\x54\x35\x70\x36\x27\xdc\x79\xe5\x7b\xa9\x6a\xd2\xf9\x21\x63\xfc\xc6\xda\xa3\xf5\x9c\xd8\x55\x27\x57\x4a\xb6\x4d\x3f\x69\xff\xb2\x8a\x5e\x62\x05\x0b\xa9\x06\xea\x8f\xbc\xc6\x7f\x49\x81\x24\xb7\x4f\x60\x1f\xb3\xd4\xad\xf2\xa8\xb8\x68\x5a\x03\x66\xd5\xe0\x9c\x18\xfc\xdd\x90\x40\x88\xdd\x2b\x25\x2b\x02\x82\xd6\x76\xc1\xc0\x11\x6e\x68\xd5\xe2\x9c\xac\xd7\x49\xb7\x7f\x17\x45\x89\xac\xad\x30\x19\x84\x6e\x36\xc4\x33\xe4\x56\x15\x68\x2a\x5a\x60\x29\x2b\x86\x6a\x4e\xce\x6a\x54\xbc\xa0\xe9\x07\x5c\xfe\xfb\x17\xa9\xae\x07\x5b\x53\xc6\x6f\x8e\x36\xfb\xa2\x92\xe6\x3d\x17\xad\x41\x4d\x72\xfb\x02\xef\x50\x5c\x99\x12\xe2\xba\x1b\x1d\x3f\xec\x05\xd1\xd6\x97\xa8\x1e\xf4\x83\xf6\x44\xdc\xef\x0a\x4f\x91\xd0\x1b\xbe\x86\x50\x73\x31\x27\xa7\x2f\x09\xd4\xf4\xf7\x39\x79\xfe\x62\x4a\x40\x1b\x6c\xdc\xd8\x97\x70\xc7\x5b\xa1\x8d\x6a\x0b\xc3\xa5\xd0\x24\x7f\xd3\x87\x1e\xf8\xc3\xbb\xfe\xb0\x91\x40\x15\xd2\x87\x9c\xc0\x7d\xc6\x9e\x75\x81\x40\x50\x72\xa9\xe7\xe4\x6b\x67\x5e\xe5\xf6\x61\x4e\x4e\xa7\xd3\x29\xc9\x77\x1d\xe6\x53\x6e\x36\x59\x3a\x68\xb1\xeb\x86\xf2\x65\x3e\x00\xc6\xcb\x7e\xc8\xd0\xcb\x0a\x07\x7d\xbb\x17\xf7\xff\x44\x0f\x29\x64\x7f\x99\x29\x91\xb2\xbb\xf7\x7e\x2c\x7f\x43\x57\x59\x6a\xca\xdd\x89\x1f\x1b\x14\x7a\xff\xd4\xb7\x95\xd4\x78\xcf\xdc\xe0\x67\x0d\x0d\x2a\xb0\x1b\xbe\x7f\x5d\x38\x9a\xa5\x5b\xea\x65\xe6\x52\xb2\x95\xe7\x5c\x67\xb5\x67\x8f\xfd\x5b\xaf\x15\x15\x57\x08\xdb\xee\x74\x6b\x37\x9b\x60\x6d\x66\x54\x48\x6c\x7f\x99\xd9\xf2\xc8\xf0\xcb\x34\x56\x58\x98\xfd\x51\xc0\xa8\xa1\x27\x0b\x8e\x15\x9b\x93\x25\xe2\x35\xa3\xab\x7e\xf0\x36\x23\x1a\xc5\x85\x59\x00\xf9\x13\x23\x90\xfc\xdc\xad\xd9\x6c\xc8\x7e\x61\xf6\x2f\x93\x8d\x8d\xa7\x21\xa7\xa6\x24\xbf\x68\x05\xb3\x7b\xd3\x4d\x1c\x4c\x79\x4a\xf2\xf7\xf2\x28\xca\xe7\x24\xff\xd8\xa2\x3e\x86\xf4\x6b\x92\xff\x8c\x4c\x1c\x47\xfc\x82\xe4\x1f\xcb\x56\x1d\x45\xfb\x92\xe4\xdf\x2b\x7e\x0c\xe5\x37\x24\xbf\xa0\xa6\x55\x8f\xd2\x66\x69\x17\x0b\xbb\xb3\x59\xba\x2f\x7c\x6c\x4c\x05\xb0\x6a\xcb\x1c\x79\x3c\x92\xa4\xcd\x37\x1f\x54\x5d\x02\xda\xa0\xf9\xb2\x72\x0a\x97\xbc\xbe\xa0\x2e\x9d\x9f\x20\xe9\xc1\x42\x11\xc8\xea\x9b\x84\x40\x5a\x3f\x66\x2b\x43\x57\x02\xfa\x0a\x70\x3a\x9d\x3e\xa8\xc1\x65\x6b\x8c\x14\xbd\x0a\xdd\xcb\xad\x0a\x97\x46\xc0\xa5\x11\x27\x15\x17\xd7\x04\xa4\x28\x2a\x5e\x5c\xcf\x89\xc2\x5a\xde\xe0\xb9\x5c\xc6\xa6\xe4\x7a\x4c\xf2\x73\x37\x90\xa5\x1d\xf9\x1e\x69\x59\xba\x8d\x13\xeb\x35\x0a\xe6\x81\x49\x96\x3a\x68\xea\xc1\x37\x75\x50\xdb\xbf\x1c\xa2\xa1\x6c\x4d\xc5\x05\x9e\x34\x8a\xd7\x54\xad\x3c\x65\x29\x63\x0e\xb8\xe2\x31\xc9\xcf\x18\x1b\x1a\xc3\x5e\xd5\x5e\x82\xca\x5d\x93\x38\xd4\x02\xb7\x75\x0c\xde\x50\x83\x5f\xb2\x24\x18\xdc\x0f\xda\xe7\x48\xb5\x14\xfb\xe7\x0e\x07\xf4\xcb\x8a\x16\xd7\xb2\x35\x4e\xeb\x03\x21\xfd\xb5\x4f\x73\x28\xb4\x07\x41\xcb\xa8\x39\x24\x3d\x18\x0d\xa2\xf5\x4d\x87\xdb\x07\x26\xc6\x03\x7d\xa4\x2f\x43\x39\x37\xfa\x62\x3a\xc7\x6e\x36\x41\xb7\xf0\xfc\xff\x09\xe1\x6f\xfb\x90\x17\x5e\xcc\x3f\x94\x1d\x87\xc8\xde\x95\xa9\xe9\x0d\x86\xa1\x17\x8f\x6d\xa5\xb8\x41\x08\xbf\xd7\x06\xc1\x59\x6a\x63\x29\x1f\x65\x06\xeb\xa6\xa2\x06\x5d\xcf\xe2\x3e\x7e\xce\xe5\xb2\x8f\xee\x20\x42\x77\x9a\x8e\x27\x37\x1b\xf9\xe8\x91\xe2\x76\x40\xfb\xf0\xf4\xb6\xe1\x88\x76\xe1\xa8\x36\xe1\x98\xf6\xe0\xe9\x6d\xc1\x53\xdb\x81\xdd\x36\x20\xcc\x95\x63\xcb\x71\x58\xf6\xa7\x7f\x9d\xed\x26\xfd\x17\xaa\xf4\xa7\x7f\x39\x84\xf7\xf1\xb5\xfd\xe5\xe3\xf5\xfc\xbf\x0f\x5b\x1d\x5c\xd9\x2f\xa8\x2e\x1b\xb7\x13\x73\xa8\x3d\x0f\xe4\xe6\xb1\x55\xe3\x31\xcf\x3e\xb1\x38\x3c\x5c\x08\xfe\x17\x9e\x1c\x80\x7f\x74\x77\xae\xa5\x0b\xc5\x1b\x13\x9c\x6c\x75\x43\x9e\xcd\xe9\x6f\xf4\x86\x76\xa3\xbd\xcb\x99\x2c\xda\x1a\x85\x49\x3e\xb7\xa8\x56\x17\x0e\xfd\xa4\x3a\xab\xaa\x38\xfa\xa3\xf7\xb5\x07\x5d\xc6\x45\xe3\x64\x21\x95\x3d\xb8\x8a\x17\xad\x70\x1f\xc9\x10\x77\x53\x63\x58\xdf\x7a\xa4\x1b\x49\x5c\xb0\xc3\xbc\xa7\x4d\xec\x26\x69\xec\x87\x5f\xb9\xc5\x9b\xf1\xab\x91\x7b\xb8\xa1\x0a\x86\xa6\x0b\xe6\x70\xc7\xdc\x67\x7b\xab\xeb\x15\x9a\xef\x2a\xb4\x8f\xaf\x57\x6f\x59\x1c\x79\x8a\x46\xe3\x84\x36\x0d\x0a\xf6\x6d\xc9\x2b\x16\xdf\x4b\x32\x54\x85\x68\x9c\xf4\x1e\x4b\x8a\x4a\x0a\xfc\x20\x19\xc6\x46\xb5\x38\x1e\xf7\x2a\x86\x1a\xfa\x55\xf0\xe9\x8a\x06\xed\xd6\xa1\xaa\x7a\x79\xf2\x24\x6d\x6f\xe3\x2b\xd0\xb3\x8b\x4d\x5f\xdb\x6e\xc4\x32\xd4\xa8\x4d\x1c\x19\x15\x8d\x93\x8e\x38\xde\x62\x9b\xa6\xd0\x9f\x5f\x9d\x2d\x0c\xaa\xf7\x9c\x09\x7e\x55\x1a\x50\x48\x99\x06\xea\x8e\x11\xc1\x25\xda\x04\x8c\x42\x6a\xb8\xb8\x02\x0a\x96\xb5\x7d\x72\xd3\x72\x01\xd3\xe9\x6c\x3a\x05\xaa\xa1\x1e\x18\x50\xe3\x0e\x2a\x51\x30\x7b\x88\x69\x1f\x19\x5d\x25\xb7\xa6\xec\x15\xea\x5b\xe5\x62\x6a\x32\x08\xf2\xad\xb3\x91\xd5\x50\x65\x34\xcc\x3b\x74\x4c\xdc\x11\x6b\x1c\xcd\xa2\xf1\xab\x60\x55\x2f\x04\xe6\xf0\xc1\x01\x6f\xec\xc8\x7e\x9d\x7e\x1a\xc3\x9f\xe1\x9b\x29\x3c\x0b\xc7\x4f\x3f\x79\xf4\x0a\x4d\xab\xc4\xad\xa1\x5f\x7d\x35\xf8\x09\xe6\xf3\x39\x4c\xe1\x6f\xf0\xfc\x45\xc7\x65\x36\xcc\xec\x6e\x98\x43\x9d\x60\xb3\x94\x5c\x4e\xdc\x71\x9f\x6f\x51\x2f\x4b\xc9\x65\x98\xb4\x71\xf4\xab\x0f\x5e\x11\x3c\x73\xa4\xf0\x0c\x22\xf2\x29\x1a\x07\x79\xe7\x49\xdd\x6d\xb3\x02\x15\xb6\x7d\x69\x81\xb7\x0f\x4f\xeb\xd2\x7b\xa3\xf6\xee\xf0\x38\x1a\x27\xd8\x13\x84\x0e\x2f\xfb\x6c\x3f\x53\x8a\xae\x92\x46\x49\x23\x2d\x54\x25\x35\x6d\x92\x82\x56\x55\x7c\x28\x38\xd9\x88\x9d\x84\x6e\xf3\xd5\xf6\x9c\x16\x0e\xda\x5f\x7f\x22\x34\x1b\x36\xd7\x6d\x82\xe5\x30\x81\xa8\x6f\xf2\xa2\xf1\x78\xb2\x43\xe7\x8e\x04\xce\xcc\x6c\x6f\x6c\x06\x5c\x5c\x5b\xe1\x14\xa4\x95\xc6\x3d\xac\x5c\x17\x7d\x18\x2f\x1b\x60\xe8\x98\x39\x8c\xda\xc3\xab\x6f\x05\xf6\xda\x33\xf4\x09\x3b\x06\x6d\xee\x36\x66\xb3\x95\x15\x01\x64\x1d\xbb\x59\x21\x93\x27\x6c\x17\xbc\xa1\xab\x19\xf8\x36\xb8\xfd\x98\x40\xf7\x95\x16\x4e\x75\xdf\x72\xd1\x78\xdb\x9c\xbd\x58\xb0\xe4\x82\xc9\x65\x52\xc9\x82\x5a\x2d\x92\x86\x9a\xd2\xa6\x4b\x0f\x0f\x24\x25\x9e\x23\x5c\xc6\x27\x8d\x6c\xe2\x2d\xef\xe8\x12\x2b\x83\xea\x27\x6a\xca\x3d\x3c\xa5\xe2\x57\x5c\xc0\xb3\x4e\x68\xf2\x9b\xe4\x62\x8b\xb1\xe5\xa1\xf0\x33\xcc\x41\xe0\x12\xfe\xf9\xfe\xdd\x0f\xc6\x34\xe7\xf8\xb9\xb5\x48\xec\xad\x53\xf8\x39\xb1\x71\x14\x93\x9f\xfe\xf1\x91\x4c\x76\x24\x95\x0a\x17\xdb\xcb\x85\x42\xca\x56\xda\x50\x83\x45\xe9\xbe\xe4\xef\xcb\x6b\xcf\xe3\x25\x15\xac\xc2\x33\xbd\x12\xc5\x39\xea\x46\x0a\x8d\xb1\xc2\xcf\x13\xdf\xd2\x09\x90\x5f\x64\x0b\x4c\x8a\xc8\x40\x69\x2f\x99\x1a\x54\x35\xd7\xda\x26\x9f\x91\xd0\x4b\xeb\xae\xc3\x3a\x1c\xe8\xae\xa0\xfe\xe0\x9b\x3e\x20\xdf\xa0\xaf\x46\xc1\xe2\xbf\x5f\xfc\xf8\x21\xd1\x46\x71\x71\xc5\x17\xab\x38\x54\x71\xb8\xc0\x99\x05\x20\x94\xd8\x5d\x63\x6f\x0d\xd6\x71\x34\xdc\x0d\x0d\x68\x17\x46\xb9\x77\xe5\x71\x97\x1e\xf7\x70\xf2\x6e\x57\x06\x66\x5b\x39\xe3\x5f\x14\xdc\xaf\x91\x7f\x41\xb1\x5f\x2b\xd7\xb6\xcc\x3a\x07\x85\x33\xaf\xfd\xac\x99\x85\x99\x78\xb7\x72\xe3\xd5\xfe\x2c\xed\x9a\xbb\x7c\xb4\x5e\xa3\x60\x9b\xcd\xe8\x3f\x03\x00\xec\x56\x50\xb5\xf8\x1c\x00\x00")
+
+func assetsTemplatesUsersIntakeHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesUsersIntakeHtml,
+		"assets/templates/users/intake.html",
+	)
+}
+
+func assetsTemplatesUsersIntakeHtml() (*asset, error) {
+	bytes, err := assetsTemplatesUsersIntakeHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/users/intake.html", size: 7416, mode: os.FileMode(420), modTime: time.Unix(1792433124, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesUsersNewHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xdf\x6f\xdb\x36\x10\x7e\xd7\x5f\x71\xe3\x8b\x65\xb4\x91\xd1\x3e\xae\x92\x81\x2c\x4d\xb1\x0c\x5b\x1a\xc4\x0d\xb0\xed\x8d\x16\xcf\x36\x57\x8a\x54\x48\xca\x99\x61\xf8\x7f\x1f\x48\x51\x96\xe4\x58\xce\xf2\x03\x1b\x22\xd8\x0c\xf5\xdd\x77\xf7\x1d\xc9\x3b\x7a\xbb\x65\xb8\xe0\x12\x81\x14\x94\xcb\xb3\x5c\x49\x8b\xd2\x92\xdd\x2e\x4a\x57\x1f\xa6\xb3\x15\x0a\x8b\x1a\x6e\x71\xc9\x8d\xd5\xd4\x72\x25\xd3\xc9\xea\xc3\x34\x4a\xe7\x7a\x1a\xa5\x0b\xa5\x0b\xe0\x2c\x23\xb9\x46\x6a\xf1\x8b\xd2\x05\x99\x46\x00\x00\xe9\x82\xa3\x60\x06\x2d\xe4\x82\x1a\x93\x11\x07\x3d\x5b\x6a\x55\x95\x01\xe1\x9e\x54\xe0\x12\x25\x9b\x9e\xe7\xb9\xaa\xa4\x05\xab\xe0\xc2\x33\xa5\x93\xf0\xa6\x85\x32\xbe\xee\x71\xe5\x2b\xcc\xbf\x77\xb8\xdc\x93\x72\x59\x56\x7d\x97\x1e\x76\xe6\xe7\x09\xd8\x4d\x89\x19\xd1\x94\x71\x45\x40\xd2\x02\x33\xe2\xa6\x88\xd7\x50\x19\xd4\xdf\x36\x25\x06\xd1\x04\xd6\x54\x54\x98\x7d\xe8\x79\x70\x8f\x92\xb9\xe0\xf9\xf7\x8c\x58\xb5\x5c\x0a\xbc\xc5\xfb\x8a\x6b\x64\x4e\xfd\x17\xaf\x3a\x1e\x1f\xc6\x25\xe8\x1c\xc5\x91\xb8\xfc\x3c\x81\x85\xd2\x21\x92\x26\xe5\xe9\xc4\xbf\x6a\x69\xd2\x09\xe3\xeb\xff\x25\x1d\xb4\xa0\x9a\x5b\x2a\x9b\x84\x7c\xfc\x8f\x13\xd2\xf8\x87\xf8\xb3\x92\x4a\x8f\x4f\xa5\x26\x9d\x34\xfb\x6e\x1a\x1d\x4d\x53\xbd\x03\xfd\x7a\x9b\x3a\xd3\xd7\xb4\xc0\x4e\x74\x21\x32\xbf\x22\x3d\xc4\x9d\x41\x0d\x0e\x0c\xb1\xd2\x10\x96\xc9\x4f\x1c\x89\xc8\x27\x38\xe4\xd7\xe2\xdf\x96\xf4\xa5\x2a\x69\xb5\x12\x4d\xce\xdd\x27\x81\x52\xd0\x1c\x57\x4a\x30\xd4\x19\xd9\x3b\x0b\x91\x75\x25\x3e\x2d\xc9\x59\xcb\x27\x65\xb5\xa8\x66\x04\x71\x65\x90\xb9\x53\x28\xd4\x12\xb8\x7c\xad\xb0\xaa\xf1\xf0\x58\x9c\x7c\xa9\xb6\xcb\x82\x72\x71\x5a\x58\x80\xf8\xaf\x57\x2a\x40\xc7\x71\xb0\x36\xdd\x08\x9e\x17\xfb\xcc\x6a\x44\x7b\x3a\xf8\x06\x53\x7f\xc3\x39\x63\x1a\x8d\x79\xa1\x0c\x58\x71\x86\x74\x2e\xb0\xd1\x63\x3c\xeb\x81\xa0\xbe\xab\x17\x29\xbb\xa1\xc6\x3c\x28\xcd\x4e\x6b\x6b\x51\xcd\xe8\xb4\xae\xb2\xc1\x9f\x5a\xa2\x16\xd4\x13\xd5\xfa\x7a\x81\x9c\x0b\x6e\x37\xa7\xa5\xd4\x08\xf7\xf9\x56\x4b\x93\x3b\xc6\xbe\x86\x4e\x18\xcf\x8b\x7f\x66\xa9\x7d\xe2\xf4\x07\x88\xff\x7a\x2b\x09\xc6\x91\x1d\x68\xe8\x86\xf2\x3c\x11\x7f\xf2\xf2\xb4\x04\x0f\xb8\x51\xc6\x52\x01\x17\x8a\xbd\x99\x8c\xd2\x53\x3a\xc6\x03\x2d\x1d\x5f\x47\x14\xcd\x2b\x6b\x95\x0c\xde\xea\x7f\xf6\xfe\xe6\x56\xc2\xdc\xca\xb3\x52\xf3\x82\xea\x0d\x69\x5b\x66\x7d\x73\x0a\xed\x24\x1e\x93\x69\x7d\xd7\x42\x0d\xae\x4c\xa6\x93\x9a\x68\x1a\xa5\x13\xb7\x57\xa7\xd1\x76\x8b\x92\xed\x76\x51\xd4\xde\xdc\x4c\xae\x79\x69\x7b\x77\xb7\x7a\xaa\xa3\x7c\xf2\x17\x5d\xd3\x7a\x36\x84\xbe\xa6\x1a\x7a\xce\x21\x83\x45\x25\x73\x77\xc7\x83\x78\x0c\xdb\x7d\x1a\x1d\x52\xe3\x3d\x64\x20\xf1\x01\x7e\xff\xed\xd7\x9f\xad\x2d\x5d\x93\x47\x63\xe3\xf1\xa7\x3d\x4e\xe3\x7d\xf2\xc0\xed\xea\x42\x23\x43\x69\x39\x15\x06\x32\xb0\xba\xc2\x16\xe3\xb8\x9c\x90\x4b\x81\x05\x4a\xeb\x00\x4c\xe5\x95\x1b\x27\x4b\xb4\x61\xfa\xa7\xcd\x15\x8b\x47\xed\xa5\x72\x34\x4e\x30\x18\xf4\xa9\xc2\xec\x5d\xc9\xa8\x45\xc8\x3a\x41\xbb\xc7\x35\xe7\x1f\x7b\xee\x12\xb7\xc2\xec\xca\x62\x11\x8f\xdc\x70\x34\x4e\xfc\xa5\xe6\x7d\xcf\xae\x69\x50\xc3\xb6\x4d\x67\x3b\x6e\xef\x3b\xc4\xb0\xb1\x6f\x2a\xc7\x2d\x9b\xaa\x35\x6c\xdc\x94\xbb\xe3\xf6\x75\x29\x1f\xb6\xae\xeb\xff\x71\x5b\x57\x6d\x86\x2d\x5d\x79\x1a\xf2\x49\xed\x89\x54\xf9\xa2\x70\xdc\xf2\x66\x7f\xd0\x86\xcd\xdb\xc3\x78\x9c\xe3\x2e\x5c\x53\x87\x19\xdc\x21\x78\x6c\xbb\xfb\x14\xed\xc7\x6e\xe3\xaa\x12\x65\x4c\x6e\xbe\xce\xbe\x91\xf7\xf0\xc0\x25\x53\x0f\x89\x50\xb9\xff\xc9\x93\x28\xcd\x97\x5c\xc2\x3b\x18\x4d\x42\xed\x31\x93\xd1\xc1\xce\x57\x52\x23\x65\x1b\x2f\x37\x5f\x51\xb9\xc4\xc1\x03\xe5\xfe\x34\xda\x4a\x4b\x58\x51\xc9\x04\x9e\x9b\x8d\xcc\x6f\xd1\x94\x4a\x1a\x8c\x7b\xb8\x40\xdf\x17\xed\xfe\xfe\x55\x8c\xf0\x0e\x7e\x99\x7d\xbd\x4e\x4a\xaa\x0d\xc6\x4e\xa7\x0e\x5e\xc6\xc9\xd5\xe7\xc7\xa4\xe4\x0f\x55\x01\x53\x20\x95\x85\x15\x5d\x23\x94\xa8\x0b\x6e\x8c\xab\x09\x56\x85\x7a\x01\x14\x82\x87\x1f\x48\x8f\x61\xfc\x69\x30\xbd\x06\x25\x8b\x7d\x28\xc6\x6a\x2e\x97\x7c\xb1\x89\x7b\x87\x77\x3c\xee\x59\xf8\xe4\x2c\xa8\x30\xa1\x76\x34\x7c\xee\xd0\x0f\xfd\xca\x38\x59\xc0\x9a\x22\xff\x99\xaf\x7b\x45\xe7\xbe\x42\xbd\x99\xa1\xc0\xdc\x2a\x7d\x2e\x44\x4c\x92\x7d\x3f\xe8\xe8\xf1\x7e\x37\xa5\x5b\xd3\xeb\xaa\x98\xa3\x8e\x9f\x57\xb6\x86\x36\x64\x57\x35\x5f\x40\xec\xde\x41\x96\x65\xf0\xf1\x70\xc3\x74\x05\x24\x0b\xa5\x2f\x69\xbe\x6a\x52\x08\xd9\xb4\x29\x85\x09\xe3\xc6\xc1\x58\x28\xbd\xdd\x35\x01\x14\x06\x5f\x4f\xeb\x97\xa5\xcb\xeb\x47\xbb\x28\x9d\xd4\x0d\x66\x1a\x6d\xb7\x28\xd9\x6e\xf7\xcf\x00\x61\x93\x02\xea\x5d\x10\x00\x00")
 
 func assetsTemplatesUsersNewHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -348,12 +500,12 @@ func assetsTemplatesUsersNewHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/users/new.html", size: 3905, mode: os.FileMode(511), modTime: time.Unix(1602118344, 0)}
+	info := bindataFileInfo{name: "assets/templates/users/new.html", size: 4189, mode: os.FileMode(436), modTime: time.Unix(1792427452, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesUsersSamaritansummaryHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8e\xb1\x6e\x83\x30\x10\x86\xf7\x48\xbc\x83\xe5\x29\x19\x80\x29\x1b\x30\x91\x21\x4b\x97\xa8\x0f\x70\xe0\xa3\x9c\x62\x8e\xf6\x7c\x19\x2a\xcb\xef\x5e\xe1\x08\xb5\x51\x17\xeb\xf7\x2f\xfb\xff\xbe\x18\x1d\x4e\xc4\x68\x6c\x80\x05\x84\x14\xb8\x0c\x8f\x65\x01\xf9\xb6\x29\x15\x87\x66\x3e\x9b\xd1\x43\x08\xad\x1d\x41\x5c\xa9\xa4\x1e\x6d\x17\x63\xf5\x1e\x50\xaa\x37\x58\x30\x25\x73\xdc\xef\x97\x05\xc8\xa7\x74\x6a\xea\xf9\xdc\x15\x87\x66\x90\xed\x04\x33\x0b\x4e\xad\xad\xea\xfd\xdd\xb5\x4f\xa9\x46\x47\x6a\x8d\xac\x1e\x5b\x3b\x3c\x54\x57\xb6\x3b\x6b\x50\x36\x83\x72\xf9\x29\xb4\xa9\x98\xcc\xf6\xc4\x77\xdb\x5d\x1c\x69\x53\x43\x57\x1c\x62\xa4\xc9\xe4\xbd\x1b\x86\x40\x2b\x6f\xc2\xb9\xc4\xaf\x97\x3e\xe7\x6b\x6f\x7e\xd9\x9b\x5a\x26\x9a\x95\x47\x4f\xe3\xbd\xb5\x0e\x3d\x2a\xde\x66\xf4\x8a\x72\x3c\xfd\x53\x71\xc0\x1f\x28\x7f\x4d\xfa\xfc\xa3\xa9\x9f\x4b\x59\x08\xd9\x3d\x25\x5e\xc3\x4f\x00\x00\x00\xff\xff\x51\xf5\x70\x94\x66\x01\x00\x00")
+var _assetsTemplatesUsersSamaritansummaryHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x90\xcd\xaa\xdb\x30\x10\x85\xf7\x81\xbc\x83\xd0\x2a\x59\x38\x82\x42\x76\xb6\x21\xc4\x09\x64\xd1\x6e\xd2\x3e\xc0\x58\x1a\xd7\x22\xb2\x94\x4a\x63\xd2\x20\xf4\xee\xc5\x36\xa6\xb9\xff\x97\xbb\x11\xa3\x41\xe7\x9c\xef\x28\x46\x85\x8d\xb6\xc8\x78\x80\x0e\xbc\x26\xb0\x59\xe8\xbb\x0e\xfc\x9d\xa7\xb4\x5c\xe4\xed\x96\x49\x03\x21\x14\x5c\x82\x57\x19\x69\x32\xc8\xcb\x18\x37\xbf\x02\xfa\xcd\x0f\xe8\x30\x25\xb6\x9a\xef\x87\x0e\xb4\x49\x69\x9d\x8b\x76\x5b\x2e\x17\x79\xed\x87\x13\x58\xeb\xb1\x29\xf8\x46\xcc\xef\x4e\x55\x4a\x02\x95\x26\xce\xbc\x33\x58\xf0\xba\x27\x72\x96\xcf\x59\x35\x59\x56\x93\xcd\xae\x5e\x0f\x28\x6c\xcc\x36\xda\x5e\x78\x79\x50\x9a\x72\x01\xe5\x72\x11\xa3\x6e\xd8\xe8\x77\xc6\x10\xb4\xb3\x03\xf0\xb8\xc4\x3f\x4f\xf6\xe3\x7c\xaa\xd8\xff\xec\x07\x28\x11\x26\xb1\xf8\xd6\x80\xf8\x00\x27\xa0\x74\x56\x3d\x03\xfa\x79\x73\xd9\x11\x24\x39\xcf\x76\x3d\xb5\x68\x49\x4b\x20\xed\xec\x44\x99\x4f\x5e\xcc\x59\x69\xb4\xbc\x14\x5c\x87\xd0\xe3\x1e\x0c\x0e\x4e\x47\x44\xb5\x5a\x7f\x2e\xa8\xf2\xee\x9a\xb9\xa6\x61\xb3\x38\x17\x93\xf7\x7b\x7f\xfc\xf7\xea\x3c\x7d\xa5\x56\xe5\x6e\xd6\x38\x50\xec\xfb\x9d\x55\x40\xf0\x46\x1b\x85\x06\x09\xcf\x2d\x1a\x42\xff\x4a\x13\x05\xf6\x37\xfa\x47\xe3\xbd\x71\x01\xd9\x4e\x4a\xd7\x5b\x7a\xa8\x10\x23\x5a\x95\xd2\xcb\xe1\xdf\x00\x22\x15\x76\xd0\xa4\x02\x00\x00")
 
 func assetsTemplatesUsersSamaritansummaryHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -368,12 +520,12 @@ func assetsTemplatesUsersSamaritansummaryHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/users/samaritanSummary.html", size: 358, mode: os.FileMode(511), modTime: time.Unix(1583804446, 0)}
+	info := bindataFileInfo{name: "assets/templates/users/samaritanSummary.html", size: 676, mode: os.FileMode(436), modTime: time.Unix(1792433591, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesUsersSheltersummaryHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\x31\x6b\xc3\x30\x10\x85\xf7\x40\xfe\xc3\xa1\x29\x81\x3a\x9a\x92\xc9\xf6\x92\x64\xc8\x52\x0a\xa6\x3f\x40\xb6\x2e\xb5\x88\x2c\xb5\xd2\x19\x1a\x84\xfe\x7b\x91\x8c\x5b\x9b\x2e\xe2\xee\xa1\xf7\xde\xc7\x85\x20\xf1\xae\x0c\x02\xf3\x3d\x6a\x42\x57\xf8\x71\x18\x84\x7b\xb2\x18\xb7\x9b\xb2\x3f\x42\xa7\x85\xf7\x15\xeb\x84\x93\x05\x29\xd2\xc8\xea\x10\x0e\xef\x1e\xdd\xe1\x55\x0c\x18\x23\xec\xe6\xfd\x3a\x08\xa5\x63\xdc\x97\xbc\x3f\xd6\xc9\x7d\x5a\xb9\xfd\xd8\xe6\x00\x20\xfc\xa6\x62\x18\x09\x25\xab\xb7\x1b\x00\x80\x39\xa1\x21\x87\x48\x31\xbe\xfc\x2a\x67\x45\xcf\xe5\xde\x90\x20\x5c\x0a\x6f\xd6\x93\xd0\x67\x2b\x31\x13\xf3\xfe\x94\xaa\x5b\x97\x5e\x01\xbd\xc3\x7b\xc5\x0e\x7c\xfe\x7d\xbb\xc4\xc8\x51\x2a\x62\xe0\xac\xc6\x8a\xb5\x23\x91\x35\x6c\x06\x6d\xc9\x40\x4b\xa6\xf8\x74\x2a\x5d\x01\x32\xb8\x56\xe6\xc1\xea\xab\x54\x54\x72\x51\x6f\x37\x21\xa8\x3b\xe4\xbc\x06\xbd\x57\xd6\xa4\xe6\x2c\xe2\xd7\x4a\xcf\xf3\xed\x02\x7f\xdd\x09\x2d\x37\x82\x35\x9d\x56\xdd\xa3\x62\x12\x35\x12\x36\xd3\xf9\x77\xfb\x7f\x28\x52\x98\x0f\x74\x4b\x92\x4b\x76\x94\x7c\x4a\xca\x40\x68\xe4\x04\xb1\x1e\x7e\x02\x00\x00\xff\xff\x4e\x3d\x94\xda\xdf\x01\x00\x00")
+var _assetsTemplatesUsersSheltersummaryHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xc1\x6e\xdb\x38\x10\xbd\x07\xc8\x3f\x0c\x74\xd8\x8d\x81\x95\x84\x4d\x91\x1c\x5a\x59\x40\x12\x27\x6d\x0e\x4d\x8b\x38\x85\x81\xde\x68\x71\x64\x11\xa6\x48\x87\x1c\x41\x31\x04\xfe\x7b\x41\x5a\x8a\xed\x34\x4d\x03\xdf\xc4\xd1\xcc\x9b\xf7\x1e\x87\xd3\x75\x1c\x4b\xa1\x10\x22\x5b\xa1\x24\x34\xb1\x6d\xea\x9a\x99\x75\xe4\xdc\xf1\x51\x56\x9d\x41\x21\x99\xb5\xe3\xa8\x60\x86\xc7\x24\x48\x62\x94\x77\x5d\xf2\xc3\xa2\x49\xee\x58\x8d\xce\xc1\xc9\x70\xbe\xae\x99\x90\xce\x8d\xb2\xb4\x3a\xcb\x7d\xf5\xf9\x5e\xb5\x6d\xe6\x01\x00\x08\x9f\x28\xae\x1b\x42\x1e\xe5\xc7\x47\x00\x00\x03\xc2\x94\x0c\x22\x39\xf7\xdf\x73\xe4\x4a\xd0\x7a\xf7\x3c\x25\x46\xb8\x1b\xf8\xae\x2d\x31\x79\xa5\x39\x06\xc6\x69\x75\x9e\x1f\x1f\x75\x5d\x2b\xa8\x82\xe4\x56\x11\x5b\xe2\xb4\xa8\x90\x37\x32\x24\x74\x9d\x28\x21\x79\x60\x4b\xb4\x97\x5a\x2f\x85\x5a\x58\x1f\xce\x56\xfb\x42\xf1\x89\xa0\xa6\xf8\x03\xd4\xf3\xf8\xff\x28\x9f\x18\xbd\x8a\x75\x59\x42\xa5\x1b\x63\x83\xe2\x07\x51\xe3\x4f\xad\xd0\xb9\xd1\xc7\x2c\x5d\x79\xbd\x8d\x1c\x40\xa4\xb0\x14\x37\xca\xd2\x5a\x22\xf7\x18\xa7\x5b\xa5\x86\xa9\x05\x42\xf2\xc5\x23\x39\x97\x49\xe1\xfd\x9c\x21\x2e\x39\x5b\x3b\xe7\x85\x7f\x5b\xa1\xb2\xce\xfd\xa3\x38\xb3\xd5\xa7\xae\x4b\xae\xa4\xb6\x68\x9d\xcb\xd2\x90\x8d\x8a\x3b\xf7\x02\xee\x52\xb2\x62\xa9\x1b\x9a\x30\xc2\x1e\x36\x54\x71\x0f\x38\xf1\xc8\x83\x27\xf7\xc8\xac\x56\xfd\xbd\x39\x37\xea\xf1\xf6\xb1\xb3\xb4\x91\xc1\xc7\xfe\xbc\x35\xd4\x92\x69\x0a\x12\xda\x33\x7c\xc5\xb5\x30\x1d\x9e\xe9\x6a\x0b\xb6\xf3\x21\x4a\x48\x66\xc2\x56\xde\xa0\xf7\x18\xff\x9c\x7b\x90\xc7\x3b\x9d\xbc\x21\x19\x83\xca\x60\x39\x8e\xd2\x76\xf8\x91\x76\x5d\x72\x3b\x71\x2e\xd0\xde\xcc\x73\x96\xb2\x1c\x4e\x16\x9a\x49\x6f\xdd\x67\xcd\xa4\xf7\x34\x8c\xf5\x5b\x0e\xbd\xea\xc5\x8d\x96\x52\xb7\x40\x95\xb0\xd0\x3f\xb0\x7f\x2d\x18\x7c\x6c\xd0\x6b\x82\x2d\xa3\x12\x91\xdb\xb4\xcf\xb1\xe9\x30\xdd\x9e\x5b\xc2\x48\xd7\x51\x7e\x41\xba\x0e\xdc\xb4\x79\x67\x9d\xb1\x36\xca\xef\xa7\x53\x5f\xd5\xdb\x37\x37\xde\xc4\xa1\x3a\xd9\xcb\x4f\x91\x0b\x8a\xc0\x68\x89\xe3\x68\xde\x10\x69\x15\x0d\x9a\xe6\xa4\x60\x4e\x2a\x5e\x19\xe1\xb7\x03\x04\x8d\x52\xa8\x65\x94\x5f\x73\x41\xbe\xc3\x70\xbd\x1e\x6f\x8a\xd6\x0a\xad\x9e\xef\x1c\x1f\xf7\xe2\xe1\xfb\x76\x02\xdb\xde\x3b\xa4\x52\xbb\x29\x4e\x4f\x4b\x96\xfe\x85\x8e\xc5\x42\x2b\xfe\x82\xd0\x43\xab\xe3\x1b\x56\x90\x36\x70\xd1\x50\x85\x8a\x44\xc1\xfc\xc4\x6e\x58\xfe\x49\xbd\x08\xbb\xe2\x90\x86\x9b\x2d\x03\xe1\x45\xbf\xdd\xa3\x15\x7c\x81\x74\x48\x8f\x19\xce\xad\x20\x84\x59\x40\x78\xd1\x65\x67\x9e\x15\xb6\x87\xc0\xdf\x61\x0b\xc3\x6b\x79\x5b\x02\x3e\xad\xb4\x39\x48\xc2\x44\xb7\x4a\x6a\xc6\xe1\xeb\x1a\x26\x8c\x58\xdf\x67\x43\x13\xb4\x2a\xa4\x28\x96\xe3\x88\xa3\x44\xc2\xe9\x66\xa2\x4f\x46\xbf\x61\x73\xbf\x3f\xcd\x2e\x70\x58\x74\x70\x51\x14\xba\x51\x94\xa5\x1b\xc0\xfd\xed\xb5\xff\xf1\x6b\x00\x1c\xbe\x17\xc0\xf6\x06\x00\x00")
 
 func assetsTemplatesUsersSheltersummaryHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -388,12 +540,12 @@ func assetsTemplatesUsersSheltersummaryHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/users/shelterSummary.html", size: 479, mode: os.FileMode(511), modTime: time.Unix(1583804446, 0)}
+	info := bindataFileInfo{name: "assets/templates/users/shelterSummary.html", size: 1782, mode: os.FileMode(436), modTime: time.Unix(1792434193, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesUsersUserHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\x4f\x6f\xda\x4e\x10\xbd\xf3\x29\xe6\xb7\x8a\x14\xa2\x5f\xc0\xea\xb5\x31\x48\x51\x13\xb5\x91\xd2\x4a\x0d\x69\xd5\x1e\x07\xef\x80\xb7\xb5\x67\xcd\xee\x18\x42\x90\xbf\x7b\xe5\x3f\x10\x9b\x94\xc8\x87\xfa\x60\x7b\xd7\xef\xcd\xcc\xce\x7b\x9e\xdd\x4e\xd3\xc2\x30\x81\x4a\xd1\xf0\x28\xb2\x2c\xc4\xa2\x8a\x62\x10\x6a\xb3\x86\x28\x41\xef\x27\x2a\x42\xa7\xd5\x74\x00\x00\x70\xbc\x3d\x8a\x09\x35\xb9\xe6\x6b\x79\x7d\xf3\xe4\xe0\x8e\x17\xb6\xc6\x07\xda\xac\x4f\x50\xe7\x56\x6f\x5b\xc4\xdd\xce\x2c\x80\x56\x30\x2e\x23\x54\xb7\xc7\x6d\x46\xf0\xae\x28\x5a\x10\xa1\x34\x4b\x50\x08\x94\x8f\x29\x11\x72\x23\x9f\xa7\x29\xba\xad\x82\x71\x07\x48\x89\xa7\x53\x4c\x4c\xd1\x19\x41\x3e\xc9\x65\xdd\xac\x9b\xf2\xf7\x0f\xc1\x79\x42\xfb\x33\xd4\x8b\xea\x3e\xf2\xe2\x4c\x46\x87\x26\x49\xd9\x95\x03\xae\x5c\x8c\x34\xba\xdf\xad\xc3\x86\x12\xbf\x2c\xca\xeb\x03\x0a\x2d\xad\xdb\xbe\x20\x82\x36\xe4\x15\xfe\x23\xb1\x26\xd7\x17\x3d\x33\xcf\xd4\x17\xfb\x35\x47\x16\x23\xbd\x2b\x99\x09\x4a\xee\xfb\xa2\x7b\xa8\x5c\xf1\x70\xdf\xbe\xb9\x30\xcc\x85\x47\x3e\xc5\x24\xa9\xde\x6c\x2e\x89\x61\x1a\x65\xce\xd4\xea\x39\x9b\xd0\x44\xcd\x73\x11\xcb\x0a\x62\x47\x8b\x89\x0a\x8c\x50\xea\x03\xa6\x8d\x9a\x7e\xa1\x0d\xdc\x09\xa5\x61\x80\xc7\xc5\x1c\xd9\xe4\x5f\xa6\x56\xd3\xef\xa6\x49\xec\xff\x96\xf9\x60\xb2\x6e\xd7\xca\x37\x42\xbd\x77\x52\xf9\x93\xb4\xff\x11\x87\xbc\x24\x38\x33\xac\xe9\xe9\x12\xce\x28\xa1\x94\x58\xe0\xfd\x04\xc6\x55\x26\x68\x07\x15\xd7\xcd\x1a\x8a\x9e\xee\x76\x07\xd6\x78\x6f\x3a\x28\x8a\x30\x10\xfd\x36\xb8\x76\x5c\x2f\x68\x69\xb7\x5e\xc0\xbd\xd7\xde\x02\xfb\xca\x5f\xd7\x7e\x26\xce\xf0\xb2\x95\xa4\xda\x3f\xc9\x0c\xb1\x2b\x47\x3b\xed\xdd\x0d\x14\xc5\xb1\x78\x47\xa2\x1b\x5e\xd8\x5a\xc2\x52\xbc\x6e\x8e\x30\x68\x77\xb6\x3b\x30\x1a\xc5\xc2\xa0\x9a\x0c\xd3\xc1\xfe\xeb\xe0\x65\xd6\xfa\xc8\x99\x4c\x3a\xd3\xb6\xde\x02\xd9\x66\x34\x51\x42\x4f\x12\xfc\xc2\x35\xd6\xbb\xcd\xd8\x58\xa3\x03\x4d\x09\x09\xcd\xea\xd1\x07\x13\x58\xe4\x1c\x89\xb1\x0c\xc3\x0b\xd8\x1d\x0a\x2a\x91\x8e\x56\x30\x01\xa6\x0d\xfc\xf8\x7c\xff\x49\x24\x7b\xa0\x55\x4e\x5e\x86\x17\x57\x07\x9c\xa3\xd5\xd8\x66\xc4\x43\x75\x73\x7b\x7f\xfb\x78\xab\x2e\x61\x63\x58\xdb\xcd\x38\xb1\x11\x96\x71\x2f\xba\x58\x76\x84\x7a\x5b\x0a\x42\x51\x5c\x19\xf1\x54\x09\x35\x45\x72\xc7\x10\x23\xeb\x84\xae\xfd\x96\xa3\x07\xf2\x99\x65\x4f\xc3\x0e\xae\x09\x7f\xf9\x6a\xf3\xa8\x98\xb1\x75\x66\x69\x18\xfe\x87\xf3\xa0\x99\xfe\xfe\xfc\x35\x4b\xfd\xb4\x39\xa0\x23\x3e\x17\xc0\x5c\x62\xeb\xcc\x33\x69\x10\xdb\x74\x0f\x24\x36\x1e\x9a\x00\xff\xa9\x0e\xbf\xd5\x9c\x62\xd0\x39\xbb\x27\xd6\xc3\xba\x1d\xc5\xd5\x20\x0c\x6a\x69\x0e\xf2\xfe\x09\x00\x00\xff\xff\x8d\xb3\x48\xa5\x49\x07\x00\x00")
+var _assetsTemplatesUsersUserHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x5d\x6f\xdb\xb8\x12\x7d\xf7\xaf\x98\x0a\x45\x63\xa3\xb1\xd4\xde\x7b\x9f\x1a\xd9\x17\x41\x93\x76\xbd\xe8\xb6\xdd\x38\x5d\x6c\x81\xbe\xd0\xe2\xc8\xe6\x46\x1a\x2a\x24\x65\x47\x35\xf4\xdf\x17\xa4\x24\x5b\xf2\x47\x92\x6e\xdb\x8d\x02\xdb\x22\x67\x38\xa3\x33\xe7\x0c\xa9\xf5\x9a\x63\x2c\x08\xc1\x4b\x99\xa0\x61\x24\xc9\x20\x19\xaf\x2c\x7b\x21\x17\x4b\x88\x12\xa6\xf5\xc8\x8b\x98\xe2\xde\xb8\x07\x00\xb0\x3b\x3c\x5c\x20\xe3\xa8\xea\x59\xfb\xff\x49\xa3\x82\x09\xc5\xd2\x8d\x84\x01\x17\xcb\x23\xae\x33\xc9\x8b\x96\xe3\x7a\x2d\x62\xc0\x5b\xf0\xed\x0a\xee\xe3\xba\xc8\x10\x5e\x96\x65\xcb\xc4\x60\x9a\x25\xcc\x20\x78\x7a\x81\x89\x41\x35\xd4\x79\x9a\x32\x55\x78\xe0\x77\x0c\x31\xd1\x78\xcc\x93\xa5\x4c\x09\xc3\xe8\xa8\x2f\xf1\xb2\x6c\xa7\x5f\x7f\xb9\x0c\xfd\xf3\x4c\x5c\xcb\x1b\xa4\x69\x24\x33\xd4\x65\xd9\x6b\xaf\xcd\x32\x31\x34\x76\x56\x57\x8b\x36\x8b\x85\x86\xcd\x12\x6c\x1e\xbf\xba\x71\x9f\x43\x6d\x94\xc8\x70\x83\xaf\xb1\x80\x6e\xec\xec\xcd\x90\x33\x75\xd3\xc2\x29\x34\x8b\xed\x8d\xbd\x5e\x33\x83\x73\xa9\x8a\xad\x45\x60\x16\xf7\xd8\xbf\x45\xe2\xa8\x1e\x6b\x3d\x15\x5f\xf1\xb1\xb6\xbf\xe7\x8c\x8c\x30\x8f\xce\x64\x6a\x98\xc9\xf5\x63\xad\x1f\x41\x10\xfb\x1f\xb2\x06\xbe\x99\x21\x98\x19\x1a\xea\x94\x25\x89\xfb\x25\x73\x93\x08\xc2\x61\xa6\x44\x55\x78\x25\x13\x1c\x79\xb3\xdc\x18\x49\x1e\x2c\x14\xc6\x23\x2f\x10\x06\x53\x1d\x10\xae\xbc\xf1\x7b\x5c\xc1\xc4\x60\x1a\x06\x6c\x37\x99\x95\x30\x8b\x8a\xac\x53\xd4\x5a\x48\x2a\xcb\x4e\x86\x93\x0b\x78\xea\x7e\xf8\x93\x8b\x9f\x94\xa2\x48\x33\xa9\x8c\x37\x9e\xb8\xef\xbd\x1c\x7f\x54\x18\xbc\xab\xc2\x5c\xde\x1d\x0c\x53\x73\xbc\xa1\xfa\xce\x54\x57\x87\x3f\x32\x2d\x6f\xfc\x87\xa8\xcb\xa3\x8f\x25\x75\x80\x5b\x96\x93\xc8\x78\xa3\x37\xdb\x85\xb6\xae\xeb\xb5\x62\x34\x47\x78\x2a\x88\xe3\xdd\x29\x3c\xc5\x04\x53\x24\x03\xaf\x46\xe0\x5b\x22\x68\x68\x2f\x6a\x54\x37\x6a\x68\xf8\x78\xbd\xde\x78\xf9\x8d\x34\xa1\x2c\xc3\xc0\xf0\xfb\x8d\x2b\x5d\x3e\xca\xd4\x8a\xf2\x51\x86\x6f\xa4\x4a\x99\x31\xc8\x1b\x69\xde\xe7\xa5\x9d\x1c\xcf\xf5\xd4\x28\x41\xf3\x56\x34\x37\x7e\xd4\x33\x64\xdd\xba\xb4\xe3\x4f\x2e\xa0\x2c\x77\xab\xb8\x53\x7d\x41\xb1\xac\x6a\x69\xab\xd8\x8d\x11\x06\x6d\x88\xdb\x45\x0d\x83\xba\x74\x61\xe0\x1a\x69\xd3\x9e\x2f\x30\x41\x83\xdc\xd5\xea\x7b\xf6\xb1\x2b\x8c\x90\x4c\x52\x40\xbd\xe0\xb7\x6e\x67\x61\xd6\x99\x35\x78\x67\xbc\x71\xbd\x16\x38\xa0\x20\x62\x04\x33\x04\x85\xda\x48\x85\x1c\x72\x32\x22\x01\xb3\xc0\xe2\x44\x21\x64\xa8\x52\x46\x55\x0e\x0a\x53\xb9\x44\xee\x87\x41\x36\xde\x4b\xe4\xa1\xad\x05\xd2\xd9\xf0\x45\x3b\xb3\x87\xf7\x98\xa6\xff\x36\xfc\xdd\xaa\xa7\xf9\xb3\xb3\x15\x61\x0f\xcf\x59\x86\x1e\x9e\x69\x98\x78\x78\xb6\x46\xe8\xf0\x64\x77\xb4\x23\xe4\x43\x62\x6e\x0b\x7a\x97\x17\xcd\xfc\x41\x19\x6f\x35\xb1\x51\xf0\x21\xf2\xb7\xcc\x2a\x28\x1e\x30\xb2\x98\x3c\x60\xb2\xa7\xd7\xfb\xed\x63\x67\xfe\x89\xc4\xdd\xb5\x48\xb7\x0f\x69\x6f\xee\x73\x0c\x2b\x25\x82\xa4\x28\x11\xd1\xcd\xc8\xab\x29\x68\xc1\xe9\xaf\xd7\x6e\xc3\x1a\xec\xe9\x54\xa7\x07\x5b\xf4\xf8\xaa\x72\x0e\x83\x6a\xd5\x1d\x05\xef\xab\xf8\x48\x7b\xde\xd6\x6e\xa3\xe8\x9a\xe5\x8d\x71\xf3\xdd\xdb\x1e\x5b\x75\xa4\x44\x66\x3a\x07\xd7\x6a\x08\x4c\x91\xe1\xc8\xb3\xb2\x0b\xfe\x62\x4b\x56\x8d\xd6\x14\x5f\x32\x05\xdc\x41\x35\xad\x4e\x91\x30\x82\x38\xa7\xc8\x08\x49\xd0\x1f\xc0\x7a\x93\x97\xb5\xcc\x98\xd6\x2b\xa9\x38\x8c\x20\x53\x32\xcd\x4c\xdf\x7b\x9d\x48\x6d\x5b\x64\x21\x73\x05\x2c\x8a\x64\x4e\x06\xb4\x98\x93\x86\x42\xe6\x20\x73\x03\xb8\x44\x55\xac\x16\xa8\xd0\x87\xcf\xd6\x2c\x43\xa5\x25\xb1\x04\x6c\xbf\xb3\x65\xb3\xc1\x84\xae\x13\xe1\xc0\x62\x83\x0a\xfe\xfb\x02\x38\x2b\x34\xe4\x94\xa0\xae\x56\x4b\xe4\x1c\x04\x01\x9b\x33\x61\xfb\x45\x2c\x15\xda\x26\x41\xfe\x17\xfa\x42\x97\x64\xbd\x5c\x1e\x9b\x3c\x8d\x84\x28\x91\x1a\x3b\xe9\xf9\xde\xe0\x6c\xf3\x58\x22\x86\xfe\xf6\xb1\x46\x23\xa0\x3c\x49\xda\xcf\x6d\x2f\x85\x26\x57\x04\x31\x4b\x34\x6e\x5d\xcb\xde\xe6\xa7\x05\x47\xe1\x2d\x8c\x80\x70\x05\x7f\xfe\xf6\xee\x17\x63\xb2\x2b\xbc\xcd\x51\x9b\x7e\x2b\x9a\xc2\x5b\x5f\x66\x48\x7d\xef\xe2\xf2\xdd\xe5\xf5\xa5\x77\x0a\x2b\x41\x5c\xae\xfc\x44\x46\x0e\x87\x41\xd7\x96\x14\x32\x5e\xd8\xed\x08\xa3\x85\xdb\x8f\x8f\xd5\xa7\x95\xe7\x82\x11\x4f\xf0\x5c\x17\x14\x5d\xa1\xce\x24\x69\xec\x77\xec\xea\xe5\x4f\xf7\x06\x77\x92\xf1\xa5\x12\x73\x41\xf0\x1c\x4e\x82\xfa\x2d\x43\x9f\xec\x7b\x79\x9f\x65\x0e\x4c\x21\x9d\x18\x60\xb9\x59\x48\x25\xbe\x62\x0b\x7c\xb3\x10\xba\x01\xff\x89\xd7\x71\x1f\x1c\x84\xd3\xc2\xa4\x91\x78\xff\xd7\xe9\x87\xf7\xbe\x7d\x2f\xa0\xb9\x88\x8b\xfe\x1a\x3e\xd6\xa5\x7a\xb5\xe5\x62\x39\xa8\x30\x2b\xcf\x7a\x1b\x4a\x0b\xad\x73\x7c\xcd\x12\x24\xce\xd4\x1b\x44\x7e\x94\xd6\xb6\xfe\x4f\x22\x49\xb1\x50\x69\xdf\x7b\x8b\x06\x18\x44\xb5\x23\x24\x82\x6e\xc0\x9d\x6e\x1d\x7f\xb8\x92\xd9\x50\xc6\xb1\x06\x46\x6e\xd7\x10\x29\x70\x64\xdc\x36\x01\xfd\x7f\x38\xa7\x42\x12\x56\xf6\x66\x81\x95\xb7\xdd\xd9\x34\x3a\x9a\xa6\xa7\xce\x8f\x51\x51\x4d\x59\x46\xaf\x50\x21\xcc\xc5\x12\x37\x7c\xd6\x46\x66\x1a\x56\x52\xdd\x08\x9a\xfb\xde\xe0\x5f\xe0\xe2\xc7\x0f\xd3\x6b\xef\xf4\x78\xf1\xbd\x20\x46\xe4\x3a\x68\x70\x69\xeb\xe7\x1f\xb0\xd4\x42\x6e\xdd\x9c\x93\x7d\xf1\x41\x27\xbc\xff\xc1\xb3\x67\x2e\x29\xcb\xf6\x5c\xbb\xb1\xff\xbc\x78\xb9\xeb\x6d\xaf\xa6\xf9\x4c\xf3\x99\xed\x66\x33\xb4\x5c\x73\x2c\x73\xc0\x0a\xaa\xf4\xbe\xa9\x23\xcb\xb2\x57\xde\x29\x38\x36\x65\x4c\x69\xac\xe3\x57\xda\x18\xf8\x9f\xae\xde\x0d\xce\xf6\xa2\x1c\x46\xda\x5e\x65\xef\x80\xdd\x21\xd5\x59\x91\xed\xe2\x7a\x0a\xde\x07\x4a\x0a\xd8\xbc\x7c\x6b\x58\xb0\x25\x02\xdb\xf0\x6b\x93\xf8\x93\x36\xd2\x0d\xbf\x1b\xd4\x9d\x40\x06\x67\x7b\xdc\x6f\xed\x60\x9d\x32\xd8\x93\xd6\xe4\xa2\x0d\xe7\xcf\x60\x4a\xfd\x42\x02\xcf\xdd\xc9\x6e\x72\xe1\x06\xeb\x94\xbe\x93\x37\xdf\x8e\xf3\xd1\xbe\x54\x27\x54\x71\xc6\x26\xfa\x2d\x48\x87\x81\x25\x5d\x66\xc6\xbd\xf5\x1a\x89\x97\xe5\xdf\x03\x00\xfb\x7d\x07\x1e\x3c\x12\x00\x00")
 
 func assetsTemplatesUsersUserHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -408,12 +560,12 @@ func assetsTemplatesUsersUserHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/users/user.html", size: 1865, mode: os.FileMode(511), modTime: time.Unix(1603506893, 0)}
+	info := bindataFileInfo{name: "assets/templates/users/user.html", size: 4668, mode: os.FileMode(436), modTime: time.Unix(1792433591, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _assetsTemplatesUsersUsersHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xc1\x6e\xdc\x20\x10\x86\xef\x7e\x8a\x11\xca\x31\x5e\x94\x6b\x85\x91\xa2\xb4\x87\x5e\xaa\xaa\xab\xf6\x3e\x5e\x66\x63\x54\x0c\x2b\x98\x28\x5d\x21\xde\xbd\xb2\xcb\xc6\xae\xb7\x8d\xd6\x07\x8b\x19\xbe\xb1\xe7\xff\x07\x72\x36\x74\xb4\x9e\x40\x8c\x68\x7d\x7b\x08\x9e\xc9\xb3\x28\xa5\x51\xc3\x83\x7e\x74\x0e\xf6\x03\x39\xa6\x98\x94\x1c\x1e\x74\xa3\xfa\xa8\x1b\xc5\xd8\x3b\x82\x83\xc3\x94\x3a\xf1\x27\x98\xdf\x6d\xe2\x68\x4f\x64\x84\x6e\x00\x00\x14\x0f\x84\xe6\x8d\x9b\x82\xd6\x60\xfc\x59\xb7\x2b\xb2\x04\xd3\x53\x7f\x07\x5f\x70\xa4\x85\x92\x6b\xec\xaa\xe6\xd3\x88\xd6\xdd\x0a\x3f\x1a\x13\x29\xa5\x5b\x71\x85\x97\xfe\x7b\xf6\xd0\xb3\x6f\xd3\x88\xce\xcd\xab\xf0\xc2\xce\x7a\x6a\x4f\xd1\x8e\x18\xcf\x02\x62\x70\xd4\x89\xfe\x85\x39\x78\x01\x43\xa4\x63\x27\x64\xaa\x06\x4a\x4f\xaf\x42\x7f\xa3\x67\x9b\x26\x81\x55\xa8\x92\xa8\xff\xd1\xcb\xb4\x22\x34\x17\x1f\xfb\x60\xce\x0b\x96\x73\x44\xff\x4c\x70\x67\xbd\xa1\x5f\xf7\x70\x47\x8e\x46\xf2\x0c\x1f\x3a\xd8\x7d\x4f\x14\x13\x94\xb2\x92\x14\x37\x92\xd8\xe8\x9c\xdf\xaa\x76\x93\xd5\x50\x8a\x92\x6c\xde\x07\x67\x9f\x6f\x22\xf7\x1c\x89\xb8\x94\x7b\x58\x67\x9f\x2c\x9f\x61\x9b\xdc\x33\x32\x5d\x65\xbf\x86\xc4\xe8\x9e\x82\xf9\x7f\x67\x0a\xab\xc3\x3b\xb9\xae\xfc\xfc\x11\x4a\xd9\x8e\x62\x33\x42\xeb\x8f\x41\xe8\x1f\x96\x5e\x27\xfb\xff\xfe\xbc\x92\x6b\xbf\x72\x26\x6f\xaa\x99\x4a\xd6\x39\x28\x39\x9f\x76\xdd\x5c\x76\x9b\xe5\x1a\xa5\x43\xb4\x27\x5e\x5d\xa4\xca\xfc\x0e\x00\x00\xff\xff\xd0\xe5\x4c\xdf\x69\x03\x00\x00")
+var _assetsTemplatesUsersUsersHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xc1\x8e\xdb\x20\x10\x86\xef\x7e\x8a\x11\xda\xe3\x3a\x68\xaf\x15\x46\x5a\x6d\x7b\xe8\xa5\xaa\x1a\xb5\xf7\x71\x98\xac\x51\x31\x44\x30\xab\x34\x42\xbc\x7b\x65\x97\xc4\x6e\xda\x8d\x22\x24\x8b\x19\xfe\x1f\x0f\xdf\x40\xce\x86\xf6\xd6\x13\x88\x11\xad\x6f\x77\xc1\x33\x79\x16\xa5\x34\x6a\x78\xd2\xcf\xce\xc1\x76\x20\xc7\x14\x93\x92\xc3\x93\x6e\x54\x1f\x75\xa3\x18\x7b\x47\xb0\x73\x98\x52\x27\xfe\x04\xf3\xb7\x4d\x1c\xed\x81\x8c\xd0\x0d\x00\x80\xe2\x81\xd0\x5c\x74\x53\xd0\x1a\x8c\x3f\xeb\x72\x95\x2c\xc1\x34\xea\xef\xe0\x0b\x8e\xb4\xa8\x24\x0f\x37\x3c\x9f\x46\xb4\xee\x5e\xf1\xb3\x31\x91\x52\xba\x57\xae\xf0\x5c\x7f\xcf\x1e\x7a\xf6\x6d\x1a\xd1\xb9\x79\x16\xde\xd8\x59\x4f\xed\x21\xda\x11\xe3\x49\x40\x0c\x8e\x3a\xd1\xbf\x31\x07\x2f\x60\x88\xb4\xef\x84\x4c\x15\xa0\xf4\x74\x14\xfa\x1b\xbd\xda\x34\x1d\xb0\x1e\x54\x49\xd4\xff\xa9\x65\x9a\x11\x9a\x33\xc7\x3e\x98\xd3\x22\xcb\x39\xa2\x7f\x25\x78\xb0\xde\xd0\xaf\x47\x78\x20\x47\x23\x79\x86\x0f\x1d\x6c\xbe\x27\x8a\x09\x4a\x59\x36\xe5\xb8\x58\xa7\xa1\xd8\xe8\x9c\x2f\xae\xcd\x84\x1a\x4a\x51\x92\xcd\x6d\xe1\xcc\xf9\x2e\xe5\x96\x23\x11\x97\xf2\x08\xeb\xec\x8b\xe5\x13\x5c\x27\xb7\x8c\x4c\xff\x64\xbf\x86\xc4\xe8\x5e\x82\x79\xbf\x32\x85\x95\xf0\x46\xae\x9d\x9f\x3f\x42\x29\xd7\xad\xb8\x6a\xa1\xf5\xfb\x20\xf4\x0f\x4b\xc7\x09\xff\xdf\xdb\x2b\xb9\xe6\x95\x33\x79\x53\x61\x2a\x59\xfb\xa0\xe4\x7c\xdb\x75\x73\x5e\x6d\x96\x67\x94\x76\xd1\x1e\x78\xf5\x90\x72\x26\x6f\x4a\xf9\x3d\x00\xd0\xe5\x4c\xdf\x69\x03\x00\x00")
 
 func assetsTemplatesUsersUsersHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -428,7 +580,107 @@ func assetsTemplatesUsersUsersHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "assets/templates/users/users.html", size: 873, mode: os.FileMode(511), modTime: time.Unix(1583804446, 0)}
+	info := bindataFileInfo{name: "assets/templates/users/users.html", size: 873, mode: os.FileMode(436), modTime: time.Unix(1604454525, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesUsersWidgetHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x6f\x6f\xdb\xb6\x13\x7e\xaf\x4f\x71\x25\xf0\xab\x65\xfc\x1c\xc9\xd9\x86\xbd\x48\x24\x01\x1d\xb6\x61\x1d\xd2\x36\x68\xd6\x75\x03\xf6\x86\x96\xce\x12\x1b\x8a\x64\xc8\x93\x55\xc3\xd0\x77\x1f\x28\x29\xb1\x14\x27\xc5\x5a\x04\x88\xc5\x3f\x7a\xee\x79\xee\x39\x1e\x75\x38\x14\xb8\x15\x0a\x81\xd5\x5c\xa8\xb3\x5c\x2b\x42\x45\xac\xeb\x82\xa4\x3a\xcf\x3e\xe2\xc6\x09\x42\xf8\x28\x8a\x12\x29\x89\xab\xf3\x2c\x48\x36\x36\x0b\x12\x93\x05\x00\x00\x37\x95\x6e\x81\x2a\x04\x41\x58\x3b\xd8\xeb\x06\x14\x62\x01\x5a\xf9\x67\x0b\xba\x55\xd0\x0e\x20\x11\xfc\x29\x9c\x20\x6d\x1d\x38\xc4\x71\xd9\xa0\x02\x8b\x77\x0d\x3a\x72\x2b\x68\x05\x55\xc0\x41\x0a\x75\x0b\xa4\xa1\x42\x69\x86\x39\xe4\x79\x05\x5a\x61\x1f\x53\x2b\x78\x8b\xa2\xac\x36\xda\xba\x08\xae\xb9\x23\xf4\x6b\xa0\xb7\x9e\x89\x43\x10\x8a\xf4\x80\xef\xe3\x5e\x04\x49\x6c\xb2\x20\x29\xc4\x0e\x72\xc9\x9d\x4b\xd9\x56\xdb\xfa\xac\xb4\xba\x31\x6c\x90\x91\x48\xbe\x41\x09\x5b\x6d\x53\xd6\xf6\x5a\x6f\x72\x2b\x0c\xb1\x6c\xf8\x5d\x41\x5b\x89\xbc\x82\xad\x20\x07\x42\x0d\xa4\xfa\x08\x86\x97\x98\xc4\xfd\xeb\x23\x14\xe1\x67\xe2\x16\xf9\x2c\x98\xcf\xab\xd5\x92\x81\x28\x1e\x45\x00\xab\x5b\x97\xb2\xef\x18\x58\xe4\x85\x56\x72\x9f\xbd\x94\x74\xe9\xfa\xb8\xe0\x6c\x9e\xb2\xc3\x21\x1a\x0c\xf8\xf0\xfe\xaa\xeb\xa2\x4f\x8e\x01\x77\x7b\x95\xbf\x2c\xe9\xd2\xef\x8d\x87\xcd\x7e\x98\xc4\xf7\xe1\xb3\x20\x89\x0b\xb1\xfb\x6a\xe1\xbf\x5a\x5e\x23\xcb\xfa\x9f\x7b\xd9\xb7\x88\xc6\x1d\x93\xbe\xe8\x6d\x75\xb4\x97\x42\x95\xdf\x26\xbe\x87\x7f\x4e\xbb\xd8\xfa\xd5\xa7\xb4\x33\x20\x41\x12\x53\xf6\xba\x2f\xb7\x16\xfb\x6a\x63\xd0\x8a\x82\xaa\x94\x9d\xaf\xd7\xff\x63\x50\x79\x9e\x94\xb2\x1f\xd6\x6b\xd6\xb3\xc4\x94\x6d\xb4\x2d\xd0\x5e\xc0\x9a\x3d\x24\x6d\x88\xf2\x5c\xd2\x4c\xf6\x33\xee\x50\x6a\x83\xd6\x41\xce\x15\x6c\x1a\x21\x0b\x5f\x60\x62\xa8\xea\xad\xd5\xb5\x1f\x82\xf3\x5c\x87\xf2\xe7\x04\x09\x87\xca\xe2\xf6\x09\xd3\xb4\x62\xd9\x13\x93\x49\xcc\xb3\x68\xa8\x50\x9f\xac\x69\x8e\xb4\xad\xef\x6d\xfa\x92\x87\x4f\xfb\xf8\x4a\x4a\xdd\x62\xf1\xce\x8a\x52\x28\xc7\xb2\x71\x0c\x37\x82\xd0\xcd\x4c\xfb\x0f\xc6\x29\x5e\x63\xca\xf8\x1c\x72\x42\xf5\x51\xb0\xd1\xd7\xef\x19\x18\xc9\x73\xac\xb4\x2c\xd0\xa6\xac\x22\x32\xee\x22\x8e\xdb\xb6\x8d\xf0\x33\xaf\x8d\xc4\x48\xdb\xd2\xa7\xc5\x72\x55\x22\x8c\xc9\xb9\x41\x22\xa1\x4a\x17\xcd\x61\xbb\xee\x70\x88\xba\x2e\x38\x1c\x50\x15\x5d\x37\x75\xed\x41\x86\xab\xb9\x94\x33\x0d\x7e\x13\xf8\x7f\x67\x75\x43\x58\xb0\xec\x9d\xc2\xbe\x2f\x80\x41\xeb\x5b\x0d\xae\x40\x8a\x5b\x84\x67\xd8\x45\x70\x85\x7c\xe7\x1d\x06\xac\x0d\xed\x7d\x63\x92\x48\xc0\xd5\x7e\x80\x71\xbe\x05\xf6\xbd\x60\x48\x7c\x94\xc4\x3d\x8b\xd1\xb9\xe1\x10\x7e\xa3\x89\xef\xc7\xd6\x78\x8d\xf6\x8d\x50\x0d\x21\xcb\xae\x34\x2f\x5c\xcf\x7d\x6c\xa7\xfd\xf3\xb0\x7a\x6a\xab\x50\xa6\x21\xa0\xbd\xc1\x94\xa9\xa6\xde\xa0\x65\x5f\x72\xd8\x9e\xc4\x83\x1d\x97\x0d\x4e\xca\xf9\xc1\x9c\x13\x6e\x5d\x37\x2d\x89\x53\xea\x50\x0b\x95\xb2\x73\x06\x35\xff\x9c\xb2\x1f\xd7\xeb\x51\xfa\x78\xe4\x3c\xe1\x64\xd3\x10\x69\x35\x12\x1e\x06\x0f\x84\x37\xa4\x60\x43\xea\xcc\x58\x51\x73\xbb\x67\xa0\x55\x2e\x45\x7e\x9b\x32\xc7\x77\x38\x67\x17\x2e\x59\x76\xe3\x6d\x1b\xa6\xe1\x7e\x3e\x89\x07\x50\x7f\xd0\x7d\x02\xb2\xfb\x6a\x0a\x82\xe3\x4d\x38\xb4\xd3\xd9\x5d\x38\xb6\xe3\x81\x97\xaf\xa6\xf8\x13\xdf\x71\x37\x5e\x12\xbd\x8c\x1d\xb7\x70\x4a\x04\x52\xd8\x36\x2a\x27\xa1\x15\x84\x4b\x38\x3c\x58\xe3\xb7\x7b\x06\xbf\x48\xac\x51\x91\x83\x14\x0a\x9d\x37\xfe\x39\x2a\x91\xc6\xe9\x9f\xf6\xaf\x8b\x70\x71\xec\x07\x8b\x65\x84\xe3\x0b\x97\x33\xa8\xf9\xd9\x84\x74\x86\x1d\x79\x77\x0b\xdf\x33\xc3\xc5\x7c\xe3\x62\x19\xf5\x06\x47\xce\x48\x41\xe1\xe2\x1f\xb5\x58\x46\x35\x37\xe1\x91\xb4\xee\x77\x4e\xa9\xfb\x3f\x8b\xd4\x58\x05\xc3\x62\x44\x56\xd4\xe1\xf2\xc8\xa8\x5b\x46\x5b\x21\x09\xed\xd7\xe1\xc0\x8b\x34\x85\xc5\x62\x8a\x73\x19\x3c\x0c\x7c\xc6\x0c\xb7\x7d\xaa\x5a\xa1\x0a\xdd\x46\x52\xe7\xdc\xb3\x8c\x0c\xa7\xca\x8b\x1c\x75\xb0\x98\x4d\xd8\xf4\x2f\x45\x46\x9b\x29\x45\x8f\xe6\x2a\xf4\x24\xaf\x39\x55\x4f\x60\x8e\x9c\xfe\x3f\xbe\xff\x49\x0b\xf5\x08\xd8\x63\x58\xbc\x83\x14\x14\xb6\xf0\xd7\x9b\xab\xdf\x88\xcc\x58\xf8\xd3\x58\x16\xef\x22\x6d\x50\x85\xec\xfa\xc3\x1f\x6c\x75\x12\xc9\xdf\x18\x8f\xb7\x2b\x8b\xbc\xd8\x3b\xe2\x84\x79\xd5\x77\xc7\xe7\x2a\x69\x92\xc6\x8a\xab\x42\xe2\x2b\xff\x71\xf0\x1e\x9d\xd1\xca\x61\x68\xf1\x6e\x35\x55\xba\x02\xf6\xb7\x6e\xa0\xd0\x6a\x41\x50\xf9\x33\x62\xd0\xd6\xc2\x39\xef\x12\x69\x18\xa3\x51\x25\x9c\xbf\x57\x4b\xa4\x17\x53\xcd\xdd\xc4\x11\xaf\xcb\xa1\x2a\xc2\xdf\x6f\xde\xbd\x8d\x1c\x59\xa1\x4a\xb1\xdd\x87\x73\x6e\xf3\x2e\x7e\x01\xf3\x0a\x5c\xcd\xf6\x9e\x74\x8d\x0b\x78\xdb\xb7\xad\xf0\xb9\x7a\x3e\x69\x59\xf7\x25\xbd\x3c\x22\x77\xcb\x51\x40\x77\x19\x24\xe3\xd7\x52\x16\x1c\x0e\xa8\x8a\xae\x0b\xfe\x1d\x00\x87\xa7\x21\x0a\x03\x0b\x00\x00")
+
+func assetsTemplatesUsersWidgetHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesUsersWidgetHtml,
+		"assets/templates/users/widget.html",
+	)
+}
+
+func assetsTemplatesUsersWidgetHtml() (*asset, error) {
+	bytes, err := assetsTemplatesUsersWidgetHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/users/widget.html", size: 2819, mode: os.FileMode(420), modTime: time.Unix(1792433799, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesWidgetShelterHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x4b\x8f\xdb\x36\x10\xbe\xfb\x57\x4c\xb4\x57\x4b\xb2\x17\xd8\x6c\x2a\xd3\x3a\x34\x6d\xda\x00\xed\xb6\x8d\x77\x0f\x3d\x15\x23\x71\x24\xb2\x4b\x91\x02\x39\x8e\xd7\x11\xf4\xdf\x0b\x3d\xfc\x68\xb2\x45\xa1\x03\x41\x72\xbe\xe1\xf7\x18\x89\x37\x3f\xfc\xf6\xfe\xf1\xcf\xdf\x7f\x04\xc5\x8d\xc9\x17\x62\x58\xc0\xa0\xad\xb7\x11\xd9\x28\x5f\x2c\x84\x22\x94\xf9\x02\x00\x40\x34\xc4\x08\xa5\x42\x1f\x88\xb7\xd1\xd3\xe3\x87\xf8\x5d\x74\x7d\x65\xb1\xa1\x6d\xf4\x59\xd3\xa1\x75\x9e\x23\x28\x9d\x65\xb2\xbc\x8d\x0e\x5a\xb2\xda\x4a\xfa\xac\x4b\x8a\xc7\xcd\x12\xb4\xd5\xac\xd1\xc4\xa1\x44\x43\xdb\x75\xb2\x3a\xb5\x62\xcd\x86\xf2\x8f\x4c\x4d\x80\xae\x4b\x76\x8a\x0c\x93\x4f\x1e\xb0\xa1\xbe\x07\x4b\x24\x83\x48\xa7\xa2\x09\x50\x60\x20\x60\xf4\xf5\xc0\xea\xaf\xc2\xa0\x7d\x3e\xf5\x0a\x7c\x3c\x95\x0d\x5f\xe1\xe4\x11\x3a\xa8\x9c\xe5\xb8\xc2\x46\x9b\x63\x06\x31\xb6\xad\xa1\x38\x1c\x03\x53\xb3\x84\xef\x8d\xb6\xcf\xbf\x62\xb9\x1b\xf7\x1f\x9c\xe5\x25\x44\x3b\xaa\x1d\xc1\xd3\xc7\x68\x09\x9f\x5c\xe1\xd8\x2d\x21\xa0\x0d\x71\x20\xaf\xab\x0d\x34\xe8\x6b\x6d\x33\x58\x6d\xa0\x45\x29\xb5\xad\x33\x58\x25\x77\x9e\x9a\x0d\x94\xce\x38\x9f\xc1\xcd\xed\xfa\xf6\xee\xf6\xbb\x0d\xf4\x67\x32\x6a\x7d\xa2\x12\xf4\x17\xca\x60\x9d\xdc\x4e\x90\x73\x3b\x58\x9d\xdb\x5c\x60\x7b\x03\x1d\x18\x1d\x38\x1e\xc5\x65\x60\x9d\xa5\xff\xe0\x70\x8d\x33\x1a\x3a\x90\x3a\xb4\x06\x8f\x19\x54\x86\x5e\x36\xf0\xf7\x3e\xb0\xae\x8e\xf1\x1c\x54\x06\xa1\xc5\x92\xe2\x82\xf8\x40\x64\x37\x80\x46\xd7\x36\xd6\x43\x14\x19\x94\x64\x99\xfc\x37\x12\x07\xd5\x85\xf3\x92\x7c\x5c\x38\x66\xd7\x64\xb0\x6e\x5f\x20\x38\xa3\x25\xdc\x48\xa2\x5b\x7a\x7b\xcd\x23\x91\xc4\xa8\x4d\x80\xee\xec\xcd\xdb\xf2\xfe\xee\x5e\x6e\xae\xcd\x58\x25\xef\xee\xbf\x56\x8e\x89\x22\xd3\x5e\xe1\xaa\xaa\xda\x40\x81\xe5\x73\xed\xdd\xde\xca\x0c\x6e\x56\xab\xfb\x62\x3c\x9c\x08\x79\x94\x7a\x1f\x86\x6e\xb3\xb5\x57\xdc\xc7\x93\xb3\xbf\x4c\x2f\x1c\x4b\x2a\x9d\x47\xd6\xce\x9e\x5c\x3d\x28\xcd\x14\x8f\xae\x0c\x47\x07\x8f\xed\x35\xa3\xca\x39\x26\x0f\xdd\xeb\xcc\xa7\x48\x62\x76\xed\x65\x1c\x26\xac\x48\xe7\xc1\x14\xe9\xf4\x7b\x2d\xc4\x30\x9a\xf3\xd0\xaa\xf5\xff\x4d\xbf\x5a\xcf\xa5\x7b\x73\x19\xee\xae\xf3\x68\x6b\x82\x64\x04\xf7\x17\x96\xc2\xe8\x4b\xd5\xf0\x89\xd0\xa2\xcd\xbb\x2e\xf9\x63\x8f\x96\x35\x1f\xfb\x7e\x78\xeb\x3d\x32\xd5\xce\x1f\xfb\x5e\x14\x3e\x1f\x8b\xa0\x34\x18\xc2\x36\x9a\x33\x8b\x06\xd0\x4f\x64\x25\xf9\xbe\x5f\xc2\x20\x78\x00\xee\xf4\x17\xea\x7b\x91\x0e\x88\x7c\x5e\xfe\xfd\x20\x9e\x1a\x0d\x09\x46\xa0\x3c\x55\xdb\xa8\xeb\x92\x9f\xc9\xb4\x4f\x9f\x7e\xe9\xfb\x08\x3c\x99\x6d\x64\x9d\x6b\xc9\x92\x8f\xf2\xe1\x06\x0e\x9a\x15\xb0\xd2\x41\xa4\x78\x91\x20\xd2\x6b\x41\x5d\x47\x26\xd0\x57\x72\x1f\x1c\x2b\x6d\x6b\xf0\xba\x56\x3c\x04\x97\xc0\xa3\x42\xfb\x0c\x47\xb7\x7f\xf3\x0d\xde\xca\x19\x2e\xd2\x93\xa1\x62\x8a\x36\x17\x78\x21\x7b\xca\xe2\x75\xc2\x3b\xa2\x57\x02\x73\x16\x1e\x48\xd7\xaa\x70\x7e\x14\x21\xd2\xb9\xf1\x42\xa4\x53\xe4\x0b\x91\x2a\x6e\x4c\xbe\xf8\x67\x00\xd2\x23\x3c\xde\x8c\x05\x00\x00")
+
+func assetsTemplatesWidgetShelterHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesWidgetShelterHtml,
+		"assets/templates/widget/shelter.html",
+	)
+}
+
+func assetsTemplatesWidgetShelterHtml() (*asset, error) {
+	bytes, err := assetsTemplatesWidgetShelterHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/widget/shelter.html", size: 1420, mode: os.FileMode(420), modTime: time.Unix(1792433799, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesWishlistsEditHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdd\x6f\xdb\xb6\x16\x7f\xd7\x5f\x71\x2e\x71\x51\xdb\xb8\x89\x94\xf4\xee\x29\x91\x34\x64\xf5\xd6\x79\xd8\xd2\xae\x6e\xd1\x0d\xc3\x1e\x68\xf1\xd8\xe2\x2a\x91\x0a\x49\xd9\x71\x05\xfd\xef\xc3\x91\xc5\x58\xfe\x48\x37\x60\x83\x0c\x83\x3a\x1f\xbf\xf3\x7d\xc4\xa6\x11\xb8\x94\x0a\x81\x95\x5c\xaa\xcb\x4c\x2b\x87\xca\xb1\xb6\x0d\xe2\xfc\x3a\x6d\x1a\xb9\x84\xf0\xa3\xb4\x79\x21\xad\x0b\x67\xd3\xb6\xfd\x50\x09\xee\x10\x3c\xad\x69\xb0\xb0\xd8\xb6\xf7\xb8\x19\xd2\x94\x68\xdb\x38\xca\xaf\xd3\x20\x5e\x98\x34\x88\xab\xf4\xb5\xd1\x75\x05\x2e\x47\x90\x0e\x4b\x0b\x5b\x5d\x83\x42\x14\xb0\xd4\x06\x38\x08\xad\xb8\x93\x5a\x81\x30\x72\x8d\x17\x24\xa8\xc0\xe6\xdc\x20\x1d\x61\xd3\x43\x8f\x2c\x54\x7c\x85\xb0\x91\x2e\x27\x08\x43\x8a\xda\x58\x70\x1a\x6c\xae\x37\x24\x5c\x02\x1d\xb2\x42\x5b\x24\x11\xe0\x06\xc3\x38\xaa\xd2\x20\x5e\x6a\x53\x82\x14\x09\xf3\x70\xdf\x69\x53\xb2\x34\x00\x00\x88\xa5\xaa\x6a\x07\x6e\x5b\x61\xc2\x72\x29\x04\x2a\x06\x8a\x97\x98\x30\x29\x18\xac\x79\x51\x63\xc2\x9a\xe6\x30\x1b\x5e\x59\xc8\x35\x64\x05\xb7\x36\x61\x64\xe4\x72\x45\xd1\xf6\x4c\xfa\xc5\x05\x5f\x60\x41\xb1\xee\x8d\xdf\xf3\x12\x59\x4a\xff\x71\xd4\xb1\x07\xe2\x43\x67\x1c\x3e\x3a\x76\x80\x4e\x55\x32\xba\xf0\xfe\xd1\xff\x59\x0f\x09\xbb\x6d\xd9\x41\xc8\x44\x63\x50\xf2\xc7\x02\xd5\xca\xe5\x09\xbb\xbe\xba\x62\x50\x15\x3c\xc3\x5c\x17\x02\x4d\xc2\x3e\x4a\xe5\xd0\xc0\x2b\xcd\x1d\x4c\xa9\x1c\x3e\xca\x48\xc8\xf5\x3f\x08\x78\x8a\x36\x33\xb2\xa2\x32\xb3\x74\xf0\x72\x1a\x3e\x85\xcc\x0d\xf2\x2f\x45\x2d\x06\x68\x07\x11\x0e\xcd\x80\xd1\x1b\x9b\xb0\xff\x1f\x44\xfc\xf2\xea\xea\x8a\xa5\xc3\x44\x0d\x54\xa8\x6f\xbd\xf9\x7f\x27\xec\xd7\x9a\x17\x53\xee\x90\xa5\x74\x02\x3a\x7e\xb9\xe0\x34\x60\x5f\x2c\xf8\xca\x23\x9e\x2b\xba\x37\x77\x5c\xf8\xbd\x1b\x27\x51\x2d\x25\x16\xc2\xa2\xfb\x8b\xd0\x70\x85\x4a\x78\x99\x4c\x17\x97\x9d\x5c\x17\x09\x4b\x67\x34\xd5\x71\xb4\x13\xda\x6b\x35\x8d\xe1\x6a\x85\x10\x76\xfc\xb6\xdd\xc3\x1d\xa7\x32\xcb\x31\xfb\x34\xb0\x77\x92\x96\x4e\x60\xa1\x1f\x8f\x52\x43\xd4\xcb\x2e\x7d\x3e\x3d\xb4\x60\x66\x07\x33\x3b\x9b\x1e\x67\x83\xdc\xf1\x8c\x6e\xcb\x49\x25\xf0\x11\xfe\x1b\xce\xb1\xc0\xcc\xa1\x20\x01\x0b\x9d\x00\x74\x96\x51\xf4\x7b\xed\xc8\xc5\x33\x15\x1f\x82\x9f\x71\xb6\xcf\x58\xd3\x84\xb4\x7f\xb8\x73\x28\x7e\xae\xb9\x72\xd2\x6d\xdb\x16\x9a\x26\x7c\xc5\x1d\xae\xb4\xa1\xb7\x31\xa5\x13\xcd\x0d\x91\x5f\x77\xc7\xb6\xbd\x00\x2b\x3f\x63\x47\x9a\xcb\xcf\xd8\xb6\x13\x78\xa1\x04\xb7\xf9\x2d\x34\x8d\x75\xdc\xd5\xf6\xce\xce\x9d\x91\x6a\x05\xe1\xbc\x7b\x6f\xdb\xd3\x96\xdb\x37\x00\x3d\x7e\x91\xef\xf9\x95\x77\x9d\xc6\xe1\xb2\xac\x1d\x0a\x96\xfe\xaa\x6b\xc8\xf9\x1a\xd5\xc8\x01\xb7\x9f\xfc\xfe\x56\x5b\xbf\xd6\xd1\x85\x10\x73\xc8\x0d\x2e\x13\x16\x75\xc4\x48\xe1\x86\xa5\xef\xf0\xa1\x46\xeb\x40\x2b\x8c\x23\x9e\xc2\x52\x1a\xeb\x76\x8b\xd9\x9b\xec\x13\xdc\xf7\xa7\xef\xca\xbe\x49\x17\xb5\x73\x5a\xf5\xbd\xb0\x7b\x79\x4a\xee\xc2\x29\x58\x38\x75\x59\x19\x59\x72\xb3\x65\xa0\x55\x56\xc8\xec\x53\xc2\x2c\x5f\xa3\x1f\x8d\xf1\x84\xa5\x73\xbe\xde\x7f\xbc\xe2\x68\x87\x93\x06\x71\x44\xf5\x49\x03\xef\x41\xb0\xff\x2e\xee\x36\xc3\xc1\x97\x71\x47\xea\x5d\xa1\xec\x44\x7f\xf0\x35\xdf\x51\xfb\x0e\x5e\x73\x03\x43\xdb\x90\xc0\xb2\x56\x19\x6d\x2b\x18\x4f\xa0\x79\x8a\x99\x04\xc9\xf6\xb7\x05\x96\xa8\x9c\x85\x04\x84\xce\x6a\x3a\x87\x2b\x74\x3d\xf9\x9b\xed\x4c\x8c\x47\xbe\xbf\xa8\x6d\x46\x93\x10\x7b\x95\xdb\x03\x30\x4a\xf9\x6c\x4a\x38\x77\xc6\xf0\x6d\x58\x19\xed\x34\xb9\x1a\x2e\x65\xe1\xd0\x84\x19\x2f\x8a\xf1\x93\x8d\x87\x1a\xcd\x76\xd7\xf4\xda\xdc\x15\xc5\x78\xd4\xcd\xd2\x6f\x07\xa3\xf4\xfb\x68\x72\x31\xf0\xdf\x4f\xe2\x30\x0e\x7a\x0c\xba\xda\x28\xf0\xec\xb0\x9f\x9b\xbd\x7f\xed\x24\x2c\x79\x35\xfe\xdb\x48\xf7\x75\xb9\x40\xf3\x24\x15\x76\xeb\x6e\x32\xc4\xbb\x0d\x9e\x5e\x28\x93\x52\x40\xe2\xb5\x86\x59\x0d\x29\x9c\x6e\xa6\xc7\x23\x29\x46\x93\x13\x24\x52\x36\xf8\x00\x09\x28\xdc\xc0\x2f\x3f\xfd\xf8\xbd\x73\x55\xdf\xb2\xe3\x81\x9c\xc1\x87\x50\x57\xa8\xc6\x52\x40\x0a\x57\xf0\x35\xb0\xb7\x1f\xde\x33\xb8\x01\xf6\xf6\xcd\xfc\x3d\xbb\x80\x3d\x23\xf2\xf5\xb2\x11\x83\xff\x11\xe3\xe6\x90\x78\x8c\xab\x0c\x72\xb1\xa5\x09\xc6\x2c\xef\xd6\xe6\x73\x5d\x43\x8f\x5c\xc2\x98\xdc\xe9\x94\x68\xcc\x11\x92\x24\x81\xaf\xe0\xc5\x8b\x0e\x8e\x70\x6a\xdb\xd1\x5e\x5e\x5d\x1f\x6b\xd3\xb3\x91\x4a\xe8\x4d\x58\xe8\x6c\x77\xf7\x4a\x4e\x7c\xfe\x61\xfe\xe6\x3e\xac\xb8\xb1\xd8\x9b\xb2\x95\x56\x16\x27\xe1\x6c\x7a\x1b\x1c\xa1\xf9\xa2\x2d\x79\x61\xf1\x90\xdb\x06\x67\xe4\x72\xae\x44\x81\x77\x76\xab\xb2\x77\x3d\x2e\x19\xb9\x38\x71\x42\x8a\x0b\x60\xb4\x78\x84\xa6\xb5\x43\xeb\x07\x2a\x34\xa5\xb4\x96\xbc\x76\x1a\xfa\x6c\xb9\x5c\xda\xa7\xab\xe2\x7f\x86\xe9\x6d\x07\x7d\x42\x81\x58\x54\x62\xdc\x05\x67\xbb\x3d\x29\x97\xdb\xf1\x61\x7e\xe8\x9e\x74\x03\xcf\xf5\x10\x1d\x7d\x17\x5d\x1c\xe8\x0d\xae\x12\xcf\xab\x0f\xee\x2e\xe7\x51\xfc\xc7\xfa\x79\x08\x7f\x07\x38\xaf\x4f\x32\xb3\xa9\xbd\xf1\xdb\x60\xcf\x6d\x27\x7d\x5a\xda\xdb\x20\x8e\x76\x6e\xa4\x41\xd3\xa0\x12\x6d\x1b\xfc\x39\x00\xd6\xff\x6e\x39\x0f\x0c\x00\x00")
+
+func assetsTemplatesWishlistsEditHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesWishlistsEditHtml,
+		"assets/templates/wishlists/edit.html",
+	)
+}
+
+func assetsTemplatesWishlistsEditHtml() (*asset, error) {
+	bytes, err := assetsTemplatesWishlistsEditHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/wishlists/edit.html", size: 3087, mode: os.FileMode(420), modTime: time.Unix(1792434193, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsTemplatesWishlistsWishlistHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x61\x8f\xdb\x36\x0f\xfe\x9e\x5f\xc1\x0a\x78\xfb\x26\xc0\xd9\x6e\x37\xb4\x1f\x56\x27\x43\xd1\xbb\xb5\x07\x74\x5b\x77\xb7\x61\xdb\x47\xd9\xa2\x63\xad\xb2\xe4\x93\xe8\xa4\xa9\xa1\xff\x3e\x48\x89\x13\xe5\x7a\x57\x6c\x83\x03\x44\xa6\x44\xf2\x21\xf9\x90\xf2\x38\x0a\x6c\xa4\x46\x60\x1d\x97\x3a\xab\x8d\x26\xd4\xc4\xbc\x9f\x95\x42\x6e\xa0\x56\xdc\xb9\x25\xab\xb9\x15\x6c\x35\x03\x00\xb8\x2f\xce\x5a\xe4\x02\x2d\x5b\xfd\x2e\x5d\xab\xa4\x23\x68\xac\xe9\xa0\xe4\xd0\x5a\x6c\x96\xac\x70\x2d\x2a\x42\xeb\x8a\x71\xcc\x6f\xf7\xeb\xfc\xfa\xd2\x7b\xb6\x4a\x04\x3f\xf1\x0e\xbd\x2f\x0b\xbe\x2a\x0b\x21\x37\x8f\xb8\xaa\x8c\xd8\x1d\x60\x84\x5f\xd9\xbe\x38\xdb\x26\x49\x0a\xa3\xd9\x09\xcb\xd1\x6e\xfb\x22\x55\x7b\x79\xa6\xe6\x86\x2a\x6a\x42\x57\x65\xdf\x00\xe1\x27\xca\xba\x81\x50\xb0\xd5\x5b\xc3\xd5\x77\x90\xda\x0b\x92\x4b\x4e\xe8\x3d\x3c\xed\xa4\x10\x86\x5e\x41\x12\xc6\x1b\x49\x3b\xef\x2f\x52\xd1\x2d\xc5\xe3\x65\xd1\xbe\x3c\x41\x18\xc7\xad\xa4\x16\x4e\x76\x2f\xd1\xd5\x56\xf6\x24\x8d\xf6\xbe\xec\xcf\xc3\xc2\x4f\x14\xa3\x0a\x81\xf4\xab\x71\x44\x2d\xbc\x4f\x6c\x59\xae\xd7\x08\x11\x9b\x4b\x36\x1e\x30\x03\x1d\x65\xdf\x86\x38\x9f\x47\x83\x1f\xac\x59\x5b\x74\xce\xfb\x71\x94\x0d\xe4\x1f\x14\x8a\x35\x0a\xef\x61\x3e\x8e\xc9\x9b\xd1\x40\x2d\xc2\x96\xef\x16\x07\xef\x01\xc8\xc9\x51\x52\xa7\xfe\x60\x32\x29\xd3\x63\x47\xb2\x8a\x5b\xa8\xd6\x99\x1b\xea\x3a\x68\x80\x35\x0a\x4f\xdb\x15\xb7\x0c\x1c\xed\x82\x6c\x2b\x05\xb5\xb1\x12\x1f\xd0\xd6\xa8\xe9\x06\x6b\x94\x9b\x00\xee\x7f\x0c\xb8\x95\x3c\xdb\x70\x35\xa0\x36\xdb\x25\x7b\xe8\x54\x7a\xa8\x93\x7a\xc9\x9e\x9d\x49\xf8\xa7\x25\x7b\xfe\xec\x19\x4b\xd9\xf7\x4f\xa0\x4b\xdd\x98\x7f\x85\xfb\x98\xd3\xaf\xc1\x3e\x1e\xfa\x8f\xa8\xef\xbd\x8e\x23\x2a\x87\x5f\x27\x06\x5b\x5d\x6d\xd0\xee\xa8\x95\x7a\xbd\x2f\xb7\x74\xb0\x3d\xb0\x13\x5a\xee\xa0\x42\xd4\x40\xfc\x23\x6a\xa8\xb9\x45\x30\x4d\x7e\x46\x82\x94\x96\x09\x80\x72\x50\x93\xb3\xd0\x90\xd9\xda\x9a\xa1\x87\xd3\x32\x6b\xd4\xe0\x5a\xb6\xfa\x92\xce\xd7\x84\xdd\x19\x9d\x95\xfc\xd2\x52\x26\x09\xbb\x7b\x5c\x1b\xc7\xfc\x07\x63\x3b\x4e\x84\xe2\x97\x81\x6b\x8a\x4d\x19\x8a\xf0\x86\x13\xae\x8d\x0d\x6f\xf3\x35\x6a\x81\x36\x72\xea\x6d\x5c\x86\xbe\x75\xf2\x33\x46\xd1\xad\xfc\x8c\xde\x2f\xe0\xa9\x16\xdc\xb5\xa1\xc7\x1d\x71\x1a\xdc\x6b\x77\x4b\x36\xe4\x28\xf6\xf5\x90\xe2\x0b\x4f\x6c\x23\xbc\x9b\x76\xe1\xb9\xf7\xa7\x41\x18\xa0\xc6\x29\x18\xa7\xdf\x14\x4b\xec\x4d\x25\xf5\x47\xb6\x7a\x87\xaa\x87\x38\x18\xa8\x95\x2e\x0c\xc3\x34\xa9\xe1\x29\x0b\x25\x1f\x4b\xf9\xa0\xbe\x32\x35\xa1\x32\x56\xa0\xcd\xc8\xf4\x49\xb6\x4a\xc5\x2b\x54\xd0\x18\x1b\xa8\xba\x2f\xf6\x6d\xcb\x2d\xfe\x76\xf3\x9e\xad\xe2\xea\x9c\x09\x65\x11\x35\x12\x0b\x52\xf7\x03\x01\xed\x7a\x5c\xb2\x48\xa4\xc9\x75\x63\x6c\x17\xaf\x13\x6b\x14\x03\x29\x1e\xf0\x00\x91\xfc\x91\xf9\x93\x2c\x24\xc6\x22\x17\x46\xab\x1d\x18\x5d\x2b\x59\x7f\x5c\xb2\x80\x21\x77\xa8\xb0\xa6\xf9\x22\xc1\x1f\xd3\x9d\x5f\xbb\x9f\xb7\x1a\x6d\x9a\xa6\xca\x26\x10\xa7\x02\xe4\x45\x3a\xc9\x43\x11\x0a\x14\x92\xa6\xfe\xad\x06\x22\xa3\x8f\xf8\x2b\xd2\x50\x91\xce\x7a\x2b\x3b\x6e\x77\x90\xd4\xe9\x4a\x48\x0a\xd5\x49\xfc\x45\xdd\x13\x60\x81\x0a\x09\x27\x5f\xf3\xc5\x17\x56\x45\xe0\xb8\x4d\x8d\x5e\x46\x95\xb2\xd8\xc3\x78\xac\xc8\xb1\xaf\x0e\x7f\xd3\xce\xec\x74\x87\xef\x2f\x91\xf4\x16\xbf\x9f\xa2\x72\x7f\x24\xa9\x58\xf1\x17\xdf\xf0\xbd\xf4\x90\xda\x0d\xb7\x70\x1e\x01\x2c\xa1\x19\x74\x1d\xae\x27\x98\x2f\x60\x3c\xa2\x93\x0d\xcc\x9f\xd4\x46\x37\xd2\x76\x73\xb6\x8f\xe1\x9c\x32\xdf\xc3\x35\x39\x88\xdc\x87\xad\x54\x0a\x1c\xf1\x1d\x98\x1e\x75\xce\x16\xa9\xa9\xf0\x58\xa4\xc1\xea\x57\x47\x99\x9f\x1d\x97\x01\x94\xc5\x3b\x58\x82\xc6\x2d\xfc\xf1\xe3\xfb\x77\x44\xfd\x0d\xde\x0d\x18\x12\x7c\x52\xb1\x78\x97\x07\xeb\x73\x76\x79\xf5\xfe\xea\xd7\x2b\x76\x01\x5b\xa9\x85\xd9\xe6\xca\xd4\x3c\x44\x90\x07\x3a\xdc\xd7\xd0\x16\xb9\xd8\x85\x26\xc7\xba\x0d\xc5\x79\x34\xe4\x13\x4e\x68\xb9\x16\x0a\x5f\xbb\x9d\xae\x6f\xd0\xf5\x46\x3b\x9c\x5b\xbc\xbb\x80\xf3\xaf\x9e\x29\x8b\xd3\x37\x41\xa0\x1e\xbb\x00\xf6\xa7\x19\x40\x18\xfd\x7f\x82\x96\x6f\x10\x7a\xb4\x9d\x74\x2e\x38\x24\x03\xe2\x81\x5c\x3e\x61\x09\x6c\x7f\x1e\x81\x43\x2d\xa6\x3c\xf8\x57\xb3\xb2\xd8\x97\xf4\x44\x93\x71\x44\x2d\xbc\x9f\xfd\x3d\x00\xe7\x00\x94\x05\xf2\x09\x00\x00")
+
+func assetsTemplatesWishlistsWishlistHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsTemplatesWishlistsWishlistHtml,
+		"assets/templates/wishlists/wishlist.html",
+	)
+}
+
+func assetsTemplatesWishlistsWishlistHtml() (*asset, error) {
+	bytes, err := assetsTemplatesWishlistsWishlistHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/templates/wishlists/wishlist.html", size: 2546, mode: os.FileMode(420), modTime: time.Unix(1792434193, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _assetsWidgetWidgetJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x54\x6d\x8b\xdb\x46\x10\xfe\xee\x5f\x31\x81\xc2\x4a\x3d\x75\x1d\xda\x7e\x3b\x44\xa0\xc7\x91\xb4\xa4\x2e\x3d\xa7\xd0\x0f\x81\xb2\x91\x46\xd2\xe6\x74\xbb\xba\xd9\xd1\x39\x6e\xe3\xff\x5e\x66\xf5\x62\x39\xc2\x58\x60\x69\x5e\x9e\x79\x9e\xd9\x9d\xd9\x6e\xe1\x01\x5d\x89\x14\xc0\x40\x68\xb0\x65\x24\x15\xc0\x77\xe8\xc0\x32\x3e\x05\x38\x34\x48\x08\xdc\xd8\x00\xa1\x20\xdb\x31\xd8\x00\x5d\x6b\x0a\x2c\x35\x7c\x68\x70\x0c\x33\x84\x40\x68\x4a\xa8\xc8\x3f\x01\x37\x08\xbf\xed\xff\xd8\x6d\xb6\x5b\x08\x48\x2f\x58\x82\xc3\x2f\x0c\xec\xa3\x6b\x00\xca\x20\x78\xf0\x0e\xa1\xf0\xdd\x11\x7c\x05\x96\xe1\xe0\xe9\x31\x40\xe5\x09\xf0\x05\xe9\x38\x51\xd2\x9b\xa4\xea\x5d\xc1\xd6\x3b\x48\x52\xf8\x6f\x03\x00\xf0\x62\x68\xa2\x94\x43\xe9\x8b\xfe\x09\x1d\xeb\xa2\x27\x42\xc7\xfb\xe8\xb8\x9d\x03\x0b\xef\xd8\x58\x87\x74\x11\x4b\x68\x18\xef\x5b\x94\xcc\x44\x95\xf6\x45\xa5\x43\xca\x1c\xae\x8b\xd6\x84\xb0\x33\x4f\x08\x39\x28\x87\xb6\x6e\x3e\x79\x0a\x3f\x1c\x6c\x59\x23\xab\x21\x7a\x60\xa1\x3b\x23\x95\x77\xbe\x44\x6d\x5d\x40\xe2\x5f\xb0\xf2\x84\xc9\x0c\x96\x8d\x91\xe9\xed\x66\x26\x86\x43\x75\xc8\xe1\xac\x90\x4d\x2d\x15\x33\x60\xfc\xc2\x19\x0c\xb8\x93\xec\x59\x51\x63\xdb\xf2\xba\x9a\x11\x63\xd4\x23\x8f\xad\x20\x11\xc0\x25\x90\xfc\x22\x90\x16\xcf\x9d\x77\x3c\x70\x91\xaf\x73\xe6\x69\x7e\x1b\xa8\x68\xd3\x75\xe8\xca\x3b\x49\x4c\x62\xfa\xa2\x0c\x21\xf7\xe4\x20\x9a\x07\xeb\x69\x21\xb7\xb5\xee\xf1\x52\x6b\x94\xd8\x10\x56\xd7\x84\x1a\x57\x34\x5e\xce\x6d\x6c\x55\xa2\x8c\xfa\xa6\x35\xe7\xea\x43\xb0\x16\x3c\xc8\x41\xfe\x56\x3e\x36\x54\xa3\x88\x54\xff\x7c\x6a\x8d\x7b\x54\xab\x08\xc2\x56\xdc\xce\xcb\x1c\x20\xa9\x95\xb8\x01\x69\xad\x8e\xf0\x19\x72\x70\x78\x80\xbf\x7f\x7f\xff\x8e\xb9\x7b\xc0\xe7\x1e\x03\x27\x63\x7f\x08\x9f\xb5\x60\x26\xea\xed\xfd\x07\x35\x5d\x07\x1d\xa8\xd0\x84\x71\xa8\x92\xed\x47\xfd\x39\x24\x1f\xdf\xe8\xef\xd3\x37\xdf\x6d\x33\x50\xfa\x73\xf0\x4e\xa5\x4b\x04\x47\x68\xca\x63\x60\xc3\x58\x34\xc6\xd5\x78\xd1\xd1\x65\xff\xe4\xcc\xa5\x68\x4c\xd8\x4b\x02\xbc\xca\x73\xf8\x19\xbe\x7e\x8d\x50\x82\xd1\x87\x68\xfb\xf1\xf5\xeb\x65\xe6\x42\x6d\x65\xda\x80\xe7\x1e\x9c\x36\xf3\xab\x68\xee\xcc\xb1\xf5\x46\x6e\xa2\x4c\xbc\x0c\x41\xc0\xb1\x66\xe8\xbc\x0b\x38\x32\x97\x67\x3e\xc1\xe6\x27\x95\x81\xfa\x35\xae\x0e\x05\x37\x13\x88\xde\x8f\xf3\x2e\xd7\x1f\x6e\x40\x81\x43\x2c\x83\xca\xce\x03\xb9\x40\x93\xea\xad\x0d\xbc\xbc\x1a\x7d\x2b\xc0\x57\x12\xa6\x2a\xb1\xae\xae\x3c\xdd\x9b\xa2\x59\x6c\x16\x59\x65\xdf\xf6\x40\x8a\x90\x3f\x2c\x6b\xb4\x56\x65\x71\xed\xe9\x3f\x7b\xe3\xd8\xf2\x31\x52\x15\x1d\xd1\x7a\x67\x18\x6b\x4f\x83\x35\x99\xcd\x6f\xe3\xa2\x15\x63\x06\xc1\xfe\x8b\x30\x7b\xf6\xf2\x75\x03\x2a\x15\x97\x28\x5a\x70\x96\x47\x86\x26\x51\xef\xb0\xed\xe0\x60\xb9\x89\xdb\x78\xa2\x20\xd6\xbf\x1e\xde\x67\x40\xfe\xb0\x48\x3b\x2d\xde\xe5\x12\x5c\x4a\x6f\xd1\xd5\xdc\x40\x9e\xe7\xb0\x3a\xf4\x4b\x99\x6a\xe7\xb9\xb1\xae\x06\xb2\x75\xc3\xe0\xfc\x41\xb6\xbe\x71\x8f\x70\xf4\xfd\xab\x35\xdd\xf3\xaa\x18\x48\xef\x71\xd0\x79\xf5\x80\xbd\x83\xdd\xb4\x55\x55\xb6\x8a\x8b\xd2\x66\x4a\xdd\xea\x70\xd3\xd5\x6c\x2e\x6e\xeb\xe9\x3c\x33\x01\x5d\x99\xa4\xb7\x9b\x53\x9a\xa4\xb7\x9b\xff\x07\x00\x5b\x3e\xea\xcb\xf7\x06\x00\x00")
+
+func assetsWidgetWidgetJsBytes() ([]byte, error) {
+	return bindataRead(
+		_assetsWidgetWidgetJs,
+		"assets/widget/widget.js",
+	)
+}
+
+func assetsWidgetWidgetJs() (*asset, error) {
+	bytes, err := assetsWidgetWidgetJsBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "assets/widget/widget.js", size: 1783, mode: os.FileMode(420), modTime: time.Unix(1792433799, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -485,23 +737,35 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"assets/scripts/neighbors_db.sql":              assetsScriptsNeighbors_dbSql,
+	"assets/templates/admin/audit.html":            assetsTemplatesAdminAuditHtml,
+	"assets/templates/admin/deletedUsers.html":     assetsTemplatesAdminDeletedusersHtml,
+	"assets/templates/admin/settings.html":         assetsTemplatesAdminSettingsHtml,
 	"assets/templates/home/error.html":             assetsTemplatesHomeErrorHtml,
 	"assets/templates/home/index.html":             assetsTemplatesHomeIndexHtml,
 	"assets/templates/home/layout.html":            assetsTemplatesHomeLayoutHtml,
 	"assets/templates/home/unauthorized.html":      assetsTemplatesHomeUnauthorizedHtml,
 	"assets/templates/items/edit.html":             assetsTemplatesItemsEditHtml,
+	"assets/templates/items/import.html":           assetsTemplatesItemsImportHtml,
 	"assets/templates/items/item.html":             assetsTemplatesItemsItemHtml,
 	"assets/templates/items/items.html":            assetsTemplatesItemsItemsHtml,
 	"assets/templates/items/new.html":              assetsTemplatesItemsNewHtml,
+	"assets/templates/items/receive.html":          assetsTemplatesItemsReceiveHtml,
 	"assets/templates/login/login.html":            assetsTemplatesLoginLoginHtml,
 	"assets/templates/login/reset.html":            assetsTemplatesLoginResetHtml,
+	"assets/templates/login/twoFactor.html":        assetsTemplatesLoginTwofactorHtml,
+	"assets/templates/users/apiTokens.html":        assetsTemplatesUsersApitokensHtml,
 	"assets/templates/users/edit.html":             assetsTemplatesUsersEditHtml,
+	"assets/templates/users/intake.html":           assetsTemplatesUsersIntakeHtml,
 	"assets/templates/users/new.html":              assetsTemplatesUsersNewHtml,
 	"assets/templates/users/samaritanSummary.html": assetsTemplatesUsersSamaritansummaryHtml,
 	"assets/templates/users/shelterSummary.html":   assetsTemplatesUsersSheltersummaryHtml,
 	"assets/templates/users/user.html":             assetsTemplatesUsersUserHtml,
 	"assets/templates/users/users.html":            assetsTemplatesUsersUsersHtml,
+	"assets/templates/users/widget.html":           assetsTemplatesUsersWidgetHtml,
+	"assets/templates/widget/shelter.html":         assetsTemplatesWidgetShelterHtml,
+	"assets/templates/wishlists/edit.html":         assetsTemplatesWishlistsEditHtml,
+	"assets/templates/wishlists/wishlist.html":     assetsTemplatesWishlistsWishlistHtml,
+	"assets/widget/widget.js":                      assetsWidgetWidgetJs,
 }
 
 // AssetDir returns the file names below a certain
@@ -546,10 +810,12 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"assets": &bintree{nil, map[string]*bintree{
-		"scripts": &bintree{nil, map[string]*bintree{
-			"neighbors_db.sql": &bintree{assetsScriptsNeighbors_dbSql, map[string]*bintree{}},
-		}},
 		"templates": &bintree{nil, map[string]*bintree{
+			"admin": &bintree{nil, map[string]*bintree{
+				"audit.html":        &bintree{assetsTemplatesAdminAuditHtml, map[string]*bintree{}},
+				"deletedUsers.html": &bintree{assetsTemplatesAdminDeletedusersHtml, map[string]*bintree{}},
+				"settings.html":     &bintree{assetsTemplatesAdminSettingsHtml, map[string]*bintree{}},
+			}},
 			"home": &bintree{nil, map[string]*bintree{
 				"error.html":        &bintree{assetsTemplatesHomeErrorHtml, map[string]*bintree{}},
 				"index.html":        &bintree{assetsTemplatesHomeIndexHtml, map[string]*bintree{}},
@@ -557,23 +823,39 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"unauthorized.html": &bintree{assetsTemplatesHomeUnauthorizedHtml, map[string]*bintree{}},
 			}},
 			"items": &bintree{nil, map[string]*bintree{
-				"edit.html":  &bintree{assetsTemplatesItemsEditHtml, map[string]*bintree{}},
-				"item.html":  &bintree{assetsTemplatesItemsItemHtml, map[string]*bintree{}},
-				"items.html": &bintree{assetsTemplatesItemsItemsHtml, map[string]*bintree{}},
-				"new.html":   &bintree{assetsTemplatesItemsNewHtml, map[string]*bintree{}},
+				"edit.html":    &bintree{assetsTemplatesItemsEditHtml, map[string]*bintree{}},
+				"import.html":  &bintree{assetsTemplatesItemsImportHtml, map[string]*bintree{}},
+				"item.html":    &bintree{assetsTemplatesItemsItemHtml, map[string]*bintree{}},
+				"items.html":   &bintree{assetsTemplatesItemsItemsHtml, map[string]*bintree{}},
+				"new.html":     &bintree{assetsTemplatesItemsNewHtml, map[string]*bintree{}},
+				"receive.html": &bintree{assetsTemplatesItemsReceiveHtml, map[string]*bintree{}},
 			}},
 			"login": &bintree{nil, map[string]*bintree{
-				"login.html": &bintree{assetsTemplatesLoginLoginHtml, map[string]*bintree{}},
-				"reset.html": &bintree{assetsTemplatesLoginResetHtml, map[string]*bintree{}},
+				"login.html":     &bintree{assetsTemplatesLoginLoginHtml, map[string]*bintree{}},
+				"reset.html":     &bintree{assetsTemplatesLoginResetHtml, map[string]*bintree{}},
+				"twoFactor.html": &bintree{assetsTemplatesLoginTwofactorHtml, map[string]*bintree{}},
 			}},
 			"users": &bintree{nil, map[string]*bintree{
+				"apiTokens.html":        &bintree{assetsTemplatesUsersApitokensHtml, map[string]*bintree{}},
 				"edit.html":             &bintree{assetsTemplatesUsersEditHtml, map[string]*bintree{}},
+				"intake.html":           &bintree{assetsTemplatesUsersIntakeHtml, map[string]*bintree{}},
 				"new.html":              &bintree{assetsTemplatesUsersNewHtml, map[string]*bintree{}},
 				"samaritanSummary.html": &bintree{assetsTemplatesUsersSamaritansummaryHtml, map[string]*bintree{}},
 				"shelterSummary.html":   &bintree{assetsTemplatesUsersSheltersummaryHtml, map[string]*bintree{}},
 				"user.html":             &bintree{assetsTemplatesUsersUserHtml, map[string]*bintree{}},
 				"users.html":            &bintree{assetsTemplatesUsersUsersHtml, map[string]*bintree{}},
+				"widget.html":           &bintree{assetsTemplatesUsersWidgetHtml, map[string]*bintree{}},
+			}},
+			"widget": &bintree{nil, map[string]*bintree{
+				"shelter.html": &bintree{assetsTemplatesWidgetShelterHtml, map[string]*bintree{}},
 			}},
+			"wishlists": &bintree{nil, map[string]*bintree{
+				"edit.html":     &bintree{assetsTemplatesWishlistsEditHtml, map[string]*bintree{}},
+				"wishlist.html": &bintree{assetsTemplatesWishlistsWishlistHtml, map[string]*bintree{}},
+			}},
+		}},
+		"widget": &bintree{nil, map[string]*bintree{
+			"widget.js": &bintree{assetsWidgetWidgetJs, map[string]*bintree{}},
 		}},
 	}},
 }}
@@ -0,0 +1,545 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/storage"
+	"gopkg.in/yaml.v2"
+)
+
+const DEFAULT_SENDER_ADDRESS = "neighbors@massally.org"
+const DEFAULT_SENDER_NAME = "Neighbors"
+
+// CONFIG_FILE_ENV names the environment variable that points at a config file when -config isn't given.
+const CONFIG_FILE_ENV = "NEIGHBORS_CONFIG"
+
+const REDACTED = "********"
+
+const (
+	SMTP_PROVIDER     = "smtp"
+	SENDGRID_PROVIDER = "sendgrid"
+)
+
+const (
+	LOCAL_STORAGE = "local"
+	S3_STORAGE    = "s3"
+)
+
+// Config is every setting the server reads at startup. Settings are layered: the defaults, then
+// the config file, then environment variables, then command line flags, each overriding the last.
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Email     EmailConfig     `yaml:"email"`
+	Security  SecurityConfig  `yaml:"security"`
+	Retention RetentionConfig `yaml:"retention"`
+	Logging   LoggingConfig   `yaml:"logging"`
+	Storage   StorageConfig   `yaml:"storage"`
+}
+
+type ServerConfig struct {
+	Port            int  `yaml:"port"`
+	DevelopmentMode bool `yaml:"developmentMode"`
+	// ReadTimeout and WriteTimeout bound how long a client can take to send a request and to
+	// receive the response. IdleTimeout closes keep-alive connections that go unused.
+	ReadTimeout  Duration `yaml:"readTimeout"`
+	WriteTimeout Duration `yaml:"writeTimeout"`
+	IdleTimeout  Duration `yaml:"idleTimeout"`
+	// ShutdownTimeout is how long in-flight requests and background jobs get to finish after a
+	// SIGTERM. It should be shorter than the time the platform waits before killing the process.
+	ShutdownTimeout Duration `yaml:"shutdownTimeout"`
+	// PublicURL is where users reach the site, such as https://neighbors.example.org. Links in
+	// emails, feeds and widgets are built from it rather than from the request's Host header,
+	// which clients choose. It can only be left out in development mode.
+	PublicURL string `yaml:"publicURL"`
+}
+
+type DatabaseConfig struct {
+	Driver string `yaml:"driver"`
+	URL    string `yaml:"url"`
+	// SQLiteFile keeps SQLite data in a file instead of in memory. It's ignored when URL is set.
+	SQLiteFile string `yaml:"sqliteFile"`
+}
+
+type EmailConfig struct {
+	// Provider is smtp or sendgrid. When it's empty, development mode sends through SMTP and
+	// everything else through SendGrid.
+	Provider       string `yaml:"provider"`
+	SenderAddress  string `yaml:"senderAddress"`
+	SenderName     string `yaml:"senderName"`
+	SMTPHost       string `yaml:"smtpHost"`
+	SMTPPort       int    `yaml:"smtpPort"`
+	SendGridAPIKey string `yaml:"sendgridApiKey"`
+}
+
+type SecurityConfig struct {
+	// RateLimitStore is where login and widget rate limits are tracked: memory or database.
+	RateLimitStore string `yaml:"rateLimitStore"`
+	// TrustedProxies lists the addresses or CIDR ranges of the proxies in front of the server,
+	// separated by commas. Clients' addresses are only read from X-Forwarded-For on requests
+	// from these proxies.
+	TrustedProxies string `yaml:"trustedProxies"`
+}
+
+type RetentionConfig struct {
+	// DeletedRecords is how long deleted items and accounts can be restored before they're purged.
+	DeletedRecords Duration `yaml:"deletedRecords"`
+}
+
+type LoggingConfig struct {
+	// Level is the least severe level logged: debug, info, warn or error.
+	Level string `yaml:"level"`
+	// Format is text or json. When it's empty, development mode logs text and everything else JSON.
+	Format string `yaml:"format"`
+}
+
+type StorageConfig struct {
+	// Backend is where attachments are kept: local or s3.
+	Backend string `yaml:"backend"`
+	// Directory holds attachments for the local backend.
+	Directory         string `yaml:"directory"`
+	S3Endpoint        string `yaml:"s3Endpoint"`
+	S3Bucket          string `yaml:"s3Bucket"`
+	S3Region          string `yaml:"s3Region"`
+	S3AccessKeyID     string `yaml:"s3AccessKeyId"`
+	S3SecretAccessKey string `yaml:"s3SecretAccessKey"`
+}
+
+// Duration reads and writes durations as strings such as "720h", rather than as nanoseconds.
+type Duration time.Duration
+
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return time.Duration(d).String(), nil
+}
+
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value string
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// setting ties a config field to the flag and environment variable that can override it.
+type setting struct {
+	flag  string
+	env   string
+	usage string
+	field func(config *Config) interface{}
+}
+
+var settings = []*setting{
+	{"port", "PORT", "port to listen on", func(c *Config) interface{} { return &c.Server.Port }},
+	{"developmentMode", "NEIGHBORS_DEVELOPMENT_MODE", "run app in development mode", func(c *Config) interface{} { return &c.Server.DevelopmentMode }},
+	{"readTimeout", "NEIGHBORS_READ_TIMEOUT", "longest time to read a request", func(c *Config) interface{} { return &c.Server.ReadTimeout }},
+	{"writeTimeout", "NEIGHBORS_WRITE_TIMEOUT", "longest time to write a response", func(c *Config) interface{} { return &c.Server.WriteTimeout }},
+	{"idleTimeout", "NEIGHBORS_IDLE_TIMEOUT", "how long idle keep-alive connections are kept open", func(c *Config) interface{} { return &c.Server.IdleTimeout }},
+	{"shutdownTimeout", "NEIGHBORS_SHUTDOWN_TIMEOUT", "how long in-flight requests get to finish when shutting down", func(c *Config) interface{} { return &c.Server.ShutdownTimeout }},
+	{"publicURL", "NEIGHBORS_PUBLIC_URL", "URL users reach the site at, for links in emails, feeds and widgets", func(c *Config) interface{} { return &c.Server.PublicURL }},
+	{"dbDriver", "NEIGHBORS_DB_DRIVER", "Name of database driver to use", func(c *Config) interface{} { return &c.Database.Driver }},
+	{"databaseURL", "DATABASE_URL", "database connection string", func(c *Config) interface{} { return &c.Database.URL }},
+	{"sqliteFile", "NEIGHBORS_SQLITE_FILE", "keep data in this SQLite file instead of in memory, when no database URL is set", func(c *Config) interface{} { return &c.Database.SQLiteFile }},
+	{"emailProvider", "NEIGHBORS_EMAIL_PROVIDER", "how email is sent: smtp or sendgrid", func(c *Config) interface{} { return &c.Email.Provider }},
+	{"senderAddress", "NEIGHBORS_SENDER_ADDRESS", "address email is sent from", func(c *Config) interface{} { return &c.Email.SenderAddress }},
+	{"senderName", "NEIGHBORS_SENDER_NAME", "name email is sent from", func(c *Config) interface{} { return &c.Email.SenderName }},
+	{"smtpHost", "NEIGHBORS_SMTP_HOST", "SMTP server to send email through", func(c *Config) interface{} { return &c.Email.SMTPHost }},
+	{"smtpPort", "NEIGHBORS_SMTP_PORT", "port of the SMTP server", func(c *Config) interface{} { return &c.Email.SMTPPort }},
+	{"sendgridApiKey", "SENDGRID_API_KEY", "SendGrid API key", func(c *Config) interface{} { return &c.Email.SendGridAPIKey }},
+	{"rateLimitStore", "NEIGHBORS_RATE_LIMIT_STORE", "where login and widget rate limits are tracked: memory or database", func(c *Config) interface{} { return &c.Security.RateLimitStore }},
+	{"trustedProxies", "NEIGHBORS_TRUSTED_PROXIES", "comma-separated addresses or CIDR ranges of proxies whose X-Forwarded-For is trusted", func(c *Config) interface{} { return &c.Security.TrustedProxies }},
+	{"deletedRetention", "NEIGHBORS_DELETED_RETENTION", "how long deleted items and accounts can be restored before they're purged", func(c *Config) interface{} { return &c.Retention.DeletedRecords }},
+	{"logLevel", "NEIGHBORS_LOG_LEVEL", "least severe level logged: debug, info, warn or error", func(c *Config) interface{} { return &c.Logging.Level }},
+	{"logFormat", "NEIGHBORS_LOG_FORMAT", "how logs are written: text or json", func(c *Config) interface{} { return &c.Logging.Format }},
+	{"storageBackend", "NEIGHBORS_STORAGE_BACKEND", "where attachments are kept: local or s3", func(c *Config) interface{} { return &c.Storage.Backend }},
+	{"storageDirectory", "NEIGHBORS_STORAGE_DIRECTORY", "directory attachments are kept in by the local backend", func(c *Config) interface{} { return &c.Storage.Directory }},
+	{"s3Endpoint", "NEIGHBORS_S3_ENDPOINT", "URL of the S3-compatible service attachments are kept in", func(c *Config) interface{} { return &c.Storage.S3Endpoint }},
+	{"s3Bucket", "NEIGHBORS_S3_BUCKET", "bucket attachments are kept in", func(c *Config) interface{} { return &c.Storage.S3Bucket }},
+	{"s3Region", "NEIGHBORS_S3_REGION", "region of the S3 bucket", func(c *Config) interface{} { return &c.Storage.S3Region }},
+	{"s3AccessKeyId", "NEIGHBORS_S3_ACCESS_KEY_ID", "access key ID for the S3 bucket", func(c *Config) interface{} { return &c.Storage.S3AccessKeyID }},
+	{"s3SecretAccessKey", "NEIGHBORS_S3_SECRET_ACCESS_KEY", "secret access key for the S3 bucket", func(c *Config) interface{} { return &c.Storage.S3SecretAccessKey }},
+}
+
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Port:            8080,
+			ReadTimeout:     Duration(15 * time.Second),
+			WriteTimeout:    Duration(30 * time.Second),
+			IdleTimeout:     Duration(2 * time.Minute),
+			ShutdownTimeout: Duration(25 * time.Second),
+		},
+		Database: DatabaseConfig{Driver: "sqlite3"},
+		Email: EmailConfig{
+			SenderAddress: DEFAULT_SENDER_ADDRESS,
+			SenderName:    DEFAULT_SENDER_NAME,
+			SMTPHost:      "localhost",
+			SMTPPort:      25,
+		},
+		Security:  SecurityConfig{RateLimitStore: "memory"},
+		Retention: RetentionConfig{DeletedRecords: Duration(managers.DELETED_RECORD_RETENTION_PERIOD)},
+		Logging:   LoggingConfig{Level: "info"},
+		Storage:   StorageConfig{Backend: LOCAL_STORAGE, Directory: "uploads", S3Region: "us-east-1"},
+	}
+}
+
+// Load builds the config from the command line arguments and the environment, and validates it.
+// The config file is named by the -config flag, or else by the NEIGHBORS_CONFIG variable.
+func Load(name string, args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	return LoadFlags(flag.NewFlagSet(name, flag.ContinueOnError), args, lookupEnv)
+}
+
+// LoadFlags is Load for commands with flags of their own, which should be defined on flags before
+// calling it. Arguments left after the flags are available from flags.Args().
+func LoadFlags(flags *flag.FlagSet, args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	configFile := flags.String("config", "", "YAML file to read settings from")
+	flagValues := Default()
+	for _, s := range settings {
+		flags.Var(&settingValue{s.field(flagValues)}, s.flag, s.usage)
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *configFile == "" {
+		*configFile, _ = lookupEnv(CONFIG_FILE_ENV)
+	}
+
+	config := Default()
+	if *configFile != "" {
+		if err := config.LoadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := config.ApplyEnvironment(lookupEnv); err != nil {
+		return nil, err
+	}
+
+	flags.Visit(func(f *flag.Flag) {
+		for _, s := range settings {
+			if s.flag == f.Name {
+				(&settingValue{s.field(config)}).Set(f.Value.String())
+			}
+		}
+	})
+	return config, config.Validate()
+}
+
+func (config *Config) LoadFile(path string) error {
+	extension := strings.ToLower(filepath.Ext(path))
+	if extension != ".yaml" && extension != ".yml" {
+		return fmt.Errorf("Config file %s must be YAML", path)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return yaml.UnmarshalStrict(contents, config)
+}
+
+func (config *Config) ApplyEnvironment(lookupEnv func(string) (string, bool)) error {
+	for _, s := range settings {
+		value, found := lookupEnv(s.env)
+		if !found {
+			continue
+		}
+
+		if err := (&settingValue{s.field(config)}).Set(value); err != nil {
+			return fmt.Errorf("Invalid %s: %v", s.env, err)
+		}
+	}
+	return nil
+}
+
+func (config *Config) Validate() error {
+	problems := make([]string, 0)
+	if config.Server.Port < 1 || config.Server.Port > 65535 {
+		problems = append(problems, "server.port must be between 1 and 65535")
+	}
+
+	timeouts := []Duration{config.Server.ReadTimeout, config.Server.WriteTimeout, config.Server.IdleTimeout, config.Server.ShutdownTimeout}
+	for i, name := range []string{"readTimeout", "writeTimeout", "idleTimeout", "shutdownTimeout"} {
+		if timeouts[i] <= 0 {
+			problems = append(problems, "server."+name+" must be positive")
+		}
+	}
+
+	if config.Server.PublicURL == "" && !config.Server.DevelopmentMode {
+		problems = append(problems, "server.publicURL is required outside development mode")
+	} else if config.Server.PublicURL != "" && !isSiteURL(config.Server.PublicURL) {
+		problems = append(problems, "server.publicURL must be an http or https URL without a path")
+	}
+
+	if config.Database.Driver != "sqlite3" && config.Database.Driver != "postgres" {
+		problems = append(problems, "database.driver must be sqlite3 or postgres")
+	}
+
+	if config.Database.Driver == "postgres" && config.Database.URL == "" {
+		problems = append(problems, "database.url is required for postgres")
+	}
+
+	if config.Email.Provider != "" && config.Email.Provider != SMTP_PROVIDER && config.Email.Provider != SENDGRID_PROVIDER {
+		problems = append(problems, "email.provider must be smtp or sendgrid")
+	}
+
+	if !strings.Contains(config.Email.SenderAddress, "@") {
+		problems = append(problems, "email.senderAddress must be an email address")
+	}
+
+	if config.EmailProvider() == SMTP_PROVIDER && (config.Email.SMTPPort < 1 || config.Email.SMTPPort > 65535) {
+		problems = append(problems, "email.smtpPort must be between 1 and 65535")
+	}
+
+	if config.EmailProvider() == SENDGRID_PROVIDER && config.Email.SendGridAPIKey == "" {
+		problems = append(problems, "email.sendgridApiKey is required to send email through SendGrid")
+	}
+
+	if config.Security.RateLimitStore != "memory" && config.Security.RateLimitStore != "database" {
+		problems = append(problems, "security.rateLimitStore must be memory or database")
+	}
+
+	if _, err := parseTrustedProxies(config.Security.TrustedProxies); err != nil {
+		problems = append(problems, "security.trustedProxies "+err.Error())
+	}
+
+	if config.Retention.DeletedRecords <= 0 {
+		problems = append(problems, "retention.deletedRecords must be positive")
+	}
+
+	if _, valid := logging.ParseLevel(config.Logging.Level); !valid {
+		problems = append(problems, "logging.level must be debug, info, warn or error")
+	}
+
+	if config.Logging.Format != "" && config.Logging.Format != logging.TEXT_FORMAT && config.Logging.Format != logging.JSON_FORMAT {
+		problems = append(problems, "logging.format must be text or json")
+	}
+
+	switch config.Storage.Backend {
+	case LOCAL_STORAGE:
+		if config.Storage.Directory == "" {
+			problems = append(problems, "storage.directory is required for local storage")
+		}
+	case S3_STORAGE:
+		if config.Storage.S3Endpoint == "" || config.Storage.S3Bucket == "" || config.Storage.S3Region == "" {
+			problems = append(problems, "storage.s3Endpoint, storage.s3Bucket and storage.s3Region are required for S3 storage")
+		}
+	default:
+		problems = append(problems, "storage.backend must be local or s3")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid configuration: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func isSiteURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || parsed.User != nil || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && (parsed.Path == "" || parsed.Path == "/")
+}
+
+// PublicOrigin is server.publicURL without a trailing slash, ready for paths to be added to it.
+func (config *Config) PublicOrigin() string {
+	return strings.TrimSuffix(config.Server.PublicURL, "/")
+}
+
+// EmailProvider resolves an empty email.provider to SMTP in development mode and SendGrid otherwise.
+func (config *Config) EmailProvider() string {
+	if config.Email.Provider != "" {
+		return config.Email.Provider
+	}
+
+	if config.Server.DevelopmentMode {
+		return SMTP_PROVIDER
+	}
+	return SENDGRID_PROVIDER
+}
+
+// LogFormat resolves an empty logging.format to text in development mode and JSON otherwise.
+func (config *Config) LogFormat() string {
+	if config.Logging.Format != "" {
+		return config.Logging.Format
+	}
+
+	if config.Server.DevelopmentMode {
+		return logging.TEXT_FORMAT
+	}
+	return logging.JSON_FORMAT
+}
+
+// NewLogger builds the logger the config describes, writing to out.
+func (config *Config) NewLogger(out io.Writer) *logging.Logger {
+	level, _ := logging.ParseLevel(config.Logging.Level)
+	return logging.New(out, level, config.LogFormat())
+}
+
+// TrustedProxyNetworks returns the ranges of security.trustedProxies. Single addresses are ranges
+// of one.
+func (config *Config) TrustedProxyNetworks() []*net.IPNet {
+	networks, _ := parseTrustedProxies(config.Security.TrustedProxies)
+	return networks
+}
+
+func parseTrustedProxies(trustedProxies string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0)
+	for _, entry := range strings.Split(trustedProxies, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("must be addresses or CIDR ranges, not %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("must be addresses or CIDR ranges, not %q", entry)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// NewStore builds the store attachments are kept in.
+func (config *Config) NewStore() storage.Store {
+	if config.Storage.Backend == S3_STORAGE {
+		return &storage.S3Store{
+			Endpoint:        config.Storage.S3Endpoint,
+			Bucket:          config.Storage.S3Bucket,
+			Region:          config.Storage.S3Region,
+			AccessKeyID:     config.Storage.S3AccessKeyID,
+			SecretAccessKey: config.Storage.S3SecretAccessKey,
+		}
+	}
+	return &storage.FileStore{Directory: config.Storage.Directory}
+}
+
+// DatabaseHost is the connection string to open: the database URL, the SQLite file, or else a
+// shared in-memory SQLite database.
+func (config *Config) DatabaseHost() string {
+	if config.Database.URL != "" {
+		return config.Database.URL
+	}
+
+	if config.Database.SQLiteFile != "" {
+		return database.BuildSQLiteFileHost(config.Database.SQLiteFile)
+	}
+	return "file::memory:?mode=memory&cache=shared"
+}
+
+// Print writes the config as YAML, with secrets redacted, so it can be checked or saved as a config file.
+func (config *Config) Print(w io.Writer) error {
+	redacted := *config
+	if redacted.Email.SendGridAPIKey != "" {
+		redacted.Email.SendGridAPIKey = REDACTED
+	}
+	if redacted.Storage.S3SecretAccessKey != "" {
+		redacted.Storage.S3SecretAccessKey = REDACTED
+	}
+	redacted.Database.URL = redactURLPassword(redacted.Database.URL)
+
+	contents, err := yaml.Marshal(&redacted)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(contents)
+	return err
+}
+
+func redactURLPassword(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.User == nil {
+		return rawURL
+	}
+
+	password, hasPassword := parsed.User.Password()
+	if !hasPassword {
+		return rawURL
+	}
+	return strings.Replace(rawURL, ":"+url.PathEscape(password)+"@", ":"+REDACTED+"@", 1)
+}
+
+// settingValue parses a setting's flag or environment variable into its config field.
+type settingValue struct {
+	field interface{}
+}
+
+func (v *settingValue) String() string {
+	switch field := v.field.(type) {
+	case *string:
+		return *field
+	case *int:
+		return strconv.Itoa(*field)
+	case *bool:
+		return strconv.FormatBool(*field)
+	case *Duration:
+		return time.Duration(*field).String()
+	}
+	return ""
+}
+
+func (v *settingValue) Set(value string) error {
+	switch field := v.field.(type) {
+	case *string:
+		*field = value
+	case *int:
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*field = parsed
+	case *bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*field = parsed
+	case *Duration:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field = Duration(parsed)
+	}
+	return nil
+}
+
+// IsBoolFlag lets boolean flags be given without a value, as in -developmentMode.
+func (v *settingValue) IsBoolFlag() bool {
+	_, isBool := v.field.(*bool)
+	return isBool
+}
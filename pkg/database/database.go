@@ -2,10 +2,10 @@ package database
 
 import (
 	"database/sql"
-	"log"
-	"path/filepath"
+	"net/url"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
 
-	"github.com/kwhite17/Neighbors/pkg/assets"
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -13,12 +13,18 @@ import (
 func InitDatabase(dbConfig *dbConfig) *sql.DB {
 	db, err := sql.Open(dbConfig.Driver, dbConfig.Host)
 	if err != nil {
-		log.Fatalf("ERROR - dbInit: Connect - %v\n", err)
+		logging.Default().Fatal("Couldn't open the database", logging.Fields{"error": err})
 	}
-	if dbConfig.DevelopmentMode {
-		_, err = db.Exec(loadMigration())
+	// SQLite databases are always brought up to date. Postgres databases are migrated with
+	// `neighbors migrate` outside of development.
+	if dbConfig.DevelopmentMode || dbConfig.Dialect() == SQLITE_DIALECT {
+		addedColumns, err := Migrate(db, dbConfig.Dialect())
 		if err != nil {
-			log.Fatalf("ERROR - dbInit: Table Creation - %v\n", err)
+			logging.Default().Fatal("Couldn't migrate the database", logging.Fields{"error": err})
+		}
+
+		for _, statement := range addedColumns {
+			logging.Default().Info("Migrated", logging.Fields{"statement": statement})
 		}
 	}
 	if dbConfig.Driver == SQLITE3.Driver {
@@ -27,12 +33,12 @@ func InitDatabase(dbConfig *dbConfig) *sql.DB {
 	return db
 }
 
-func loadMigration() string {
-	fullPath := filepath.Join("assets", "scripts", "neighbors_db.sql")
-	migrationFile, err := assets.Asset(fullPath)
-	if err != nil {
-		log.Fatalf("ERROR - migration - %v\n", err)
-	}
-
-	return string(migrationFile)
+// BuildSQLiteFileHost returns a data source name for a SQLite database stored at path, with
+// write-ahead logging so reads don't block on writes, and foreign keys enforced.
+func BuildSQLiteFileHost(path string) string {
+	parameters := url.Values{}
+	parameters.Set("_journal_mode", "WAL")
+	parameters.Set("_foreign_keys", "1")
+	parameters.Set("_busy_timeout", "5000")
+	return "file:" + path + "?" + parameters.Encode()
 }
@@ -3,32 +3,36 @@ package database
 import (
 	"context"
 	"database/sql"
-	"log"
-	"math"
-	"regexp"
+	"time"
 )
 
 type Datasource interface {
-	ExecuteBatchReadQuery(ctx context.Context, query string, arguments []interface{}) (*sql.Rows, error)
-	ExecuteWriteQuery(ctx context.Context, query string, arguments []interface{}, returnResult bool) (sql.Result, error)
-	ExecuteSingleReadQuery(ctx context.Context, query string, arguments []interface{}) *sql.Row
-	finalizeQuery(query string, isWriteQuery bool, returnResult bool) string
+	ExecuteBatchReadQuery(ctx context.Context, query *Query, arguments []interface{}) (*sql.Rows, error)
+	ExecuteWriteQuery(ctx context.Context, query *Query, arguments []interface{}) (sql.Result, error)
+	ExecuteSingleReadQuery(ctx context.Context, query *Query, arguments []interface{}) *sql.Row
+	Dialect() Dialect
+	// Ping checks that the database can still be reached.
+	Ping(ctx context.Context) error
+	// Transaction runs work against a datasource whose queries all belong to one transaction. It
+	// is committed if work succeeds and rolled back otherwise.
+	Transaction(ctx context.Context, work func(Datasource) error) error
+	Close() error
 }
 
 type StandardDatasource struct {
 	Database *sql.DB
-	Datasource
+	// tx is set on the datasource passed to the work of a transaction.
+	tx *sql.Tx
 }
 
 type PostgresDatasource struct {
 	Database *sql.DB
-	Datasource
+	tx       *sql.Tx
 }
 
 type postgresResult struct {
 	lastInsertID int64
 	rowsAffected int64
-	sql.Result
 }
 
 type dbConfig struct {
@@ -43,80 +47,108 @@ var SQLITE3 = &dbConfig{
 	DevelopmentMode: true,
 }
 
-func (sd StandardDatasource) ExecuteSingleReadQuery(ctx context.Context, query string, arguments []interface{}) *sql.Row {
-	return sd.Database.QueryRowContext(ctx, sd.finalizeQuery(query, false, false), arguments...)
+func (config *dbConfig) Dialect() Dialect {
+	if config.Driver == "postgres" {
+		return POSTGRES_DIALECT
+	}
+	return SQLITE_DIALECT
 }
 
-func (sd StandardDatasource) ExecuteBatchReadQuery(ctx context.Context, query string, arguments []interface{}) (*sql.Rows, error) {
-	resultSet, err := sd.Database.QueryContext(ctx, sd.finalizeQuery(query, false, false), arguments...)
-	if err != nil {
-		log.Printf("ERROR - ReadQuery: %s, Args: %v, Error: %v\n", query, arguments, err)
-		return nil, err
-	}
-	return resultSet, nil
+func (sd StandardDatasource) Dialect() Dialect {
+	return SQLITE_DIALECT
 }
 
-func (sd StandardDatasource) ExecuteWriteQuery(ctx context.Context, query string, arguments []interface{}, returnResult bool) (sql.Result, error) {
-	result, err := sd.Database.ExecContext(ctx, sd.finalizeQuery(query, true, false), arguments...)
-	if err != nil {
-		log.Printf("ERROR - WriteQuery: %s, Args: %v, Error: %v\n", query, arguments, err)
-		return nil, err
-	}
-	return result, nil
+func (sd StandardDatasource) Ping(ctx context.Context) error {
+	return sd.Database.PingContext(ctx)
 }
 
-func (sd StandardDatasource) finalizeQuery(query string, isWriteQuery bool, returnResult bool) string {
-	postgresParam := regexp.MustCompile("$[0-9]")
-	finalQuery := postgresParam.ReplaceAllLiteralString(query, "?")
+func (sd StandardDatasource) Close() error {
+	return sd.Database.Close()
+}
 
-	return finalQuery
+func (sd StandardDatasource) Transaction(ctx context.Context, work func(Datasource) error) error {
+	if sd.tx != nil {
+		return work(sd)
+	}
+	return runTransaction(ctx, sd.Database, func(tx *sql.Tx) error {
+		return work(StandardDatasource{Database: sd.Database, tx: tx})
+	})
 }
 
-func (pd PostgresDatasource) ExecuteSingleReadQuery(ctx context.Context, query string, arguments []interface{}) *sql.Row {
-	return pd.Database.QueryRowContext(ctx, pd.finalizeQuery(query, false, false), arguments...)
+func (sd StandardDatasource) ExecuteSingleReadQuery(ctx context.Context, query *Query, arguments []interface{}) *sql.Row {
+	return executeSingleReadQuery(ctx, queryRunnerFor(sd.Database, sd.tx), query.Render(sd.Dialect()), arguments)
 }
 
-func (pd PostgresDatasource) ExecuteBatchReadQuery(ctx context.Context, query string, arguments []interface{}) (*sql.Rows, error) {
-	resultSet, err := pd.Database.QueryContext(ctx, pd.finalizeQuery(query, false, false), arguments...)
-	if err != nil {
-		log.Printf("ERROR - ReadQuery: %s, Args: %v, Error: %v\n", query, arguments, err)
-		return nil, err
-	}
-	return resultSet, nil
+func (sd StandardDatasource) ExecuteBatchReadQuery(ctx context.Context, query *Query, arguments []interface{}) (*sql.Rows, error) {
+	return executeBatchReadQuery(ctx, queryRunnerFor(sd.Database, sd.tx), query, sd.Dialect(), arguments)
 }
 
-func (pd PostgresDatasource) ExecuteWriteQuery(ctx context.Context, query string, arguments []interface{}, returnResult bool) (sql.Result, error) {
-	rows, err := pd.Database.QueryContext(ctx, pd.finalizeQuery(query, true, returnResult), arguments...)
+func (sd StandardDatasource) ExecuteWriteQuery(ctx context.Context, query *Query, arguments []interface{}) (sql.Result, error) {
+	statement := query.Render(sd.Dialect())
+	defer observeQuery(ctx, statement, time.Now())
+	result, err := queryRunnerFor(sd.Database, sd.tx).ExecContext(ctx, statement, arguments...)
 	if err != nil {
-		log.Printf("ERROR - WriteQuery: %s, Args: %v, Error: %v\n", query, arguments, err)
+		logQueryError(ctx, "WriteQuery", query, statement, arguments, err)
 		return nil, err
 	}
+	return result, nil
+}
+
+func (pd PostgresDatasource) Dialect() Dialect {
+	return POSTGRES_DIALECT
+}
 
-	return pd.buildPostgresResult(rows), nil
+func (pd PostgresDatasource) Ping(ctx context.Context) error {
+	return pd.Database.PingContext(ctx)
 }
 
-func (pd PostgresDatasource) finalizeQuery(query string, isWriteQuery bool, returnResult bool) string {
-	finalQuery := query
-	if isWriteQuery && returnResult {
-		finalQuery = query + " RETURNING id"
+func (pd PostgresDatasource) Close() error {
+	return pd.Database.Close()
+}
+
+func (pd PostgresDatasource) Transaction(ctx context.Context, work func(Datasource) error) error {
+	if pd.tx != nil {
+		return work(pd)
 	}
+	return runTransaction(ctx, pd.Database, func(tx *sql.Tx) error {
+		return work(PostgresDatasource{Database: pd.Database, tx: tx})
+	})
+}
 
-	return finalQuery + ";"
+func (pd PostgresDatasource) ExecuteSingleReadQuery(ctx context.Context, query *Query, arguments []interface{}) *sql.Row {
+	return executeSingleReadQuery(ctx, queryRunnerFor(pd.Database, pd.tx), query.Render(pd.Dialect()), arguments)
 }
 
-func (pd PostgresDatasource) buildPostgresResult(rows *sql.Rows) postgresResult {
-	lastInsertID := int64(-1)
-	rowsAffected := int64(0)
-	for rows.Next() {
-		var id int64
-		err := rows.Scan(&id)
-		if err != nil {
+func (pd PostgresDatasource) ExecuteBatchReadQuery(ctx context.Context, query *Query, arguments []interface{}) (*sql.Rows, error) {
+	return executeBatchReadQuery(ctx, queryRunnerFor(pd.Database, pd.tx), query, pd.Dialect(), arguments)
+}
 
+// ExecuteWriteQuery reads back the generated ID of queries that return one, since lib/pq doesn't
+// support LastInsertId. An insert skipped by its conflict clause returns no ID and affects no rows.
+func (pd PostgresDatasource) ExecuteWriteQuery(ctx context.Context, query *Query, arguments []interface{}) (sql.Result, error) {
+	statement := query.Render(pd.Dialect())
+	defer observeQuery(ctx, statement, time.Now())
+	runner := queryRunnerFor(pd.Database, pd.tx)
+	if !query.ReturnsID() {
+		result, err := runner.ExecContext(ctx, statement, arguments...)
+		if err != nil {
+			logQueryError(ctx, "WriteQuery", query, statement, arguments, err)
+			return nil, err
 		}
-		rowsAffected++
-		lastInsertID = int64(math.Max(float64(lastInsertID), float64(id)))
+		return result, nil
+	}
+
+	var id int64
+	err := runner.QueryRowContext(ctx, statement, arguments...).Scan(&id)
+	if err == sql.ErrNoRows {
+		return postgresResult{lastInsertID: -1, rowsAffected: 0}, nil
+	}
+
+	if err != nil {
+		logQueryError(ctx, "WriteQuery", query, statement, arguments, err)
+		return nil, err
 	}
-	return postgresResult{lastInsertID: int64(lastInsertID), rowsAffected: int64(rowsAffected)}
+	return postgresResult{lastInsertID: id, rowsAffected: 1}, nil
 }
 
 func (pr postgresResult) LastInsertId() (int64, error) {
@@ -127,6 +159,23 @@ func (pr postgresResult) RowsAffected() (int64, error) {
 	return pr.rowsAffected, nil
 }
 
+// executeSingleReadQuery only times running the query, since its error isn't known until the row is scanned.
+func executeSingleReadQuery(ctx context.Context, db queryRunner, statement string, arguments []interface{}) *sql.Row {
+	defer observeQuery(ctx, statement, time.Now())
+	return db.QueryRowContext(ctx, statement, arguments...)
+}
+
+func executeBatchReadQuery(ctx context.Context, db queryRunner, query *Query, dialect Dialect, arguments []interface{}) (*sql.Rows, error) {
+	statement := query.Render(dialect)
+	defer observeQuery(ctx, statement, time.Now())
+	resultSet, err := db.QueryContext(ctx, statement, arguments...)
+	if err != nil {
+		logQueryError(ctx, "ReadQuery", query, statement, arguments, err)
+		return nil, err
+	}
+	return resultSet, nil
+}
+
 func BuildDatasource(driver string, host string, developmentMode bool) Datasource {
 	config := buildConfig(driver, host, developmentMode)
 	if config.Driver == "postgres" {
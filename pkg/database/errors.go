@@ -0,0 +1,57 @@
+package database
+
+import (
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+const postgresUniqueViolation = "23505"
+
+// sqliteUniqueViolationPrefix starts SQLite's messages for unique violations, which go on to name
+// the index, or the columns of a constraint declared with the table.
+const sqliteUniqueViolationPrefix = "UNIQUE constraint failed: "
+
+// IsUniqueViolation reports whether err was caused by a UNIQUE constraint or index rejecting a write.
+func IsUniqueViolation(err error) bool {
+	switch dbErr := err.(type) {
+	case sqlite3.Error:
+		return dbErr.ExtendedCode == sqlite3.ErrConstraintUnique || dbErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	case *pq.Error:
+		return dbErr.Code == postgresUniqueViolation
+	default:
+		return false
+	}
+}
+
+// UniqueViolationKeys returns what rejected a write that IsUniqueViolation: the name of the
+// constraint or index, and on SQLite, for constraints declared with the table, their columns
+// like "users.Email" instead. It returns nothing for other errors.
+func UniqueViolationKeys(err error) []string {
+	switch dbErr := err.(type) {
+	case sqlite3.Error:
+		message := dbErr.Error()
+		if dbErr.ExtendedCode != sqlite3.ErrConstraintUnique || !strings.HasPrefix(message, sqliteUniqueViolationPrefix) {
+			return nil
+		}
+
+		key := strings.TrimPrefix(message, sqliteUniqueViolationPrefix)
+		if strings.HasPrefix(key, "index '") {
+			return []string{strings.TrimSuffix(strings.TrimPrefix(key, "index '"), "'")}
+		}
+
+		keys := strings.Split(key, ",")
+		for i := range keys {
+			keys[i] = strings.TrimSpace(keys[i])
+		}
+		return keys
+	case *pq.Error:
+		if dbErr.Code != postgresUniqueViolation {
+			return nil
+		}
+		return []string{dbErr.Constraint}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestSQLiteUniqueViolationsNameTheirKey(t *testing.T) {
+	datasource := StandardDatasource{Database: InitDatabase(SQLITE3)}
+	defer datasource.Database.Close()
+	ctx := context.Background()
+
+	insert := Insert("blackoutDates", "ShelterID", "Day", "Reason")
+	if _, err := datasource.ExecuteWriteQuery(ctx, insert, []interface{}{1, "2024-12-25", "Closed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := datasource.ExecuteWriteQuery(ctx, insert, []interface{}{1, "2024-12-25", "Still closed"})
+	if keys := UniqueViolationKeys(err); !IsUniqueViolation(err) || !reflect.DeepEqual(keys, []string{"blackoutDates.ShelterID", "blackoutDates.Day"}) {
+		t.Errorf("Expected the constraint's columns, got %v from %v", keys, err)
+	}
+
+	insert = Insert("users", "Name", "Username", "Email", "Password", "UserType")
+	if _, err := datasource.ExecuteWriteQuery(ctx, insert, []interface{}{"Harbor", "harbor", "harbor@example.org", "hash", 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = datasource.ExecuteWriteQuery(ctx, insert, []interface{}{"Harbor", "HARBOR", "other@example.org", "hash", 1})
+	if keys := UniqueViolationKeys(err); !reflect.DeepEqual(keys, []string{"idx_users_username_lower"}) {
+		t.Errorf("Expected the index's name, got %v from %v", keys, err)
+	}
+}
+
+func TestPostgresUniqueViolationsNameTheirConstraint(t *testing.T) {
+	err := &pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "idx_users_email_lower"`, Constraint: "idx_users_email_lower"}
+	if keys := UniqueViolationKeys(err); !IsUniqueViolation(err) || !reflect.DeepEqual(keys, []string{"idx_users_email_lower"}) {
+		t.Errorf("Expected the constraint's name, got %v", keys)
+	}
+
+	notUnique := &pq.Error{Code: "23503", Constraint: "fk_items_shelter"}
+	if keys := UniqueViolationKeys(notUnique); keys != nil || UniqueViolationKeys(errors.New("username taken")) != nil {
+		t.Errorf("Expected other errors to have no keys, got %v", keys)
+	}
+}
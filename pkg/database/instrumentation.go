@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/metrics"
+)
+
+// SLOW_QUERY_THRESHOLD is how long a query can take before it is logged.
+const SLOW_QUERY_THRESHOLD = 500 * time.Millisecond
+
+var databasePackage = reflect.TypeOf(Query{}).PkgPath() + "."
+
+// observeQuery is deferred by each query with the time it started. It records how long the query
+// took against the manager method that ran it, and logs it if it was slow.
+func observeQuery(ctx context.Context, statement string, start time.Time) {
+	elapsed := time.Since(start)
+	manager, method := queryCaller()
+	metrics.QueryDuration.WithLabelValues(manager, method).Observe(elapsed.Seconds())
+	if elapsed >= SLOW_QUERY_THRESHOLD {
+		logging.FromContext(ctx).Warn("Slow query", logging.Fields{"query": statement, "caller": manager + "." + method, "elapsed": elapsed.String()})
+	}
+}
+
+// logQueryError logs a failed query with its arguments named after their columns, so that
+// passwords, emails and the like are redacted.
+func logQueryError(ctx context.Context, kind string, query *Query, statement string, arguments []interface{}, err error) {
+	logging.FromContext(ctx).Error(kind+" failed", logging.Fields{
+		"query":     statement,
+		"arguments": logging.RedactArguments(query.ArgumentNames(), arguments),
+		"error":     err,
+	})
+}
+
+// queryCaller finds the first function outside this package on the stack.
+func queryCaller() (string, string) {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, databasePackage) {
+			return splitFunctionName(frame.Function)
+		}
+
+		if !more {
+			return "unknown", "unknown"
+		}
+	}
+}
+
+// splitFunctionName turns ".../managers.(*ItemManager).GetItem" into ItemManager and GetItem, and
+// ".../managers.recordAuditEvent" into managers and recordAuditEvent. Closures are attributed to
+// the function they're in.
+func splitFunctionName(function string) (string, string) {
+	name := function[strings.LastIndex(function, "/")+1:]
+	parts := strings.Split(name, ".")
+	if len(parts) < 2 {
+		return "unknown", name
+	}
+
+	packageName, parts := parts[0], parts[1:]
+	if len(parts) > 1 && !isClosureName(parts[1]) {
+		return strings.Trim(parts[0], "(*)"), parts[1]
+	}
+	return packageName, parts[0]
+}
+
+func isClosureName(name string) bool {
+	return strings.HasPrefix(name, "func") || strings.HasPrefix(name, "gowrap") || (name != "" && name[0] >= '0' && name[0] <= '9')
+}
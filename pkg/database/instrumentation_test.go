@@ -0,0 +1,19 @@
+package database
+
+import "testing"
+
+func TestQueriesAreAttributedToManagerMethods(t *testing.T) {
+	tests := map[string][2]string{
+		"github.com/kwhite17/Neighbors/pkg/managers.(*ItemManager).GetItem":         {"ItemManager", "GetItem"},
+		"github.com/kwhite17/Neighbors/pkg/managers.(*UserManager).PurgeUser.func1": {"UserManager", "PurgeUser"},
+		"github.com/kwhite17/Neighbors/pkg/managers.recordAuditEvent":               {"managers", "recordAuditEvent"},
+		"github.com/kwhite17/Neighbors/pkg/managers.readIDs.func2":                  {"managers", "readIDs"},
+	}
+
+	for function, expected := range tests {
+		manager, method := splitFunctionName(function)
+		if manager != expected[0] || method != expected[1] {
+			t.Errorf("Expected %s to be attributed to %v, got %s.%s", function, expected, manager, method)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Migrate brings a database up to date with SCHEMA. Missing tables, indexes and seed rows are
+// created, columns added to SCHEMA since a table was created are added to it and backfilled, and
+// integer columns widened in SCHEMA are widened. It returns the statements it ran to alter tables.
+func Migrate(db *sql.DB, dialect Dialect) ([]string, error) {
+	for _, table := range SCHEMA {
+		if _, err := db.Exec(renderCreateTable(dialect, table)); err != nil {
+			return nil, fmt.Errorf("Creating %s: %v", table.Name, err)
+		}
+	}
+
+	alterations := make([]string, 0)
+	for _, table := range SCHEMA {
+		existingColumns, err := getExistingColumns(db, dialect, table.Name)
+		if err != nil {
+			return alterations, err
+		}
+
+		for _, column := range table.Columns {
+			existingType, found := existingColumns[strings.ToLower(column.Name)]
+			statements := make([]string, 0)
+			if !found {
+				statements, err = renderAddColumn(dialect, table, column)
+				if err != nil {
+					return alterations, err
+				}
+			} else if statement := renderWidenColumn(dialect, table, column, existingType); statement != "" {
+				statements = append(statements, statement)
+			}
+
+			for _, statement := range statements {
+				if _, err := db.Exec(statement); err != nil {
+					return alterations, fmt.Errorf("Altering %s.%s: %v", table.Name, column.Name, err)
+				}
+				alterations = append(alterations, statement)
+			}
+		}
+	}
+
+	for _, table := range SCHEMA {
+		for _, index := range table.Indexes {
+			if _, err := db.Exec(renderCreateIndex(table, index)); err != nil {
+				return alterations, fmt.Errorf("Creating %s: %v", index.Name, err)
+			}
+		}
+	}
+
+	for _, table := range SCHEMA {
+		for _, row := range table.Rows {
+			if _, err := db.Exec(renderInsertRow(dialect, table, row)); err != nil {
+				return alterations, fmt.Errorf("Seeding %s: %v", table.Name, err)
+			}
+		}
+	}
+	return alterations, nil
+}
+
+// MigrateDatasource migrates the database behind a datasource from BuildDatasource.
+func MigrateDatasource(datasource Datasource) ([]string, error) {
+	switch ds := datasource.(type) {
+	case StandardDatasource:
+		return Migrate(ds.Database, ds.Dialect())
+	case PostgresDatasource:
+		return Migrate(ds.Database, ds.Dialect())
+	}
+	return nil, fmt.Errorf("Can't migrate a %T", datasource)
+}
+
+// renderAddColumn refuses to add a required column without a default or a backfill, since
+// existing rows would have no value for it. A backfilled column is added as nullable, filled in,
+// and then made required. SQLite can't change whether an existing column is required, so there it
+// stays nullable, with the app always writing it.
+func renderAddColumn(dialect Dialect, table *Table, column *Column) ([]string, error) {
+	backfill := column.Backfill[dialect]
+	if column.Type == SERIAL || column.PrimaryKey || (!column.Nullable && column.Default == "" && backfill == "") {
+		return nil, fmt.Errorf("%s.%s can't be added to an existing table without a default", table.Name, column.Name)
+	}
+
+	if column.Nullable || column.Default != "" {
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", table.Name, renderColumn(dialect, column))}, nil
+	}
+
+	nullable := *column
+	nullable.Nullable = true
+	statements := []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", table.Name, renderColumn(dialect, &nullable)),
+		fmt.Sprintf("UPDATE %s SET %s = %s WHERE %s IS NULL;", table.Name, column.Name, backfill, column.Name),
+	}
+	if dialect == POSTGRES_DIALECT {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL;", table.Name, column.Name))
+	}
+	return statements, nil
+}
+
+// integerWidths orders the integer types Postgres reports, narrowest first.
+var integerWidths = map[string]int{"smallint": 1, "integer": 2, "bigint": 3}
+
+// renderWidenColumn returns the statement widening an existing integer column to its type in
+// SCHEMA, or "" when it's already wide enough. SQLite stores any integer in any integer column, so
+// only Postgres columns are widened.
+func renderWidenColumn(dialect Dialect, table *Table, column *Column, existingType string) string {
+	if dialect != POSTGRES_DIALECT || integerWidths[existingType] == 0 {
+		return ""
+	}
+
+	columnType := renderColumnType(dialect, column)
+	if integerWidths[strings.ToLower(columnType)] <= integerWidths[existingType] {
+		return ""
+	}
+	return fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s;", table.Name, column.Name, columnType)
+}
+
+// getExistingColumns returns the lower-cased types of a table's columns by their lower-cased
+// names. Postgres folds the unquoted names in SCHEMA to lower case.
+func getExistingColumns(db *sql.DB, dialect Dialect, tableName string) (map[string]string, error) {
+	var rows *sql.Rows
+	var err error
+	if dialect == POSTGRES_DIALECT {
+		rows, err = db.Query("SELECT column_name, data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1", strings.ToLower(tableName))
+	} else {
+		rows, err = db.Query("SELECT name, type FROM pragma_table_info(?)", tableName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns := make(map[string]string)
+	for rows.Next() {
+		var name string
+		var columnType string
+		if err := rows.Scan(&name, &columnType); err != nil {
+			return nil, err
+		}
+		columns[strings.ToLower(name)] = strings.ToLower(columnType)
+	}
+	return columns, rows.Err()
+}
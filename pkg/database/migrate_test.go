@@ -0,0 +1,180 @@
+package database
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMigrateAddsColumnsMissingFromExistingTables(t *testing.T) {
+	directory, err := ioutil.TempDir("", "neighbors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	db, err := sql.Open("sqlite3", BuildSQLiteFileHost(filepath.Join(directory, "neighbors.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE items (ID INTEGER PRIMARY KEY AUTOINCREMENT, Category VARCHAR(100) NOT NULL, Gender VARCHAR(100) NOT NULL, Quantity SMALLINT NOT NULL, Size VARCHAR(100) NOT NULL, Status SMALLINT NOT NULL, ShelterID INTEGER NOT NULL, SamaritanID INTEGER NULL)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addedColumns, err := Migrate(db, SQLITE_DIALECT)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"ALTER TABLE items ADD COLUMN Unit VARCHAR(20) NOT NULL DEFAULT 'each';",
+		"ALTER TABLE items ADD COLUMN Version INTEGER NOT NULL DEFAULT 1;",
+		"ALTER TABLE items ADD COLUMN DeletedTime BIGINT NULL;",
+		"ALTER TABLE items ADD COLUMN CreatedTime BIGINT NOT NULL DEFAULT 0;",
+		"ALTER TABLE items ADD COLUMN ClaimedTime BIGINT NOT NULL DEFAULT 0;",
+	}
+	if !reflect.DeepEqual(addedColumns, expected) {
+		t.Errorf("Expected %v to be run, got %v", expected, addedColumns)
+	}
+
+	addedColumns, err = Migrate(db, SQLITE_DIALECT)
+	if err != nil || len(addedColumns) != 0 {
+		t.Errorf("Expected a migrated database to need no changes, got %v: %v", addedColumns, err)
+	}
+}
+
+func TestMigrateRefusesRequiredColumnsWithoutDefaults(t *testing.T) {
+	table := &Table{Name: "items"}
+	if _, err := renderAddColumn(SQLITE_DIALECT, table, &Column{Name: "Required", Type: INTEGER}); err == nil {
+		t.Error("Expected a required column without a default to be refused")
+	}
+}
+
+func TestMigrateWidensPostgresIntegerColumns(t *testing.T) {
+	table := &Table{Name: "items"}
+	column := &Column{Name: "Quantity", Type: INTEGER}
+	if statement := renderWidenColumn(POSTGRES_DIALECT, table, column, "smallint"); statement != "ALTER TABLE items ALTER COLUMN Quantity TYPE INTEGER;" {
+		t.Errorf("Expected Quantity to be widened, got %q", statement)
+	}
+
+	if statement := renderWidenColumn(POSTGRES_DIALECT, table, column, "bigint"); statement != "" {
+		t.Errorf("Expected a wider column to be left alone, got %q", statement)
+	}
+
+	if statement := renderWidenColumn(SQLITE_DIALECT, table, column, "smallint"); statement != "" {
+		t.Errorf("Expected SQLite columns to be left alone, got %q", statement)
+	}
+}
+
+// baselineSchema is the SQLite schema the app had before usernames, which databases in use were
+// created with.
+const baselineSchema = `
+CREATE TABLE userTypes (ID INTEGER PRIMARY KEY, TypeName VARCHAR(20) NOT NULL);
+CREATE TABLE users (
+    ID INTEGER PRIMARY KEY AUTOINCREMENT,
+    Name VARCHAR(100) NOT NULL,
+    Email VARCHAR(100) NOT NULL,
+    Password VARCHAR(100) NOT NULL,
+    City VARCHAR(100) NULL,
+    PostalCode VARCHAR(100) NULL,
+    State VARCHAR(100) NULL,
+    Street VARCHAR(100) NULL,
+    UserType TINYINT NOT NULL DEFAULT 1,
+    CONSTRAINT idx_users_email UNIQUE (Email),
+    FOREIGN KEY(UserType) REFERENCES userTypes(ID) ON DELETE CASCADE
+);
+CREATE TABLE items (
+    ID INTEGER PRIMARY KEY AUTOINCREMENT,
+    Category VARCHAR(100) NOT NULL,
+    Gender VARCHAR(100) NOT NULL,
+    Quantity TINYINT NOT NULL,
+    Size VARCHAR(100) NOT NULL,
+    Status VARCHAR(100) NOT NULL,
+    ShelterID INTEGER NOT NULL,
+    SamaritanID INTEGER NULL,
+    FOREIGN KEY(ShelterID) REFERENCES users(ID) ON DELETE CASCADE,
+    FOREIGN KEY(SamaritanID) REFERENCES users(ID) ON DELETE CASCADE
+);
+CREATE TABLE userSessions (
+    SessionKey VARCHAR(50) PRIMARY KEY,
+    UserID INTEGER NOT NULL,
+    UserType TINYINT NOT NULL,
+    LoginTime BIGINT NOT NULL,
+    LastSeenTime BIGINT NOT NULL,
+    FOREIGN KEY(UserID) REFERENCES users(ID) ON DELETE CASCADE,
+    FOREIGN KEY(UserType) REFERENCES userTypes(ID) ON DELETE CASCADE
+);
+INSERT INTO userTypes VALUES (1, "SHELTER");
+INSERT INTO userTypes VALUES (2, "SAMARITAN");
+INSERT INTO users (Name, Email, Password, UserType) VALUES ('Harbor Shelter', 'Harbor@example.org', 'hash', 1);
+INSERT INTO users (Name, Email, Password, UserType) VALUES ('Ann', 'harbor@example.com', 'hash', 2);
+INSERT INTO items (Category, Gender, Quantity, Size, Status, ShelterID) VALUES ('Socks', 'Unisex', 5, 'L', 0, 1);
+`
+
+func TestMigrateBackfillsUsernamesOfExistingAccounts(t *testing.T) {
+	directory, err := ioutil.TempDir("", "neighbors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	db, err := sql.Open("sqlite3", BuildSQLiteFileHost(filepath.Join(directory, "neighbors.db")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(baselineSchema); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Migrate(db, SQLITE_DIALECT); err != nil {
+		t.Fatalf("Expected the baseline database to migrate, got %v", err)
+	}
+
+	rows, err := db.Query("SELECT Username FROM users ORDER BY ID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	usernames := make([]string, 0)
+	for rows.Next() {
+		var username string
+		if err := rows.Scan(&username); err != nil {
+			t.Fatal(err)
+		}
+		usernames = append(usernames, username)
+	}
+
+	if !reflect.DeepEqual(usernames, []string{"harbor-1", "harbor-2"}) {
+		t.Errorf("Expected each account to get a username of its own, got %v", usernames)
+	}
+
+	if _, err := db.Exec("INSERT INTO users (Name, Username, Email, Password, UserType) VALUES ('Copy', 'HARBOR-1', 'copy@example.org', 'hash', 1)"); !IsUniqueViolation(err) {
+		t.Errorf("Expected backfilled usernames to be unique ignoring case, got %v", err)
+	}
+
+	if alterations, err := Migrate(db, SQLITE_DIALECT); err != nil || len(alterations) != 0 {
+		t.Errorf("Expected a migrated database to need no changes, got %v: %v", alterations, err)
+	}
+}
+
+func TestPostgresBackfillsRequiredColumnsBeforeRequiringThem(t *testing.T) {
+	users := SCHEMA[1]
+	statements, err := renderAddColumn(POSTGRES_DIALECT, users, users.GetColumn("Username"))
+	expected := []string{
+		"ALTER TABLE users ADD COLUMN Username VARCHAR(100) NULL;",
+		"UPDATE users SET Username = LOWER(SPLIT_PART(Email, '@', 1)) || '-' || ID WHERE Username IS NULL;",
+		"ALTER TABLE users ALTER COLUMN Username SET NOT NULL;",
+	}
+	if err != nil || !reflect.DeepEqual(statements, expected) {
+		t.Errorf("Expected %v, got %v: %v", expected, statements, err)
+	}
+}
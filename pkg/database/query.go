@@ -0,0 +1,268 @@
+package database
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type queryKind int
+
+const (
+	selectQuery queryKind = iota
+	insertQuery
+	updateQuery
+	deleteQuery
+)
+
+// Query is a statement built once, usually into a package-level variable, and rendered for the
+// dialect of whichever datasource runs it. Conditions and assignments mark each argument with a
+// bare ?, and arguments are passed in the order their placeholders appear.
+type Query struct {
+	kind            queryKind
+	table           string
+	columns         []string
+	joins           []string
+	assignments     []string
+	conditions      []string
+	groupBy         []string
+	orderBy         []string
+	limit           bool
+	offset          bool
+	returning       string
+	conflictColumns []string
+	conflictUpdates []string
+	forUpdate       bool
+}
+
+var registeredQueries = make([]*Query, 0)
+
+func newQuery(kind queryKind, table string, columns []string) *Query {
+	query := &Query{kind: kind, table: table, columns: columns}
+	registeredQueries = append(registeredQueries, query)
+	return query
+}
+
+// RegisteredQueries returns every query built so far, so they can all be checked against each dialect.
+func RegisteredQueries() []*Query {
+	return append([]*Query(nil), registeredQueries...)
+}
+
+func Select(table string, columns ...string) *Query {
+	return newQuery(selectQuery, table, columns)
+}
+
+func Insert(table string, columns ...string) *Query {
+	return newQuery(insertQuery, table, columns)
+}
+
+func Update(table string) *Query {
+	return newQuery(updateQuery, table, nil)
+}
+
+func Delete(table string) *Query {
+	return newQuery(deleteQuery, table, nil)
+}
+
+func (query *Query) Join(joins ...string) *Query {
+	query.joins = append(query.joins, joins...)
+	return query
+}
+
+// Where adds conditions that must all hold.
+func (query *Query) Where(conditions ...string) *Query {
+	query.conditions = append(query.conditions, conditions...)
+	return query
+}
+
+// Set assigns an argument to each column.
+func (query *Query) Set(columns ...string) *Query {
+	for _, column := range columns {
+		query.assignments = append(query.assignments, column+" = ?")
+	}
+	return query
+}
+
+// SetExpression adds assignments written out in full, such as "ClosedTime = NULL".
+func (query *Query) SetExpression(assignments ...string) *Query {
+	query.assignments = append(query.assignments, assignments...)
+	return query
+}
+
+func (query *Query) GroupBy(columns ...string) *Query {
+	query.groupBy = append(query.groupBy, columns...)
+	return query
+}
+
+func (query *Query) OrderBy(terms ...string) *Query {
+	query.orderBy = append(query.orderBy, terms...)
+	return query
+}
+
+// Limit takes the maximum number of rows as an argument.
+func (query *Query) Limit() *Query {
+	query.limit = true
+	return query
+}
+
+// Offset takes the number of rows to skip as an argument, after the limit.
+func (query *Query) Offset() *Query {
+	query.offset = true
+	return query
+}
+
+// Returning makes the insert report the generated value of column as its LastInsertId.
+func (query *Query) Returning(column string) *Query {
+	query.returning = column
+	return query
+}
+
+// OnConflict skips the insert when it would violate the unique key on columns, unless DoUpdate
+// says which columns to overwrite instead.
+func (query *Query) OnConflict(columns ...string) *Query {
+	query.conflictColumns = columns
+	return query
+}
+
+func (query *Query) DoUpdate(columns ...string) *Query {
+	query.conflictUpdates = columns
+	return query
+}
+
+// ForUpdate locks the selected rows until the transaction ends, so concurrent transactions that
+// check them before writing take turns. SQLite has no row locks, but it only lets one transaction
+// write at a time, so the clause is left out there.
+func (query *Query) ForUpdate() *Query {
+	query.forUpdate = true
+	return query
+}
+
+func (query *Query) ReturnsID() bool {
+	return query.returning != ""
+}
+
+func (query *Query) IsWrite() bool {
+	return query.kind != selectQuery
+}
+
+func (query *Query) Render(dialect Dialect) string {
+	statement := &strings.Builder{}
+	switch query.kind {
+	case selectQuery:
+		statement.WriteString("SELECT " + strings.Join(query.columns, ", ") + " FROM " + query.table)
+		for _, join := range query.joins {
+			statement.WriteString(" JOIN " + join)
+		}
+	case insertQuery:
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(query.columns)), ", ")
+		statement.WriteString("INSERT INTO " + query.table + " (" + strings.Join(query.columns, ", ") + ") VALUES (" + placeholders + ")")
+	case updateQuery:
+		statement.WriteString("UPDATE " + query.table + " SET " + strings.Join(query.assignments, ", "))
+	case deleteQuery:
+		statement.WriteString("DELETE FROM " + query.table)
+	}
+
+	if len(query.conditions) > 0 {
+		statement.WriteString(" WHERE " + strings.Join(query.conditions, " AND "))
+	}
+
+	if len(query.conflictColumns) > 0 {
+		statement.WriteString(" ON CONFLICT (" + strings.Join(query.conflictColumns, ", ") + ")")
+		if len(query.conflictUpdates) == 0 {
+			statement.WriteString(" DO NOTHING")
+		} else {
+			updates := make([]string, len(query.conflictUpdates))
+			for i, column := range query.conflictUpdates {
+				updates[i] = column + " = excluded." + column
+			}
+			statement.WriteString(" DO UPDATE SET " + strings.Join(updates, ", "))
+		}
+	}
+
+	if len(query.groupBy) > 0 {
+		statement.WriteString(" GROUP BY " + strings.Join(query.groupBy, ", "))
+	}
+
+	if len(query.orderBy) > 0 {
+		statement.WriteString(" ORDER BY " + strings.Join(query.orderBy, ", "))
+	}
+
+	if query.limit {
+		statement.WriteString(" LIMIT ?")
+	}
+
+	if query.offset {
+		statement.WriteString(" OFFSET ?")
+	}
+
+	if query.forUpdate && dialect == POSTGRES_DIALECT {
+		statement.WriteString(" FOR UPDATE")
+	}
+
+	// SQLite doesn't support RETURNING, but its driver reports the inserted row ID directly.
+	if query.returning != "" && dialect == POSTGRES_DIALECT {
+		statement.WriteString(" RETURNING " + query.returning)
+	}
+	return numberPlaceholders(statement.String(), dialect)
+}
+
+func (query *Query) String() string {
+	return query.Render(SQLITE_DIALECT)
+}
+
+// Placeholder returns the marker for the nth argument of a statement, counting from 1.
+func (dialect Dialect) Placeholder(n int) string {
+	if dialect == POSTGRES_DIALECT {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// numberPlaceholders replaces each ? outside of a string literal with the dialect's placeholder.
+func numberPlaceholders(statement string, dialect Dialect) string {
+	rendered := &strings.Builder{}
+	inLiteral := false
+	argument := 0
+	for _, character := range statement {
+		switch {
+		case character == '\'':
+			inLiteral = !inLiteral
+			rendered.WriteRune(character)
+		case character == '?' && !inLiteral:
+			argument++
+			rendered.WriteString(dialect.Placeholder(argument))
+		default:
+			rendered.WriteRune(character)
+		}
+	}
+	return rendered.String()
+}
+
+// argumentNamePattern finds the column a placeholder is compared with or assigned to, as in
+// "LOWER(Email) = ?", or the clause it belongs to, as in "LIMIT ?".
+var argumentNamePattern = regexp.MustCompile(`(?i)(?:([A-Za-z_][A-Za-z0-9_]*)\)?\s*(?:=|<>|!=|<=|>=|<|>|\sLIKE|\sIN\s*\()|(LIMIT|OFFSET))\s*$`)
+
+// ArgumentNames names each of the query's arguments after its column where it can, so logged
+// arguments can be redacted by name. Arguments it can't name are "?".
+func (query *Query) ArgumentNames() []string {
+	if query.kind == insertQuery {
+		return query.columns
+	}
+
+	statement := query.String()
+	names := make([]string, 0)
+	inLiteral := false
+	for i, character := range statement {
+		switch {
+		case character == '\'':
+			inLiteral = !inLiteral
+		case character == '?' && !inLiteral:
+			name := "?"
+			if match := argumentNamePattern.FindStringSubmatch(statement[:i]); match != nil {
+				name = match[1] + match[2]
+			}
+			names = append(names, name)
+		}
+	}
+	return names
+}
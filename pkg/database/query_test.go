@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestQueriesRenderForEachDialect(t *testing.T) {
+	tests := []struct {
+		query    *Query
+		sqlite   string
+		postgres string
+	}{
+		{
+			query:    Select("items", "ID", "Status").Where("ShelterID = ?", "Status = ?").OrderBy("ID DESC").Limit().Offset(),
+			sqlite:   "SELECT ID, Status FROM items WHERE ShelterID = ? AND Status = ? ORDER BY ID DESC LIMIT ? OFFSET ?",
+			postgres: "SELECT ID, Status FROM items WHERE ShelterID = $1 AND Status = $2 ORDER BY ID DESC LIMIT $3 OFFSET $4",
+		},
+		{
+			query:    Select("items", "Status", "COUNT(*)").Where("DeletedTime IS NULL").GroupBy("Status"),
+			sqlite:   "SELECT Status, COUNT(*) FROM items WHERE DeletedTime IS NULL GROUP BY Status",
+			postgres: "SELECT Status, COUNT(*) FROM items WHERE DeletedTime IS NULL GROUP BY Status",
+		},
+		{
+			query:    Insert("items", "Category", "ShelterID").Returning("ID"),
+			sqlite:   "INSERT INTO items (Category, ShelterID) VALUES (?, ?)",
+			postgres: "INSERT INTO items (Category, ShelterID) VALUES ($1, $2) RETURNING ID",
+		},
+		{
+			query:    Insert("twoFactorSecrets", "UserID", "Secret").OnConflict("UserID").DoUpdate("Secret"),
+			sqlite:   "INSERT INTO twoFactorSecrets (UserID, Secret) VALUES (?, ?) ON CONFLICT (UserID) DO UPDATE SET Secret = excluded.Secret",
+			postgres: "INSERT INTO twoFactorSecrets (UserID, Secret) VALUES ($1, $2) ON CONFLICT (UserID) DO UPDATE SET Secret = excluded.Secret",
+		},
+		{
+			query:    Insert("rateLimitHits", "HitKey").OnConflict("HitKey"),
+			sqlite:   "INSERT INTO rateLimitHits (HitKey) VALUES (?) ON CONFLICT (HitKey) DO NOTHING",
+			postgres: "INSERT INTO rateLimitHits (HitKey) VALUES ($1) ON CONFLICT (HitKey) DO NOTHING",
+		},
+		{
+			query:    Update("users").Set("Name").SetExpression("City = '?'").Set("Email").Where("ID = ?"),
+			sqlite:   "UPDATE users SET Name = ?, City = '?', Email = ? WHERE ID = ?",
+			postgres: "UPDATE users SET Name = $1, City = '?', Email = $2 WHERE ID = $3",
+		},
+		{
+			query:    Select("intakeSchedules", "ShelterID").Where("ShelterID = ?").ForUpdate(),
+			sqlite:   "SELECT ShelterID FROM intakeSchedules WHERE ShelterID = ?",
+			postgres: "SELECT ShelterID FROM intakeSchedules WHERE ShelterID = $1 FOR UPDATE",
+		},
+		{
+			query:    Delete("userSessions").Where("UserID = ?"),
+			sqlite:   "DELETE FROM userSessions WHERE UserID = ?",
+			postgres: "DELETE FROM userSessions WHERE UserID = $1",
+		},
+	}
+
+	for _, test := range tests {
+		if rendered := test.query.Render(SQLITE_DIALECT); rendered != test.sqlite {
+			t.Errorf("Expected SQLite query %q, got %q", test.sqlite, rendered)
+		}
+
+		if rendered := test.query.Render(POSTGRES_DIALECT); rendered != test.postgres {
+			t.Errorf("Expected Postgres query %q, got %q", test.postgres, rendered)
+		}
+	}
+}
+
+func TestWriteQueriesReportInsertedIDAndRowsAffected(t *testing.T) {
+	datasource := StandardDatasource{Database: InitDatabase(SQLITE3)}
+	defer datasource.Database.Close()
+	insert := Insert("audit_events", "Action", "Entity", "EventTime").Returning("ID")
+	update := Update("audit_events").Set("EventTime").Where("Action = ?")
+
+	result, err := datasource.ExecuteWriteQuery(context.Background(), insert, []interface{}{"query-test", "user", 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if id, _ := result.LastInsertId(); id <= 0 {
+		t.Errorf("Expected a generated ID, got %v", id)
+	}
+
+	result, err = datasource.ExecuteWriteQuery(context.Background(), update, []interface{}{2, "query-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected != 1 {
+		t.Errorf("Expected 1 row to be updated, got %v", rowsAffected)
+	}
+}
+
+func TestArgumentsAreNamedAfterTheirColumns(t *testing.T) {
+	tests := []struct {
+		query    *Query
+		expected string
+	}{
+		{Insert("users", "Email", "Password"), "Email Password"},
+		{Update("users").Set("Password").SetExpression("City = '?'").Where("LOWER(Email) = ?", "DeletedTime IS NULL"), "Password Email"},
+		{Select("auditEvents", "ID").Where("CreatedTime >= ?", "Action LIKE ?").Limit().Offset(), "CreatedTime Action LIMIT OFFSET"},
+	}
+
+	for _, test := range tests {
+		if names := strings.Join(test.query.ArgumentNames(), " "); names != test.expected {
+			t.Errorf("Expected %v to name its arguments %q, got %q", test.query, test.expected, names)
+		}
+	}
+}
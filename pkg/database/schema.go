@@ -0,0 +1,504 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect is the flavour of SQL a database speaks.
+type Dialect string
+
+const (
+	SQLITE_DIALECT   Dialect = "sqlite3"
+	POSTGRES_DIALECT Dialect = "postgres"
+)
+
+// ColumnType is a portable column type, rendered differently by each dialect.
+type ColumnType int
+
+const (
+	// SERIAL is an auto-incrementing integer primary key.
+	SERIAL ColumnType = iota
+	// REFERENCE holds the ID of a row in another table, so it's as wide as SERIAL.
+	REFERENCE
+	SMALLINT
+	INTEGER
+	BIGINT
+	VARCHAR
+	// TEXT is unbounded, for documents such as JSON.
+	TEXT
+)
+
+type Column struct {
+	Name       string
+	Type       ColumnType
+	Size       int
+	Nullable   bool
+	Default    string
+	PrimaryKey bool
+	Unique     bool
+	// Backfill gives a required column without a default a value in each row of an existing
+	// table it's added to, as an expression in each dialect. It's run before the table's indexes
+	// are created, so they can be unique on it.
+	Backfill map[Dialect]string
+}
+
+type ForeignKey struct {
+	Column   string
+	Table    string
+	OnDelete string
+}
+
+type UniqueConstraint struct {
+	Name    string
+	Columns []string
+}
+
+type Index struct {
+	Name        string
+	Unique      bool
+	Expressions []string
+}
+
+type Table struct {
+	Name        string
+	Columns     []*Column
+	Uniques     []*UniqueConstraint
+	ForeignKeys []*ForeignKey
+	Indexes     []*Index
+	// Rows are inserted when the schema is applied, unless a row with the same key already exists.
+	Rows [][]interface{}
+}
+
+func (table *Table) GetColumn(name string) *Column {
+	for _, column := range table.Columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+// SCHEMA is the single source of truth for the database layout. Both the SQLite and the Postgres
+// DDL are rendered from it.
+var SCHEMA = []*Table{
+	{
+		Name: "userTypes",
+		Columns: []*Column{
+			{Name: "ID", Type: INTEGER, PrimaryKey: true},
+			{Name: "TypeName", Type: VARCHAR, Size: 20},
+		},
+		Rows: [][]interface{}{{1, "SHELTER"}, {2, "SAMARITAN"}, {3, "ADMIN"}},
+	},
+	{
+		Name: "users",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "Name", Type: VARCHAR, Size: 100},
+			// Accounts from before usernames get one from their email's local part and ID, such as
+			// ann-12, which can't collide with another since the ID comes last.
+			{Name: "Username", Type: VARCHAR, Size: 100, Backfill: map[Dialect]string{
+				SQLITE_DIALECT:   "LOWER(SUBSTR(Email, 1, INSTR(Email, '@') - 1)) || '-' || ID",
+				POSTGRES_DIALECT: "LOWER(SPLIT_PART(Email, '@', 1)) || '-' || ID",
+			}},
+			{Name: "Email", Type: VARCHAR, Size: 100},
+			{Name: "Password", Type: VARCHAR, Size: 100},
+			{Name: "City", Type: VARCHAR, Size: 100, Nullable: true},
+			{Name: "PostalCode", Type: VARCHAR, Size: 100, Nullable: true},
+			{Name: "State", Type: VARCHAR, Size: 100, Nullable: true},
+			{Name: "Street", Type: VARCHAR, Size: 100, Nullable: true},
+			{Name: "UserType", Type: SMALLINT, Default: "1"},
+			{Name: "ClosedTime", Type: BIGINT, Nullable: true},
+			{Name: "AnonymizedTime", Type: BIGINT, Nullable: true},
+			{Name: "Version", Type: INTEGER, Default: "1"},
+			{Name: "DeletedTime", Type: BIGINT, Nullable: true},
+		},
+		Uniques:     []*UniqueConstraint{{Name: "idx_users_email", Columns: []string{"Email"}}},
+		ForeignKeys: []*ForeignKey{{Column: "UserType", Table: "userTypes", OnDelete: "CASCADE"}},
+		Indexes: []*Index{
+			{Name: "idx_users_email_lower", Unique: true, Expressions: []string{"LOWER(Email)"}},
+			{Name: "idx_users_username_lower", Unique: true, Expressions: []string{"LOWER(Username)"}},
+		},
+	},
+	{
+		Name: "items",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "Category", Type: VARCHAR, Size: 100},
+			{Name: "Gender", Type: VARCHAR, Size: 100},
+			{Name: "Quantity", Type: INTEGER},
+			{Name: "Unit", Type: VARCHAR, Size: 20, Default: "'each'"},
+			{Name: "Size", Type: VARCHAR, Size: 100},
+			{Name: "Status", Type: SMALLINT},
+			{Name: "ShelterID", Type: REFERENCE},
+			{Name: "SamaritanID", Type: REFERENCE, Nullable: true},
+			{Name: "Version", Type: INTEGER, Default: "1"},
+			{Name: "DeletedTime", Type: BIGINT, Nullable: true},
+			{Name: "CreatedTime", Type: BIGINT, Default: "0"},
+			{Name: "ClaimedTime", Type: BIGINT, Default: "0"},
+		},
+		ForeignKeys: []*ForeignKey{
+			{Column: "ShelterID", Table: "users", OnDelete: "CASCADE"},
+			{Column: "SamaritanID", Table: "users", OnDelete: "CASCADE"},
+		},
+		Indexes: []*Index{{Name: "idx_items_open", Expressions: []string{"Status", "CreatedTime"}}},
+	},
+	{
+		// attachments has no foreign keys so that purging an item leaves its rows behind, marking
+		// the stored files that still have to be deleted.
+		Name: "attachments",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "ItemID", Type: REFERENCE},
+			{Name: "UploaderID", Type: REFERENCE},
+			{Name: "Kind", Type: VARCHAR, Size: 20},
+			{Name: "ContentType", Type: VARCHAR, Size: 50},
+			{Name: "Size", Type: BIGINT},
+			{Name: "StorageKey", Type: VARCHAR, Size: 200},
+			{Name: "ThumbnailKey", Type: VARCHAR, Size: 200},
+			{Name: "CreatedTime", Type: BIGINT},
+		},
+		Indexes: []*Index{{Name: "idx_attachments_item", Expressions: []string{"ItemID"}}},
+	},
+	{
+		// deliveryCodes holds the latest code issued to each item's samaritan, and once it's used,
+		// who received the item and when.
+		Name: "deliveryCodes",
+		Columns: []*Column{
+			{Name: "ItemID", Type: REFERENCE, PrimaryKey: true},
+			{Name: "SamaritanID", Type: REFERENCE},
+			{Name: "CodeHash", Type: VARCHAR, Size: 64, Unique: true},
+			{Name: "CreatedTime", Type: BIGINT},
+			{Name: "ReceiverID", Type: REFERENCE, Nullable: true},
+			{Name: "UsedTime", Type: BIGINT, Nullable: true},
+		},
+		ForeignKeys: []*ForeignKey{
+			{Column: "ItemID", Table: "items", OnDelete: "CASCADE"},
+			{Column: "SamaritanID", Table: "users", OnDelete: "CASCADE"},
+			{Column: "ReceiverID", Table: "users", OnDelete: "SET NULL"},
+		},
+	},
+	{
+		// intakeSchedules holds how a shelter takes drop-offs. Its weekly hours are in intakeHours.
+		Name: "intakeSchedules",
+		Columns: []*Column{
+			{Name: "ShelterID", Type: REFERENCE, PrimaryKey: true},
+			{Name: "TimeZone", Type: VARCHAR, Size: 50},
+			{Name: "SlotMinutes", Type: INTEGER},
+			{Name: "Instructions", Type: TEXT, Default: "''"},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "ShelterID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		Name: "intakeHours",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "ShelterID", Type: REFERENCE},
+			{Name: "Weekday", Type: SMALLINT},
+			{Name: "OpensAt", Type: SMALLINT},
+			{Name: "ClosesAt", Type: SMALLINT},
+			{Name: "Capacity", Type: INTEGER},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "ShelterID", Table: "users", OnDelete: "CASCADE"}},
+		Indexes:     []*Index{{Name: "idx_intake_hours_shelter", Expressions: []string{"ShelterID"}}},
+	},
+	{
+		Name: "blackoutDates",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "ShelterID", Type: REFERENCE},
+			{Name: "Day", Type: VARCHAR, Size: 10},
+			{Name: "Reason", Type: VARCHAR, Size: 200, Default: "''"},
+		},
+		Uniques:     []*UniqueConstraint{{Name: "idx_blackout_dates_day", Columns: []string{"ShelterID", "Day"}}},
+		ForeignKeys: []*ForeignKey{{Column: "ShelterID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		// dropOffs holds the slot each claimed item is to be dropped off in. A booking only holds its
+		// place while the item is still claimed by the samaritan who made it.
+		Name: "dropOffs",
+		Columns: []*Column{
+			{Name: "ItemID", Type: REFERENCE, PrimaryKey: true},
+			{Name: "ShelterID", Type: REFERENCE},
+			{Name: "SamaritanID", Type: REFERENCE},
+			{Name: "StartTime", Type: BIGINT},
+			{Name: "EndTime", Type: BIGINT},
+			{Name: "CreatedTime", Type: BIGINT},
+		},
+		ForeignKeys: []*ForeignKey{
+			{Column: "ItemID", Table: "items", OnDelete: "CASCADE"},
+			{Column: "ShelterID", Table: "users", OnDelete: "CASCADE"},
+			{Column: "SamaritanID", Table: "users", OnDelete: "CASCADE"},
+		},
+		Indexes: []*Index{{Name: "idx_drop_offs_shelter", Expressions: []string{"ShelterID", "StartTime"}}},
+	},
+	{
+		// wishlists group some of a shelter's items into a drive it can share with donors. The items
+		// in each are in wishlistItems.
+		Name: "wishlists",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "ShelterID", Type: REFERENCE},
+			{Name: "Name", Type: VARCHAR, Size: 100},
+			{Name: "Description", Type: TEXT, Default: "''"},
+			{Name: "GoalDate", Type: VARCHAR, Size: 10},
+			{Name: "CreatedTime", Type: BIGINT},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "ShelterID", Table: "users", OnDelete: "CASCADE"}},
+		Indexes:     []*Index{{Name: "idx_wishlists_shelter", Expressions: []string{"ShelterID", "GoalDate"}}},
+	},
+	{
+		Name: "wishlistItems",
+		Columns: []*Column{
+			{Name: "WishlistID", Type: REFERENCE},
+			{Name: "ItemID", Type: REFERENCE},
+		},
+		Uniques: []*UniqueConstraint{{Name: "idx_wishlist_items_item", Columns: []string{"WishlistID", "ItemID"}}},
+		ForeignKeys: []*ForeignKey{
+			{Column: "WishlistID", Table: "wishlists", OnDelete: "CASCADE"},
+			{Column: "ItemID", Table: "items", OnDelete: "CASCADE"},
+		},
+	},
+	{
+		Name: "userSessions",
+		Columns: []*Column{
+			{Name: "SessionKey", Type: VARCHAR, Size: 50, PrimaryKey: true},
+			{Name: "UserID", Type: REFERENCE},
+			{Name: "UserType", Type: SMALLINT},
+			{Name: "LoginTime", Type: BIGINT},
+			{Name: "LastSeenTime", Type: BIGINT},
+		},
+		ForeignKeys: []*ForeignKey{
+			{Column: "UserID", Table: "users", OnDelete: "CASCADE"},
+			{Column: "UserType", Table: "userTypes", OnDelete: "CASCADE"},
+		},
+	},
+	{
+		Name: "rateLimitHits",
+		Columns: []*Column{
+			{Name: "HitKey", Type: VARCHAR, Size: 200},
+			{Name: "HitTime", Type: BIGINT},
+		},
+		Indexes: []*Index{{Name: "idx_rate_limit_hits_key", Expressions: []string{"HitKey", "HitTime"}}},
+	},
+	{
+		// rateLimitKeys holds a row per key only to lock it while its hits are counted and added.
+		Name: "rateLimitKeys",
+		Columns: []*Column{
+			{Name: "HitKey", Type: VARCHAR, Size: 200, PrimaryKey: true},
+			{Name: "LockedTime", Type: BIGINT},
+		},
+	},
+	{
+		Name: "twoFactorSecrets",
+		Columns: []*Column{
+			{Name: "UserID", Type: REFERENCE, PrimaryKey: true},
+			{Name: "Secret", Type: VARCHAR, Size: 64},
+			{Name: "EnabledTime", Type: BIGINT, Nullable: true},
+			{Name: "LastUsedStep", Type: BIGINT, Default: "0"},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "UserID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		Name: "recoveryCodes",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "UserID", Type: REFERENCE},
+			{Name: "CodeHash", Type: VARCHAR, Size: 64},
+			{Name: "UsedTime", Type: BIGINT, Nullable: true},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "UserID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		Name: "twoFactorChallenges",
+		Columns: []*Column{
+			{Name: "ChallengeKey", Type: VARCHAR, Size: 50, PrimaryKey: true},
+			{Name: "UserID", Type: REFERENCE},
+			{Name: "UserType", Type: SMALLINT},
+			{Name: "CreatedTime", Type: BIGINT},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "UserID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		Name: "apiTokens",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "UserID", Type: REFERENCE},
+			{Name: "Name", Type: VARCHAR, Size: 100},
+			{Name: "TokenHash", Type: VARCHAR, Size: 64, Unique: true},
+			{Name: "Scopes", Type: VARCHAR, Size: 200},
+			{Name: "CreatedTime", Type: BIGINT},
+			{Name: "LastUsedTime", Type: BIGINT, Nullable: true},
+			{Name: "RevokedTime", Type: BIGINT, Nullable: true},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "UserID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		// widgetSettings are each shelter's limits on embedding its open items in other sites.
+		// AllowedOrigins is space separated, and empty allows every site.
+		Name: "widgetSettings",
+		Columns: []*Column{
+			{Name: "ShelterID", Type: REFERENCE, PrimaryKey: true},
+			{Name: "AllowedOrigins", Type: TEXT, Default: "''"},
+			{Name: "RequestsPerMinute", Type: INTEGER},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "ShelterID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		// siteSettings holds the policies administrators can change while the app runs, in a
+		// single row. Flags are 0 or 1.
+		Name: "siteSettings",
+		Columns: []*Column{
+			{Name: "ID", Type: SMALLINT, PrimaryKey: true},
+			{Name: "RequireShelterTwoFactor", Type: SMALLINT, Default: "0"},
+		},
+	},
+	{
+		// feedTokens holds the secret in each user's private feed URL. Users have one at a time.
+		Name: "feedTokens",
+		Columns: []*Column{
+			{Name: "UserID", Type: REFERENCE, PrimaryKey: true},
+			{Name: "TokenHash", Type: VARCHAR, Size: 64, Unique: true},
+			{Name: "CreatedTime", Type: BIGINT},
+		},
+		ForeignKeys: []*ForeignKey{{Column: "UserID", Table: "users", OnDelete: "CASCADE"}},
+	},
+	{
+		// audit_events deliberately has no foreign keys, so events outlive what they describe.
+		Name: "audit_events",
+		Columns: []*Column{
+			{Name: "ID", Type: SERIAL, PrimaryKey: true},
+			{Name: "ActorID", Type: REFERENCE, Nullable: true},
+			{Name: "Session", Type: VARCHAR, Size: 64, Nullable: true},
+			{Name: "IPAddress", Type: VARCHAR, Size: 50, Nullable: true},
+			{Name: "Action", Type: VARCHAR, Size: 50},
+			{Name: "Entity", Type: VARCHAR, Size: 50},
+			{Name: "EntityID", Type: REFERENCE, Nullable: true},
+			{Name: "BeforeState", Type: TEXT, Nullable: true},
+			{Name: "AfterState", Type: TEXT, Nullable: true},
+			{Name: "EventTime", Type: BIGINT},
+		},
+		Indexes: []*Index{
+			{Name: "idx_audit_events_time", Expressions: []string{"EventTime"}},
+			{Name: "idx_audit_events_actor", Expressions: []string{"ActorID", "EventTime"}},
+			{Name: "idx_audit_events_entity", Expressions: []string{"Entity", "EntityID", "EventTime"}},
+		},
+	},
+}
+
+// RenderSchema returns idempotent DDL creating every table, index and seed row in SCHEMA.
+func RenderSchema(dialect Dialect) string {
+	statements := make([]string, 0)
+	for _, table := range SCHEMA {
+		statements = append(statements, renderCreateTable(dialect, table))
+		for _, index := range table.Indexes {
+			statements = append(statements, renderCreateIndex(table, index))
+		}
+	}
+
+	for _, table := range SCHEMA {
+		for _, row := range table.Rows {
+			statements = append(statements, renderInsertRow(dialect, table, row))
+		}
+	}
+	return strings.Join(statements, "\n\n") + "\n"
+}
+
+func renderCreateTable(dialect Dialect, table *Table) string {
+	definitions := make([]string, 0, len(table.Columns))
+	for _, column := range table.Columns {
+		definitions = append(definitions, renderColumn(dialect, column))
+	}
+
+	for _, unique := range table.Uniques {
+		definitions = append(definitions, fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)", unique.Name, strings.Join(unique.Columns, ", ")))
+	}
+
+	for _, foreignKey := range table.ForeignKeys {
+		definitions = append(definitions, fmt.Sprintf("FOREIGN KEY(%s) REFERENCES %s(ID) ON DELETE %s", foreignKey.Column, foreignKey.Table, foreignKey.OnDelete))
+	}
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n    %s\n);", table.Name, strings.Join(definitions, ",\n    "))
+}
+
+func renderColumn(dialect Dialect, column *Column) string {
+	definition := column.Name + " " + renderColumnType(dialect, column)
+	if column.Type == SERIAL {
+		// Both dialects make the serial type the primary key themselves.
+		return definition
+	}
+
+	if column.PrimaryKey {
+		definition += " PRIMARY KEY"
+	} else if column.Nullable {
+		definition += " NULL"
+	} else {
+		definition += " NOT NULL"
+	}
+
+	if column.Unique {
+		definition += " UNIQUE"
+	}
+
+	if column.Default != "" {
+		definition += " DEFAULT " + column.Default
+	}
+	return definition
+}
+
+func renderColumnType(dialect Dialect, column *Column) string {
+	switch column.Type {
+	case SERIAL:
+		if dialect == POSTGRES_DIALECT {
+			return "BIGSERIAL PRIMARY KEY"
+		}
+		return "INTEGER PRIMARY KEY AUTOINCREMENT"
+	case REFERENCE:
+		if dialect == POSTGRES_DIALECT {
+			return "BIGINT"
+		}
+		// SQLite only treats exactly INTEGER as an alias for the row ID.
+		return "INTEGER"
+	case SMALLINT:
+		return "SMALLINT"
+	case INTEGER:
+		return "INTEGER"
+	case BIGINT:
+		return "BIGINT"
+	case VARCHAR:
+		return fmt.Sprintf("VARCHAR(%d)", column.Size)
+	case TEXT:
+		return "TEXT"
+	default:
+		panic(fmt.Sprintf("unknown column type %v for %s", column.Type, column.Name))
+	}
+}
+
+func renderCreateIndex(table *Table, index *Index) string {
+	unique := ""
+	if index.Unique {
+		unique = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX IF NOT EXISTS %s ON %s (%s);", unique, index.Name, table.Name, strings.Join(index.Expressions, ", "))
+}
+
+func renderInsertRow(dialect Dialect, table *Table, row []interface{}) string {
+	columns := make([]string, len(row))
+	values := make([]string, len(row))
+	for i, value := range row {
+		columns[i] = table.Columns[i].Name
+		values[i] = renderLiteral(value)
+	}
+
+	insert := fmt.Sprintf("INTO %s (%s) VALUES (%s)", table.Name, strings.Join(columns, ", "), strings.Join(values, ", "))
+	if dialect == POSTGRES_DIALECT {
+		return "INSERT " + insert + " ON CONFLICT DO NOTHING;"
+	}
+	return "INSERT OR IGNORE " + insert + ";"
+}
+
+func renderLiteral(value interface{}) string {
+	if text, ok := value.(string); ok {
+		return "'" + strings.Replace(text, "'", "''", -1) + "'"
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,184 @@
+package database
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var createTablePattern = regexp.MustCompile(`(?s)CREATE TABLE IF NOT EXISTS (\w+) \((.*?)\n\);`)
+
+type columnDescription struct {
+	Name     string
+	Nullable bool
+}
+
+func initFileDatabase(t *testing.T) (*sql.DB, func()) {
+	directory, err := ioutil.TempDir("", "neighbors")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &dbConfig{Driver: "sqlite3", Host: BuildSQLiteFileHost(filepath.Join(directory, "neighbors.db"))}
+	db := InitDatabase(config)
+	return db, func() {
+		db.Close()
+		os.RemoveAll(directory)
+	}
+}
+
+func TestFileBackedSQLiteUsesWALAndForeignKeys(t *testing.T) {
+	db, cleanup := initFileDatabase(t)
+	defer cleanup()
+
+	var journalMode string
+	var foreignKeys int
+	db.QueryRow("PRAGMA journal_mode").Scan(&journalMode)
+	db.QueryRow("PRAGMA foreign_keys").Scan(&foreignKeys)
+	if journalMode != "wal" || foreignKeys != 1 {
+		t.Errorf("Expected WAL with foreign keys, got journal mode %v and foreign keys %v", journalMode, foreignKeys)
+	}
+
+	_, err := db.Exec("INSERT INTO items (Category, Gender, Quantity, Size, Status, ShelterID) VALUES ('a', 'b', 1, 'c', 1, 12345)")
+	if err == nil {
+		t.Error("Expected an item for a missing shelter to violate its foreign key")
+	}
+}
+
+func TestSchemaCanBeAppliedRepeatedly(t *testing.T) {
+	db, cleanup := initFileDatabase(t)
+	defer cleanup()
+
+	if _, err := db.Exec(RenderSchema(SQLITE_DIALECT)); err != nil {
+		t.Fatal(err)
+	}
+
+	var userTypes int
+	db.QueryRow("SELECT COUNT(*) FROM userTypes").Scan(&userTypes)
+	if userTypes != 3 {
+		t.Errorf("Expected seed rows to be inserted once, got %v", userTypes)
+	}
+}
+
+func TestSQLiteTablesMatchSchema(t *testing.T) {
+	db, cleanup := initFileDatabase(t)
+	defer cleanup()
+
+	for _, table := range SCHEMA {
+		actual := describeSQLiteTable(t, db, table.Name)
+		if !reflect.DeepEqual(actual, expectedColumns(table)) {
+			t.Errorf("Expected %v to have columns %v, got %v", table.Name, expectedColumns(table), actual)
+		}
+	}
+}
+
+func TestDialectsDefineTheSameTables(t *testing.T) {
+	sqliteTables := parseRenderedTables(RenderSchema(SQLITE_DIALECT))
+	postgresTables := parseRenderedTables(RenderSchema(POSTGRES_DIALECT))
+
+	if len(sqliteTables) != len(SCHEMA) || !reflect.DeepEqual(sqliteTables, postgresTables) {
+		t.Errorf("Expected both dialects to define the same tables, got %v and %v", sqliteTables, postgresTables)
+	}
+
+	postgres := RenderSchema(POSTGRES_DIALECT)
+	if strings.Contains(postgres, "AUTOINCREMENT") || strings.Contains(postgres, "\"") || strings.Contains(postgres, "OR IGNORE") {
+		t.Errorf("Expected Postgres schema to be free of SQLite syntax:\n%v", postgres)
+	}
+}
+
+// TestPostgresTablesMatchSQLite applies both schemas to real databases and compares them. It only
+// runs when NEIGHBORS_TEST_POSTGRES_URL points at a disposable Postgres database.
+func TestPostgresTablesMatchSQLite(t *testing.T) {
+	postgresURL, found := os.LookupEnv("NEIGHBORS_TEST_POSTGRES_URL")
+	if !found {
+		t.Skip("NEIGHBORS_TEST_POSTGRES_URL is not set")
+	}
+
+	sqliteDB, cleanup := initFileDatabase(t)
+	defer cleanup()
+	postgresDB := InitDatabase(&dbConfig{Driver: "postgres", Host: postgresURL, DevelopmentMode: true})
+	defer postgresDB.Close()
+
+	for _, table := range SCHEMA {
+		sqliteColumns := describeSQLiteTable(t, sqliteDB, table.Name)
+		postgresColumns := describePostgresTable(t, postgresDB, table.Name)
+		for i := range sqliteColumns {
+			sqliteColumns[i].Name = strings.ToLower(sqliteColumns[i].Name)
+		}
+
+		if !reflect.DeepEqual(sqliteColumns, postgresColumns) {
+			t.Errorf("Expected %v to match across dialects, got %v and %v", table.Name, sqliteColumns, postgresColumns)
+		}
+	}
+}
+
+func expectedColumns(table *Table) []columnDescription {
+	columns := make([]columnDescription, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[i] = columnDescription{Name: column.Name, Nullable: column.Nullable && !column.PrimaryKey}
+	}
+	return columns
+}
+
+func describeSQLiteTable(t *testing.T, db *sql.DB, table string) []columnDescription {
+	rows, err := db.Query("PRAGMA table_info(" + table + ")")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	columns := make([]columnDescription, 0)
+	for rows.Next() {
+		var id int
+		var name string
+		var columnType string
+		var notNull bool
+		var defaultValue interface{}
+		var primaryKey bool
+		if err := rows.Scan(&id, &name, &columnType, &notNull, &defaultValue, &primaryKey); err != nil {
+			t.Fatal(err)
+		}
+		columns = append(columns, columnDescription{Name: name, Nullable: !notNull && !primaryKey})
+	}
+	return columns
+}
+
+func describePostgresTable(t *testing.T, db *sql.DB, table string) []columnDescription {
+	query := "SELECT column_name, is_nullable FROM information_schema.columns WHERE table_name = $1 ORDER BY ordinal_position"
+	rows, err := db.Query(query, strings.ToLower(table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	columns := make([]columnDescription, 0)
+	for rows.Next() {
+		var name string
+		var nullable string
+		if err := rows.Scan(&name, &nullable); err != nil {
+			t.Fatal(err)
+		}
+		columns = append(columns, columnDescription{Name: name, Nullable: nullable == "YES"})
+	}
+	return columns
+}
+
+func parseRenderedTables(schema string) map[string][]string {
+	tables := make(map[string][]string)
+	for _, match := range createTablePattern.FindAllStringSubmatch(schema, -1) {
+		columns := make([]string, 0)
+		for _, definition := range strings.Split(match[2], ",\n") {
+			name := strings.Fields(definition)[0]
+			if name != "CONSTRAINT" && !strings.HasPrefix(name, "FOREIGN") {
+				columns = append(columns, name)
+			}
+		}
+		tables[match[1]] = columns
+	}
+	return tables
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+)
+
+// queryRunner is what *sql.DB and *sql.Tx have in common, so queries run the same way in and out
+// of a transaction.
+type queryRunner interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func queryRunnerFor(db *sql.DB, tx *sql.Tx) queryRunner {
+	if tx != nil {
+		return tx
+	}
+	return db
+}
+
+// runTransaction commits the transaction if work succeeds, and rolls it back if it fails or panics.
+func runTransaction(ctx context.Context, db *sql.DB, work func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			tx.Rollback()
+			panic(recovered)
+		}
+	}()
+
+	if err := work(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestTransactionsRollBackWhenTheirWorkFails(t *testing.T) {
+	datasource := StandardDatasource{Database: InitDatabase(SQLITE3)}
+	defer datasource.Database.Close()
+	insert := Insert("rateLimitHits", "HitKey", "HitTime")
+	count := Select("rateLimitHits", "COUNT(*)").Where("HitKey = ?")
+	ctx := context.Background()
+
+	failure := errors.New("second insert failed")
+	err := datasource.Transaction(ctx, func(tx Datasource) error {
+		if _, err := tx.ExecuteWriteQuery(ctx, insert, []interface{}{"transaction-test", 1}); err != nil {
+			return err
+		}
+		return failure
+	})
+	if err != failure {
+		t.Errorf("Expected the work's error, got %v", err)
+	}
+
+	var attempts int
+	datasource.ExecuteSingleReadQuery(ctx, count, []interface{}{"transaction-test"}).Scan(&attempts)
+	if attempts != 0 {
+		t.Errorf("Expected the insert to be rolled back, got %d rows", attempts)
+	}
+
+	err = datasource.Transaction(ctx, func(tx Datasource) error {
+		_, err := tx.ExecuteWriteQuery(ctx, insert, []interface{}{"transaction-test", 1})
+		return err
+	})
+	datasource.ExecuteSingleReadQuery(ctx, count, []interface{}{"transaction-test"}).Scan(&attempts)
+	if err != nil || attempts != 1 {
+		t.Errorf("Expected the insert to be committed, got %d rows: %v", attempts, err)
+	}
+}
@@ -1,8 +1,11 @@
 package email
 
 import (
-	"strconv"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/kwhite17/Neighbors/pkg/ical"
 	"github.com/kwhite17/Neighbors/pkg/managers"
 	"github.com/kwhite17/Neighbors/pkg/retrievers"
 )
@@ -23,6 +26,37 @@ type PasswordReset struct {
 	TempPassword string
 }
 
+type AccountLockout struct {
+	Recipient   *managers.User
+	LockedUntil time.Time
+}
+
+func BuildAccountLockout(recipient *managers.User, lockedUntil time.Time) *AccountLockout {
+	return &AccountLockout{Recipient: recipient, LockedUntil: lockedUntil}
+}
+
+type AccountClosure struct {
+	Recipient    *managers.User
+	DeletionTime time.Time
+}
+
+func BuildAccountClosure(recipient *managers.User, deletionTime time.Time) *AccountClosure {
+	return &AccountClosure{Recipient: recipient, DeletionTime: deletionTime}
+}
+
+// DropOffNotice tells both sides of a claim when the item is to be dropped off.
+type DropOffNotice struct {
+	Item      *managers.Item
+	DropOff   *managers.DropOff
+	Schedule  *managers.IntakeSchedule
+	Shelter   *managers.User
+	Samaritan *managers.User
+}
+
+func BuildDropOffNotice(item *managers.Item, dropOff *managers.DropOff, schedule *managers.IntakeSchedule, shelter *managers.User, samaritan *managers.User) *DropOffNotice {
+	return &DropOffNotice{Item: item, DropOff: dropOff, Schedule: schedule, Shelter: shelter, Samaritan: samaritan}
+}
+
 func BuildPasswordReset(recipient *managers.User, tempPassword string) *PasswordReset {
 	return &PasswordReset{Recipient: recipient, TempPassword: tempPassword}
 }
@@ -37,8 +71,8 @@ func BuildItemUpdate(previousItem *managers.Item, updatedItem *managers.Item, re
 		itemUpdate.GenderUpdate = previousItem.Gender + " -> " + updatedItem.Gender
 	}
 
-	if previousItem.Quantity != updatedItem.Quantity {
-		itemUpdate.QuantityUpdate = strconv.Itoa(int(previousItem.Quantity)) + " -> " + strconv.Itoa(int(updatedItem.Quantity))
+	if previousItem.Quantity != updatedItem.Quantity || previousItem.Unit != updatedItem.Unit {
+		itemUpdate.QuantityUpdate = previousItem.FormattedQuantity() + " -> " + updatedItem.FormattedQuantity()
 	}
 
 	if previousItem.Size != updatedItem.Size {
@@ -56,7 +90,7 @@ func BuildItemUpdate(previousItem *managers.Item, updatedItem *managers.Item, re
 }
 
 func formatEmailBody(itemUpdate *ItemUpdate) string {
-	emailBody := "Updates on current request for: " + strconv.Itoa(int(itemUpdate.PreviousItem.Quantity)) +
+	emailBody := "Updates on current request for: " + itemUpdate.PreviousItem.FormattedQuantity() +
 		" " + itemUpdate.PreviousItem.Category + "\n"
 	if itemUpdate.CategoryUpdate != "" {
 		emailBody = emailBody + "Category: " + itemUpdate.CategoryUpdate + "\n"
@@ -81,3 +115,51 @@ func formatPasswordResetEmailBody(passwordReset *PasswordReset) string {
 	return "Hello " + passwordReset.Recipient.Name + ",\n\n" + "We've reset your password as requested to: " + passwordReset.TempPassword + ". " +
 		"We recommend you change this as soon as possible. Have a nice day!"
 }
+
+func formatAccountClosureEmailBody(accountClosure *AccountClosure) string {
+	return "Hello " + accountClosure.Recipient.Name + ",\n\n" + "Your Neighbors account has been closed. Your personal information will be permanently deleted on " +
+		accountClosure.DeletionTime.UTC().Format(time.RFC1123) + ". If you change your mind, log in before then to reopen your account."
+}
+
+func formatAccountLockoutEmailBody(accountLockout *AccountLockout) string {
+	return "Hello " + accountLockout.Recipient.Name + ",\n\n" + "We noticed several failed attempts to log in to your account, so we've locked it until " +
+		accountLockout.LockedUntil.UTC().Format(time.RFC1123) + ". If this wasn't you, we recommend resetting your password once the lock expires."
+}
+
+// formatDropOffTime writes the slot in the shelter's time zone, which is where the drop-off happens.
+func formatDropOffTime(dropOff *managers.DropOff, schedule *managers.IntakeSchedule) string {
+	slot := dropOff.In(schedule)
+	return slot.Start.Format("Monday, January 2 from 3:04 PM") + " to " + slot.End.Format("3:04 PM MST")
+}
+
+func formatShelterAddress(shelter *managers.User) string {
+	return fmt.Sprintf("%s, %s, %s %s", shelter.Street, shelter.City, shelter.State, shelter.PostalCode)
+}
+
+func formatDropOffEmailBody(dropOffNotice *DropOffNotice, recipient *managers.User) string {
+	item := dropOffNotice.Item
+	emailBody := "Hello " + recipient.Name + ",\n\n" + dropOffNotice.Samaritan.Name + " will drop off " + item.FormattedQuantity() + " " + item.Category +
+		" at " + dropOffNotice.Shelter.Name + " on " + formatDropOffTime(dropOffNotice.DropOff, dropOffNotice.Schedule) + ".\n\n" +
+		"Address: " + formatShelterAddress(dropOffNotice.Shelter) + "\n"
+	if instructions := strings.TrimSpace(dropOffNotice.Schedule.Instructions); instructions != "" {
+		emailBody = emailBody + "Drop-off instructions: " + instructions + "\n"
+	}
+	return emailBody + "\nThe attached invitation adds the drop-off to your calendar."
+}
+
+// buildDropOffCalendar builds the invitation attached to drop-off emails. Its UID stays the same
+// for the item, so calendars replace the event when the drop-off is moved.
+func buildDropOffCalendar(dropOffNotice *DropOffNotice) []byte {
+	item := dropOffNotice.Item
+	event := &ical.Event{
+		UID:         fmt.Sprintf("dropoff-%d@neighbors", item.ID),
+		Start:       time.Unix(dropOffNotice.DropOff.StartTime, 0),
+		End:         time.Unix(dropOffNotice.DropOff.EndTime, 0),
+		Summary:     "Drop off " + item.FormattedQuantity() + " " + item.Category + " at " + dropOffNotice.Shelter.Name,
+		Description: dropOffNotice.Schedule.Instructions,
+		Location:    formatShelterAddress(dropOffNotice.Shelter),
+		Stamp:       time.Now(),
+	}
+	calendar := &ical.Calendar{Name: "Neighbors drop-offs", Events: []*ical.Event{event}}
+	return calendar.Bytes()
+}
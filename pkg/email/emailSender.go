@@ -2,31 +2,55 @@ package email
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
-	"log"
+	"io"
+	"time"
 
 	"github.com/sendgrid/sendgrid-go"
 	"github.com/sendgrid/sendgrid-go/helpers/mail"
 
+	"github.com/kwhite17/Neighbors/pkg/ical"
+	"github.com/kwhite17/Neighbors/pkg/logging"
 	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/metrics"
 	"gopkg.in/gomail.v2"
 )
 
-const SENDGRID_SENDER_EMAIL = "neighbors@massally.org"
+// Senders are named in metrics by the way they send email.
+const (
+	SMTP_SENDER     = "smtp"
+	SENDGRID_SENDER = "sendgrid"
+)
+
+const SENDGRID_SCOPES_URL = "https://api.sendgrid.com/v3/scopes"
+
+const DROP_OFF_INVITATION_NAME = "drop-off.ics"
 
 type EmailSender interface {
 	DeliverEmail(ctx context.Context, previousItem *managers.Item, currentItem *managers.Item, userSession *managers.UserSession) error
 	DeliverPasswordResetEmail(ctx context.Context, user *managers.User, temporaryPassword string) error
+	DeliverAccountLockoutEmail(ctx context.Context, user *managers.User, lockedUntil time.Time) error
+	DeliverAccountClosureEmail(ctx context.Context, user *managers.User, deletionTime time.Time) error
+	// DeliverDropOffEmail tells the item's shelter and samaritan when it's to be dropped off.
+	DeliverDropOffEmail(ctx context.Context, item *managers.Item, dropOff *managers.DropOff, schedule *managers.IntakeSchedule) error
+	// Ping checks that email can be handed off, without sending any.
+	Ping(ctx context.Context) error
 }
 
+// LocalSender sends email through an SMTP server, such as a mail catcher during development.
 type LocalSender struct {
-	Dialer      *gomail.Dialer
-	UserManager *managers.UserManager
+	Dialer        *gomail.Dialer
+	UserManager   *managers.UserManager
+	SenderAddress string
+	SenderName    string
 }
 
 type SendGridSender struct {
-	Client      *sendgrid.Client
-	UserManager *managers.UserManager
+	Client        *sendgrid.Client
+	UserManager   *managers.UserManager
+	SenderAddress string
+	SenderName    string
 }
 
 func (ls *LocalSender) DeliverEmail(ctx context.Context, previousItem *managers.Item, currentItem *managers.Item, userSession *managers.UserSession) error {
@@ -53,19 +77,18 @@ func (ls *LocalSender) DeliverEmail(ctx context.Context, previousItem *managers.
 		return err
 	}
 
-	updater.Email = "kwhite@hubspot.com"
 	itemUpdate := BuildItemUpdate(previousItem, currentItem, recipient, updater)
 	return ls.sendEmail(itemUpdate)
 }
 
 func (ls *LocalSender) sendEmail(itemUpdate *ItemUpdate) error {
 	m := gomail.NewMessage()
-	m.SetAddressHeader("From", itemUpdate.Updater.Email, "kwhite")
+	m.SetAddressHeader("From", ls.SenderAddress, itemUpdate.Updater.Name+" ("+itemUpdate.Updater.Email+") via "+ls.SenderName)
 	m.SetAddressHeader("To", itemUpdate.Recipient.Email, itemUpdate.Recipient.Name)
 	m.SetHeader("Subject", "Item Updated by "+itemUpdate.Updater.Email+"!")
 	m.SetBody("text/plain", formatEmailBody(itemUpdate))
 
-	return ls.Dialer.DialAndSend(m)
+	return ls.send("item_update", m)
 }
 
 func (ls *LocalSender) DeliverPasswordResetEmail(ctx context.Context, recipient *managers.User, temporaryPassword string) error {
@@ -75,12 +98,59 @@ func (ls *LocalSender) DeliverPasswordResetEmail(ctx context.Context, recipient
 
 func (ls *LocalSender) sendPasswordResetEmail(passwordReset *PasswordReset) error {
 	m := gomail.NewMessage()
-	m.SetAddressHeader("From", "kwhite@hubspot.com", "kwhite")
+	m.SetAddressHeader("From", ls.SenderAddress, ls.SenderName)
 	m.SetAddressHeader("To", passwordReset.Recipient.Email, passwordReset.Recipient.Name)
 	m.SetHeader("Subject", "Neighbors Password Reset")
 	m.SetBody("text/plain", formatPasswordResetEmailBody(passwordReset))
 
-	return ls.Dialer.DialAndSend(m)
+	return ls.send("password_reset", m)
+}
+
+func (ls *LocalSender) DeliverAccountLockoutEmail(ctx context.Context, recipient *managers.User, lockedUntil time.Time) error {
+	accountLockout := BuildAccountLockout(recipient, lockedUntil)
+	m := gomail.NewMessage()
+	m.SetAddressHeader("From", ls.SenderAddress, ls.SenderName)
+	m.SetAddressHeader("To", accountLockout.Recipient.Email, accountLockout.Recipient.Name)
+	m.SetHeader("Subject", "Neighbors Account Locked")
+	m.SetBody("text/plain", formatAccountLockoutEmailBody(accountLockout))
+
+	return ls.send("account_lockout", m)
+}
+
+func (ls *LocalSender) DeliverAccountClosureEmail(ctx context.Context, recipient *managers.User, deletionTime time.Time) error {
+	accountClosure := BuildAccountClosure(recipient, deletionTime)
+	m := gomail.NewMessage()
+	m.SetAddressHeader("From", ls.SenderAddress, ls.SenderName)
+	m.SetAddressHeader("To", accountClosure.Recipient.Email, accountClosure.Recipient.Name)
+	m.SetHeader("Subject", "Neighbors Account Closed")
+	m.SetBody("text/plain", formatAccountClosureEmailBody(accountClosure))
+
+	return ls.send("account_closure", m)
+}
+
+func (ls *LocalSender) DeliverDropOffEmail(ctx context.Context, item *managers.Item, dropOff *managers.DropOff, schedule *managers.IntakeSchedule) error {
+	dropOffNotice, err := buildDropOffNotice(ctx, ls.UserManager, item, dropOff, schedule)
+	if err != nil {
+		return err
+	}
+
+	invitation := buildDropOffCalendar(dropOffNotice)
+	for _, recipient := range []*managers.User{dropOffNotice.Shelter, dropOffNotice.Samaritan} {
+		m := gomail.NewMessage()
+		m.SetAddressHeader("From", ls.SenderAddress, ls.SenderName)
+		m.SetAddressHeader("To", recipient.Email, recipient.Name)
+		m.SetHeader("Subject", "Drop-off Scheduled for "+formatDropOffTime(dropOff, schedule))
+		m.SetBody("text/plain", formatDropOffEmailBody(dropOffNotice, recipient))
+		m.Attach(DROP_OFF_INVITATION_NAME, gomail.SetHeader(map[string][]string{"Content-Type": {ical.CONTENT_TYPE}}), gomail.SetCopyFunc(func(w io.Writer) error {
+			_, err := w.Write(invitation)
+			return err
+		}))
+
+		if err := ls.send("drop_off", m); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (ss *SendGridSender) DeliverEmail(ctx context.Context, previousItem *managers.Item, currentItem *managers.Item, userSession *managers.UserSession) error {
@@ -108,36 +178,132 @@ func (ss *SendGridSender) DeliverEmail(ctx context.Context, previousItem *manage
 	}
 
 	itemUpdate := BuildItemUpdate(previousItem, currentItem, recipient, updater)
-	return ss.sendEmail(itemUpdate)
+	return ss.sendEmail(ctx, itemUpdate)
 }
 
 func (ss *SendGridSender) DeliverPasswordResetEmail(ctx context.Context, recipient *managers.User, temporaryPassword string) error {
 	passwordReset := BuildPasswordReset(recipient, temporaryPassword)
-	return ss.sendPasswordResetEmail(passwordReset)
+	return ss.sendPasswordResetEmail(ctx, passwordReset)
 }
 
-func (ss *SendGridSender) sendPasswordResetEmail(passwordReset *PasswordReset) error {
-	from := mail.NewEmail("Neighbors", SENDGRID_SENDER_EMAIL)
+func (ss *SendGridSender) sendPasswordResetEmail(ctx context.Context, passwordReset *PasswordReset) error {
+	from := mail.NewEmail(ss.SenderName, ss.SenderAddress)
 	to := mail.NewEmail(passwordReset.Recipient.Name, passwordReset.Recipient.Email)
 	plainTextContent := formatPasswordResetEmailBody(passwordReset)
 	htmlContent := "<div>" + plainTextContent + "</div>"
 	message := mail.NewSingleEmail(from, "Neighbors Password Reset", to, plainTextContent, htmlContent)
-	response, err := ss.Client.Send(message)
-	if response.StatusCode > 299 {
-		log.Println(response)
+	return ss.send(ctx, "password_reset", message)
+}
+
+func (ss *SendGridSender) DeliverAccountLockoutEmail(ctx context.Context, recipient *managers.User, lockedUntil time.Time) error {
+	accountLockout := BuildAccountLockout(recipient, lockedUntil)
+	from := mail.NewEmail(ss.SenderName, ss.SenderAddress)
+	to := mail.NewEmail(accountLockout.Recipient.Name, accountLockout.Recipient.Email)
+	plainTextContent := formatAccountLockoutEmailBody(accountLockout)
+	htmlContent := "<div>" + plainTextContent + "</div>"
+	message := mail.NewSingleEmail(from, "Neighbors Account Locked", to, plainTextContent, htmlContent)
+	return ss.send(ctx, "account_lockout", message)
+}
+
+func (ss *SendGridSender) DeliverAccountClosureEmail(ctx context.Context, recipient *managers.User, deletionTime time.Time) error {
+	accountClosure := BuildAccountClosure(recipient, deletionTime)
+	from := mail.NewEmail(ss.SenderName, ss.SenderAddress)
+	to := mail.NewEmail(accountClosure.Recipient.Name, accountClosure.Recipient.Email)
+	plainTextContent := formatAccountClosureEmailBody(accountClosure)
+	htmlContent := "<div>" + plainTextContent + "</div>"
+	message := mail.NewSingleEmail(from, "Neighbors Account Closed", to, plainTextContent, htmlContent)
+	return ss.send(ctx, "account_closure", message)
+}
+
+func (ss *SendGridSender) DeliverDropOffEmail(ctx context.Context, item *managers.Item, dropOff *managers.DropOff, schedule *managers.IntakeSchedule) error {
+	dropOffNotice, err := buildDropOffNotice(ctx, ss.UserManager, item, dropOff, schedule)
+	if err != nil {
+		return err
 	}
-	return err
+
+	invitation := mail.NewAttachment()
+	invitation.SetContent(base64.StdEncoding.EncodeToString(buildDropOffCalendar(dropOffNotice)))
+	invitation.SetType(ical.CONTENT_TYPE)
+	invitation.SetFilename(DROP_OFF_INVITATION_NAME)
+	invitation.SetDisposition("attachment")
+	for _, recipient := range []*managers.User{dropOffNotice.Shelter, dropOffNotice.Samaritan} {
+		from := mail.NewEmail(ss.SenderName, ss.SenderAddress)
+		to := mail.NewEmail(recipient.Name, recipient.Email)
+		plainTextContent := formatDropOffEmailBody(dropOffNotice, recipient)
+		htmlContent := "<div>" + plainTextContent + "</div>"
+		message := mail.NewSingleEmail(from, "Drop-off Scheduled for "+formatDropOffTime(dropOff, schedule), to, plainTextContent, htmlContent)
+		message.AddAttachment(invitation)
+		if err := ss.send(ctx, "drop_off", message); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (ss *SendGridSender) sendEmail(itemUpdate *ItemUpdate) error {
-	from := mail.NewEmail(itemUpdate.Updater.Name+" ("+itemUpdate.Updater.Email+") via "+SENDGRID_SENDER_EMAIL, SENDGRID_SENDER_EMAIL)
+func (ss *SendGridSender) sendEmail(ctx context.Context, itemUpdate *ItemUpdate) error {
+	from := mail.NewEmail(itemUpdate.Updater.Name+" ("+itemUpdate.Updater.Email+") via "+ss.SenderName, ss.SenderAddress)
 	to := mail.NewEmail(itemUpdate.Recipient.Name, itemUpdate.Recipient.Email)
 	plainTextContent := formatEmailBody(itemUpdate)
 	htmlContent := "<div>" + plainTextContent + "</div>"
 	message := mail.NewSingleEmail(from, "Item Updated by "+itemUpdate.Updater.Name+"!", to, plainTextContent, htmlContent)
+	return ss.send(ctx, "item_update", message)
+}
+
+func buildDropOffNotice(ctx context.Context, userManager *managers.UserManager, item *managers.Item, dropOff *managers.DropOff, schedule *managers.IntakeSchedule) (*DropOffNotice, error) {
+	shelter, err := userManager.GetUser(ctx, item.ShelterID)
+	if err != nil {
+		return nil, err
+	}
+
+	samaritan, err := userManager.GetUser(ctx, dropOff.SamaritanID)
+	if err != nil {
+		return nil, err
+	}
+	return BuildDropOffNotice(item, dropOff, schedule, shelter, samaritan), nil
+}
+
+func (ls *LocalSender) send(kind string, m *gomail.Message) error {
+	err := ls.Dialer.DialAndSend(m)
+	metrics.RecordEmail(SMTP_SENDER, kind, err)
+	return err
+}
+
+// send logs emails SendGrid refuses and counts them as failures, but doesn't return an error for
+// them, since retrying wouldn't help.
+func (ss *SendGridSender) send(ctx context.Context, kind string, message *mail.SGMailV3) error {
 	response, err := ss.Client.Send(message)
-	if response.StatusCode > 299 {
-		log.Println(response)
+	if err == nil && response.StatusCode > 299 {
+		logging.FromContext(ctx).Warn("SendGrid refused email", logging.Fields{"kind": kind, "status": response.StatusCode, "body": response.Body})
+		metrics.RecordEmail(SENDGRID_SENDER, kind, fmt.Errorf("SendGrid returned %d", response.StatusCode))
+		return nil
 	}
+	metrics.RecordEmail(SENDGRID_SENDER, kind, err)
 	return err
 }
+
+// Ping connects to the SMTP server and hangs up.
+func (ls *LocalSender) Ping(ctx context.Context) error {
+	closer, err := ls.Dialer.Dial()
+	if err != nil {
+		return err
+	}
+	return closer.Close()
+}
+
+// Ping asks SendGrid which scopes the API key has, which fails if SendGrid can't be reached or
+// the key has been revoked.
+func (ss *SendGridSender) Ping(ctx context.Context) error {
+	request := ss.Client.Request
+	request.Method = "GET"
+	request.BaseURL = SENDGRID_SCOPES_URL
+	request.Body = nil
+	response, err := sendgrid.API(request)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode > 299 {
+		return fmt.Errorf("SendGrid returned %d", response.StatusCode)
+	}
+	return nil
+}
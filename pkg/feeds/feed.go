@@ -0,0 +1,154 @@
+// Package feeds writes syndication feeds, in both Atom (RFC 4287) and RSS 2.0, so feed readers
+// that only understand one of them can subscribe.
+package feeds
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+const ATOM_CONTENT_TYPE = "application/atom+xml; charset=utf-8"
+const RSS_CONTENT_TYPE = "application/rss+xml; charset=utf-8"
+
+const ATOM_NAMESPACE = "http://www.w3.org/2005/Atom"
+
+type Feed struct {
+	// ID identifies the feed for good, even if it moves. It's usually the feed's own URL.
+	ID          string
+	Title       string
+	Description string
+	// Link is the page the feed follows, and Self is where the feed itself is served.
+	Link    string
+	Self    string
+	Author  string
+	Updated time.Time
+	Entries []*Entry
+}
+
+type Entry struct {
+	ID        string
+	Title     string
+	Link      string
+	Summary   string
+	Published time.Time
+	Updated   time.Time
+}
+
+type atomFeed struct {
+	XMLName xml.Name     `xml:"feed"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Summary string       `xml:"subtitle,omitempty"`
+	Updated string       `xml:"updated"`
+	Links   []*atomLink  `xml:"link"`
+	Author  *atomAuthor  `xml:"author"`
+	Entries []*atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string    `xml:"id"`
+	Title     string    `xml:"title"`
+	Link      *atomLink `xml:"link"`
+	Published string    `xml:"published"`
+	Updated   string    `xml:"updated"`
+	Summary   string    `xml:"summary,omitempty"`
+}
+
+type rssFeed struct {
+	XMLName   xml.Name    `xml:"rss"`
+	Version   string      `xml:"version,attr"`
+	AtomXmlns string      `xml:"xmlns:atom,attr"`
+	Channel   *rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	LastBuildDate string     `xml:"lastBuildDate"`
+	Self          *atomLink  `xml:"atom:link"`
+	Items         []*rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        *rssGUID `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// WriteAtom writes the feed as an Atom document.
+func (feed *Feed) WriteAtom(w io.Writer) error {
+	document := &atomFeed{
+		Xmlns:   ATOM_NAMESPACE,
+		ID:      feed.ID,
+		Title:   feed.Title,
+		Summary: feed.Description,
+		Updated: feed.Updated.UTC().Format(time.RFC3339),
+		Links:   []*atomLink{{Rel: "self", Type: "application/atom+xml", Href: feed.Self}, {Rel: "alternate", Type: "text/html", Href: feed.Link}},
+		Author:  &atomAuthor{Name: feed.Author},
+		Entries: make([]*atomEntry, len(feed.Entries)),
+	}
+	for i, entry := range feed.Entries {
+		document.Entries[i] = &atomEntry{
+			ID:        entry.ID,
+			Title:     entry.Title,
+			Link:      &atomLink{Rel: "alternate", Type: "text/html", Href: entry.Link},
+			Published: entry.Published.UTC().Format(time.RFC3339),
+			Updated:   entry.Updated.UTC().Format(time.RFC3339),
+			Summary:   entry.Summary,
+		}
+	}
+	return writeXML(w, document)
+}
+
+// WriteRSS writes the feed as an RSS 2.0 document. Entry IDs become guids, which RSS readers
+// expect to be links.
+func (feed *Feed) WriteRSS(w io.Writer) error {
+	channel := &rssChannel{
+		Title:         feed.Title,
+		Link:          feed.Link,
+		Description:   feed.Description,
+		LastBuildDate: feed.Updated.UTC().Format(time.RFC1123Z),
+		Self:          &atomLink{Rel: "self", Type: "application/rss+xml", Href: feed.Self},
+		Items:         make([]*rssItem, len(feed.Entries)),
+	}
+	for i, entry := range feed.Entries {
+		channel.Items[i] = &rssItem{
+			Title:       entry.Title,
+			Link:        entry.Link,
+			GUID:        &rssGUID{IsPermaLink: entry.ID == entry.Link, Value: entry.ID},
+			PubDate:     entry.Published.UTC().Format(time.RFC1123Z),
+			Description: entry.Summary,
+		}
+	}
+	return writeXML(w, &rssFeed{Version: "2.0", AtomXmlns: ATOM_NAMESPACE, Channel: channel})
+}
+
+func writeXML(w io.Writer, document interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(document)
+}
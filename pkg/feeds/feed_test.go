@@ -0,0 +1,71 @@
+package feeds
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func generateFeed() *Feed {
+	published := time.Date(2026, 10, 19, 14, 30, 0, 0, time.FixedZone("EDT", -4*60*60))
+	return &Feed{
+		ID:          "https://neighbors.example/feeds/items.atom",
+		Title:       "Open requests",
+		Description: "Items shelters need",
+		Link:        "https://neighbors.example/items/",
+		Self:        "https://neighbors.example/feeds/items.atom",
+		Author:      "Neighbors",
+		Updated:     published,
+		Entries: []*Entry{{
+			ID:        "https://neighbors.example/items/1",
+			Title:     "2 pairs of SOCKS & more",
+			Link:      "https://neighbors.example/items/1",
+			Summary:   "Size M <unisex>",
+			Published: published,
+			Updated:   published,
+		}},
+	}
+}
+
+func TestItWritesAtom(t *testing.T) {
+	encoded := &bytes.Buffer{}
+	if err := generateFeed().WriteAtom(encoded); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := &atomFeed{}
+	if err := xml.Unmarshal(encoded.Bytes(), decoded); err != nil {
+		t.Fatalf("Expected well-formed XML, got %v: %s", err, encoded)
+	}
+
+	if decoded.XMLName.Space != ATOM_NAMESPACE || decoded.Updated != "2026-10-19T18:30:00Z" || len(decoded.Links) != 2 || decoded.Author.Name != "Neighbors" {
+		t.Errorf("Expected an Atom feed updated in UTC, got %+v", decoded)
+	}
+
+	if len(decoded.Entries) != 1 || decoded.Entries[0].Title != "2 pairs of SOCKS & more" || decoded.Entries[0].Summary != "Size M <unisex>" || decoded.Entries[0].Link.Href != "https://neighbors.example/items/1" {
+		t.Errorf("Expected the entry to survive escaping, got %+v", decoded.Entries)
+	}
+}
+
+func TestItWritesRSS(t *testing.T) {
+	encoded := &bytes.Buffer{}
+	if err := generateFeed().WriteRSS(encoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(encoded.String(), `<atom:link rel="self" type="application/rss+xml" href="https://neighbors.example/feeds/items.atom">`) {
+		t.Errorf("Expected the feed to link to itself, got %s", encoded)
+	}
+
+	decoded := &rssFeed{}
+	if err := xml.Unmarshal(encoded.Bytes(), decoded); err != nil {
+		t.Fatalf("Expected well-formed XML, got %v: %s", err, encoded)
+	}
+
+	items := decoded.Channel.Items
+	if decoded.Version != "2.0" || len(items) != 1 || items[0].PubDate != "Mon, 19 Oct 2026 18:30:00 +0000" || !items[0].GUID.IsPermaLink || items[0].GUID.Value != "https://neighbors.example/items/1" {
+		t.Errorf("Expected an RSS item with a permalink guid, got %+v", items)
+	}
+}
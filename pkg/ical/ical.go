@@ -0,0 +1,117 @@
+// Package ical writes iCalendar documents (RFC 5545), both the invitations attached to email and
+// the feeds calendar apps subscribe to.
+package ical
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const CONTENT_TYPE = "text/calendar; charset=utf-8"
+const PRODUCT_ID = "-//Neighbors//Neighbors//EN"
+
+// MAX_LINE_LENGTH is the longest a line can be, in bytes, before it's folded onto the next.
+const MAX_LINE_LENGTH = 75
+
+const timestampFormat = "20060102T150405Z"
+const dateFormat = "20060102"
+
+type Calendar struct {
+	// Name is what calendar apps call a subscribed feed.
+	Name   string
+	Events []*Event
+}
+
+type Event struct {
+	// UID stays the same across versions of an event, so calendar apps update it instead of adding another.
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	// AllDay events take up the day Start falls on in its location, and ignore End.
+	AllDay bool
+	// Stamp is when this version of the event was written.
+	Stamp time.Time
+}
+
+// Encode writes the calendar with CRLF line endings and long lines folded.
+func (calendar *Calendar) Encode(w io.Writer) error {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:" + PRODUCT_ID, "CALSCALE:GREGORIAN"}
+	if calendar.Name != "" {
+		lines = append(lines, "X-WR-CALNAME:"+escapeText(calendar.Name))
+	}
+
+	for _, event := range calendar.Events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+escapeText(event.UID),
+			"DTSTAMP:"+formatTime(event.Stamp),
+		)
+		if event.AllDay {
+			lines = append(lines, "DTSTART;VALUE=DATE:"+event.Start.Format(dateFormat), "DTEND;VALUE=DATE:"+event.Start.AddDate(0, 0, 1).Format(dateFormat))
+		} else {
+			lines = append(lines, "DTSTART:"+formatTime(event.Start), "DTEND:"+formatTime(event.End))
+		}
+		lines = append(lines, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.Location != "" {
+			lines = append(lines, "LOCATION:"+escapeText(event.Location))
+		}
+		if event.URL != "" {
+			lines = append(lines, "URL:"+event.URL)
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, fold(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Bytes encodes the calendar, such as for an email attachment.
+func (calendar *Calendar) Bytes() []byte {
+	encoded := &bytes.Buffer{}
+	calendar.Encode(encoded)
+	return encoded.Bytes()
+}
+
+func formatTime(value time.Time) string {
+	return value.UTC().Format(timestampFormat)
+}
+
+var textEscaper = strings.NewReplacer("\\", "\\\\", ";", "\\;", ",", "\\,", "\r\n", "\\n", "\n", "\\n")
+
+func escapeText(text string) string {
+	return textEscaper.Replace(text)
+}
+
+// fold ends a content line with CRLF, continuing it on lines that start with a space whenever it
+// runs past MAX_LINE_LENGTH. Lines are only broken between characters.
+func fold(line string) string {
+	folded := &strings.Builder{}
+	limit := MAX_LINE_LENGTH
+	for len(line) > limit {
+		end := limit
+		for end > 0 && !utf8.RuneStart(line[end]) {
+			end--
+		}
+		folded.WriteString(line[:end] + "\r\n ")
+		line = line[end:]
+		// Continuation lines lose a byte to the leading space.
+		limit = MAX_LINE_LENGTH - 1
+	}
+	folded.WriteString(line + "\r\n")
+	return folded.String()
+}
@@ -0,0 +1,62 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestItEncodesEvents(t *testing.T) {
+	start := time.Date(2026, 10, 20, 10, 0, 0, 0, time.FixedZone("EDT", -4*60*60))
+	calendar := &Calendar{Name: "Drop-offs", Events: []*Event{{
+		UID:         "dropoff-1@neighbors",
+		Start:       start,
+		End:         start.Add(time.Hour),
+		Stamp:       start.Add(-24 * time.Hour),
+		Summary:     "Drop off 3 pairs of socks",
+		Description: "Ring the bell; ask for intake, please\nBack door",
+		Location:    "1 Main St, Boston, MA",
+	}}}
+
+	encoded := string(calendar.Bytes())
+	for _, line := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:Drop-offs\r\n",
+		"DTSTART:20261020T140000Z\r\n",
+		"DTEND:20261020T150000Z\r\n",
+		"DTSTAMP:20261019T140000Z\r\n",
+		"DESCRIPTION:Ring the bell\\; ask for intake\\, please\\nBack door\r\n",
+		"LOCATION:1 Main St\\, Boston\\, MA\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(encoded, line) {
+			t.Errorf("Expected %q in %q", line, encoded)
+		}
+	}
+}
+
+func TestAllDayEventsKeepTheirLocalDate(t *testing.T) {
+	// Late in the evening in Chicago is already the next day in UTC.
+	deadline := time.Date(2026, 10, 20, 21, 0, 0, 0, time.FixedZone("CDT", -5*60*60))
+	calendar := &Calendar{Events: []*Event{{UID: "deadline-1@neighbors", Start: deadline, AllDay: true, Summary: "Drop off socks"}}}
+
+	encoded := string(calendar.Bytes())
+	if !strings.Contains(encoded, "DTSTART;VALUE=DATE:20261020\r\n") || !strings.Contains(encoded, "DTEND;VALUE=DATE:20261021\r\n") {
+		t.Errorf("Expected a one day event on the 20th, got %q", encoded)
+	}
+}
+
+func TestItFoldsLongLinesBetweenCharacters(t *testing.T) {
+	line := "SUMMARY:" + strings.Repeat("é", 100)
+	folded := fold(line)
+
+	for _, part := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+		if len(part) > MAX_LINE_LENGTH || !strings.HasPrefix(part, "SUMMARY") && !strings.HasPrefix(part, " ") {
+			t.Errorf("Expected a folded line of at most %d bytes, got %q", MAX_LINE_LENGTH, part)
+		}
+	}
+
+	if strings.Replace(strings.TrimSuffix(folded, "\r\n"), "\r\n ", "", -1) != line {
+		t.Errorf("Expected unfolding to restore %q, got %q", line, folded)
+	}
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+)
+
+// Job is a unit of background work, such as purging expired data, run on a fixed interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Runner runs each of its jobs once at startup and then on the job's interval until it is stopped.
+// Jobs log through the logger in the context the runner is started with.
+type Runner struct {
+	Jobs []*Job
+
+	cancel    context.CancelFunc
+	waitGroup sync.WaitGroup
+}
+
+func BuildRunner(jobs ...*Job) *Runner {
+	return &Runner{Jobs: jobs}
+}
+
+func (runner *Runner) Start(ctx context.Context) {
+	ctx, runner.cancel = context.WithCancel(ctx)
+	for _, job := range runner.Jobs {
+		runner.waitGroup.Add(1)
+		go runner.schedule(ctx, job)
+	}
+}
+
+// Stop cancels any running jobs and waits for them to return.
+func (runner *Runner) Stop() {
+	if runner.cancel != nil {
+		runner.cancel()
+	}
+	runner.waitGroup.Wait()
+}
+
+func (runner *Runner) schedule(ctx context.Context, job *Job) {
+	defer runner.waitGroup.Done()
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		runJob(ctx, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func runJob(ctx context.Context, job *Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			logging.FromContext(ctx).Error("Job panicked", logging.Fields{"job": job.Name, "panic": r})
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+		logging.FromContext(ctx).Error("Job failed", logging.Fields{"job": job.Name, "error": err})
+	}
+}
@@ -0,0 +1,183 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Level int
+
+const (
+	DEBUG Level = iota
+	INFO
+	WARN
+	ERROR
+)
+
+var levelNames = map[Level]string{DEBUG: "debug", INFO: "info", WARN: "warn", ERROR: "error"}
+
+func (level Level) String() string {
+	return levelNames[level]
+}
+
+// ParseLevel reads a level name such as "warn", ignoring case.
+func ParseLevel(name string) (Level, bool) {
+	for level, levelName := range levelNames {
+		if strings.EqualFold(name, levelName) {
+			return level, true
+		}
+	}
+	return INFO, false
+}
+
+const (
+	TEXT_FORMAT = "text"
+	JSON_FORMAT = "json"
+)
+
+// Fields are the structured values logged with a message. Values of sensitive fields, and emails
+// and password hashes anywhere in a value, are redacted before they are written.
+type Fields map[string]interface{}
+
+// Logger writes one line per entry, either as text for people or as JSON for log collectors.
+// A nil Logger writes through the default logger.
+type Logger struct {
+	out    io.Writer
+	mutex  *sync.Mutex
+	level  Level
+	json   bool
+	fields Fields
+}
+
+type loggerKey struct{}
+
+var defaultLogger = New(os.Stderr, INFO, TEXT_FORMAT)
+
+func New(out io.Writer, level Level, format string) *Logger {
+	return &Logger{out: out, mutex: &sync.Mutex{}, level: level, json: format == JSON_FORMAT, fields: Fields{}}
+}
+
+func Default() *Logger {
+	return defaultLogger
+}
+
+// SetDefault replaces the logger used outside of requests and jobs, and by nil loggers.
+func SetDefault(logger *Logger) {
+	defaultLogger = logger
+}
+
+// WithLogger makes the logger available to everything the context is passed to.
+func WithLogger(ctx context.Context, logger *Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger the context was given, or the default logger.
+func FromContext(ctx context.Context) *Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*Logger); ok {
+		return logger
+	}
+	return defaultLogger
+}
+
+// With returns a logger that adds fields to every entry.
+func (logger *Logger) With(fields Fields) *Logger {
+	logger = logger.orDefault()
+	combined := make(Fields, len(logger.fields)+len(fields))
+	for key, value := range logger.fields {
+		combined[key] = value
+	}
+
+	for key, value := range fields {
+		combined[key] = value
+	}
+	return &Logger{out: logger.out, mutex: logger.mutex, level: logger.level, json: logger.json, fields: combined}
+}
+
+func (logger *Logger) Debug(message string, fields Fields) {
+	logger.orDefault().write(DEBUG, message, fields)
+}
+
+func (logger *Logger) Info(message string, fields Fields) {
+	logger.orDefault().write(INFO, message, fields)
+}
+
+func (logger *Logger) Warn(message string, fields Fields) {
+	logger.orDefault().write(WARN, message, fields)
+}
+
+func (logger *Logger) Error(message string, fields Fields) {
+	logger.orDefault().write(ERROR, message, fields)
+}
+
+// Fatal logs an error and exits.
+func (logger *Logger) Fatal(message string, fields Fields) {
+	logger.orDefault().write(ERROR, message, fields)
+	os.Exit(1)
+}
+
+func (logger *Logger) orDefault() *Logger {
+	if logger == nil {
+		return defaultLogger
+	}
+	return logger
+}
+
+func (logger *Logger) write(level Level, message string, fields Fields) {
+	if level < logger.level {
+		return
+	}
+
+	entry := make(Fields, len(logger.fields)+len(fields))
+	for _, source := range []Fields{logger.fields, fields} {
+		for key, value := range source {
+			entry[key] = Redact(key, value)
+		}
+	}
+
+	now := time.Now()
+	var line []byte
+	if logger.json {
+		entry["time"] = now.UTC().Format(time.RFC3339Nano)
+		entry["level"] = level.String()
+		entry["message"] = message
+		encoded, err := json.Marshal(entry)
+		if err != nil {
+			encoded, _ = json.Marshal(Fields{"time": entry["time"], "level": level.String(), "message": message, "logError": err.Error()})
+		}
+		line = append(encoded, '\n')
+	} else {
+		line = []byte(formatText(now, level, message, entry))
+	}
+
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	logger.out.Write(line)
+}
+
+// formatText writes entries like the standard logger, followed by the fields sorted by key.
+func formatText(now time.Time, level Level, message string, fields Fields) string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	text := &strings.Builder{}
+	text.WriteString(now.Format("2006/01/02 15:04:05") + " " + strings.ToUpper(level.String()) + " " + message)
+	for _, key := range keys {
+		value := fmt.Sprint(fields[key])
+		if strings.ContainsAny(value, " \t\n\"=") || value == "" {
+			value = fmt.Sprintf("%q", value)
+		}
+		text.WriteString(" " + key + "=" + value)
+	}
+	text.WriteString("\n")
+	return text.String()
+}
@@ -0,0 +1,67 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestJSONEntriesRedactSensitiveFields(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := New(out, INFO, JSON_FORMAT).With(Fields{"requestID": "abc"})
+	logger.Error("Couldn't create user", Fields{
+		"Password": "hunter2",
+		"apiToken": "secret-token",
+		"email":    "someone@example.com",
+		"error":    errors.New("duplicate key someone@example.com for $2a$10$abcdefghijklmnopqrstuuABCDEFGHIJKLMNOPQRSTUVWXYZ01234"),
+		"userID":   42,
+	})
+
+	entry := make(map[string]interface{})
+	if err := json.Unmarshal(out.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected a JSON entry, got %s: %v", out, err)
+	}
+
+	if entry["level"] != "error" || entry["message"] != "Couldn't create user" || entry["requestID"] != "abc" || entry["userID"] != float64(42) {
+		t.Errorf("Expected the message and fields to be kept, got %v", entry)
+	}
+
+	for _, field := range []string{"Password", "apiToken", "email"} {
+		if entry[field] != REDACTED {
+			t.Errorf("Expected %s to be redacted, got %v", field, entry[field])
+		}
+	}
+
+	if entry["error"] != "duplicate key [REDACTED] for [REDACTED]" {
+		t.Errorf("Expected the email and password hash in the error to be redacted, got %v", entry["error"])
+	}
+}
+
+func TestEntriesBelowTheLevelAreDropped(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := New(out, WARN, TEXT_FORMAT)
+	logger.Info("Ignored", nil)
+	logger.Warn("Failed login", Fields{"ipAddress": "10.0.0.1", "identifier": "someone@example.com"})
+
+	if strings.Contains(out.String(), "Ignored") || !strings.Contains(out.String(), "WARN Failed login identifier=[REDACTED] ipAddress=10.0.0.1\n") {
+		t.Errorf("Expected only the warning, got %q", out)
+	}
+}
+
+func TestContextsWithoutALoggerUseTheDefault(t *testing.T) {
+	logger := New(&bytes.Buffer{}, DEBUG, TEXT_FORMAT)
+	if FromContext(context.Background()) != Default() || FromContext(WithLogger(context.Background(), logger)) != logger {
+		t.Error("Expected the context's logger, or else the default")
+	}
+}
+
+func TestQueryArgumentsAreRedactedByColumn(t *testing.T) {
+	redacted := RedactArguments([]string{"Username", "Password", "Email"}, []interface{}{"kwhite", "$2a$10$hash", "someone@example.com", int64(7)})
+	expected := []string{"Username=kwhite", "Password=[REDACTED]", "Email=[REDACTED]", "?=7"}
+	if strings.Join(redacted, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected %v, got %v", expected, redacted)
+	}
+}
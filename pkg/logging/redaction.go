@@ -0,0 +1,68 @@
+package logging
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const REDACTED = "[REDACTED]"
+
+// sensitiveKeys are parts of field and column names whose values are never logged.
+var sensitiveKeys = []string{"password", "token", "secret", "email", "key", "hash"}
+
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+var bcryptPattern = regexp.MustCompile(`\$2[abxy]?\$\d{2}\$[./A-Za-z0-9]{53}`)
+
+func IsSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitiveKey := range sensitiveKeys {
+		if strings.Contains(key, sensitiveKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact hides the value of a sensitive field. Other values are kept, except for any emails and
+// password hashes in them, which can turn up in errors and formatted structs.
+func Redact(key string, value interface{}) interface{} {
+	if IsSensitive(key) {
+		return REDACTED
+	}
+
+	switch value := value.(type) {
+	case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return value
+	case string:
+		return redactText(value)
+	case []string:
+		redacted := make([]string, len(value))
+		for i, element := range value {
+			redacted[i] = redactText(element)
+		}
+		return redacted
+	case error:
+		return redactText(value.Error())
+	default:
+		return redactText(fmt.Sprintf("%+v", value))
+	}
+}
+
+func redactText(text string) string {
+	return bcryptPattern.ReplaceAllString(emailPattern.ReplaceAllString(text, REDACTED), REDACTED)
+}
+
+// RedactArguments pairs query arguments with the names of the columns they're compared with or
+// assigned to, redacting the sensitive ones.
+func RedactArguments(names []string, arguments []interface{}) []string {
+	redacted := make([]string, len(arguments))
+	for i, argument := range arguments {
+		name := "?"
+		if i < len(names) {
+			name = names[i]
+		}
+		redacted[i] = fmt.Sprintf("%s=%v", name, Redact(name, argument))
+	}
+	return redacted
+}
@@ -0,0 +1,251 @@
+package managers
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var closeAccountQuery = database.Update("users").Set("ClosedTime").SetExpression("Version = Version + 1").Where("ID = ?", "ClosedTime IS NULL")
+var reopenAccountQuery = database.Update("users").SetExpression("ClosedTime = NULL", "Version = Version + 1").Where("ID = ?", "ClosedTime IS NOT NULL", "AnonymizedTime IS NULL")
+var getAccountsToAnonymizeQuery = database.Select("users", "ID").Where("ClosedTime < ?", "AnonymizedTime IS NULL", "DeletedTime IS NULL")
+var anonymizeAccountQuery = database.Update("users").Set("Name", "Email", "Username", "Password").SetExpression("City = ''", "PostalCode = ''", "State = ''", "Street = ''").Set("AnonymizedTime").SetExpression("Version = Version + 1").Where("ID = ?")
+var withdrawOpenItemsForShelterQuery = database.Update("items").Set("DeletedTime").SetExpression("Version = Version + 1").Where("ShelterID = ?", "Status = ?", "DeletedTime IS NULL")
+var releaseClaimedItemsQuery = database.Update("items").SetExpression("SamaritanID = NULL", "ClaimedTime = 0").Set("Status").SetExpression("Version = Version + 1").Where("SamaritanID = ?", "Status = ?")
+var deleteUserSessionsForUserQuery = database.Delete("userSessions").Where("UserID = ?")
+var getUserSessionsForUserQuery = database.Select("userSessions", "LoginTime", "LastSeenTime").Where("UserID = ?").OrderBy("LoginTime DESC")
+var revokeApiTokensForUserQuery = database.Update("apiTokens").Set("RevokedTime").Where("UserID = ?", "RevokedTime IS NULL")
+var deleteApiTokensForUserQuery = database.Delete("apiTokens").Where("UserID = ?")
+
+// ACCOUNT_CLOSURE_GRACE_PERIOD is how long a closed account can be reopened by logging in before
+// its personal information is anonymized.
+const ACCOUNT_CLOSURE_GRACE_PERIOD = 30 * 24 * time.Hour
+const ANONYMIZED_USER_NAME = "Deleted user"
+
+// AccountManager closes accounts without losing the item history other users depend on. Closing
+// an account signs it out everywhere and hides it; after the grace period its personal information
+// is scrubbed but the row, and every item pointing at it, stays.
+type AccountManager struct {
+	Datasource database.Datasource
+}
+
+// AccountExport is everything stored about a user. Users can't message each other in the app, and
+// the emails it sends aren't kept, so there are no messages to include.
+type AccountExport struct {
+	ExportedTime     int64
+	Profile          *User
+	TwoFactorEnabled bool
+	Items            []*Item
+	Claims           []*Item
+	Sessions         []*UserSession
+	ApiTokens        []*ApiToken
+}
+
+// CloseAccount returns the time the account will be anonymized. Unclaimed items a shelter posted
+// are deleted, so they can be restored if the shelter comes back, and items a samaritan claimed but hasn't delivered go back up for claiming.
+func (am *AccountManager) CloseAccount(ctx context.Context, user *User) (time.Time, error) {
+	closedTime := time.Now()
+	closed := false
+	err := am.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, closeAccountQuery, []interface{}{closedTime.Unix(), user.ID})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected, err := result.RowsAffected(); err != nil || rowsAffected == 0 {
+			return err
+		}
+
+		if user.UserType == SHELTER {
+			_, err = tx.ExecuteWriteQuery(ctx, withdrawOpenItemsForShelterQuery, []interface{}{closedTime.Unix(), user.ID, CREATED})
+		} else {
+			_, err = tx.ExecuteWriteQuery(ctx, releaseClaimedItemsQuery, []interface{}{CREATED, user.ID, CLAIMED})
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = (&AccountManager{Datasource: tx}).signOutEverywhere(ctx, user.ID); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecuteWriteQuery(ctx, revokeApiTokensForUserQuery, []interface{}{closedTime.Unix(), user.ID})
+		if err != nil {
+			return err
+		}
+
+		closed = true
+		return recordAuditEvent(ctx, tx, AUDIT_ACCOUNT_CLOSE, AUDIT_USER, user.ID, nil, map[string]int64{"ClosedTime": closedTime.Unix()})
+	})
+	if err != nil || !closed {
+		return time.Time{}, err
+	}
+	return closedTime.Add(ACCOUNT_CLOSURE_GRACE_PERIOD), nil
+}
+
+// ReopenAccount cancels a pending closure. It reports whether there was one to cancel.
+func (am *AccountManager) ReopenAccount(ctx context.Context, userID int64) (bool, error) {
+	var rowsAffected int64
+	err := am.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, reopenAccountQuery, []interface{}{userID})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_ACCOUNT_REOPEN, AUDIT_USER, userID, nil, nil)
+	})
+	return rowsAffected > 0 && err == nil, err
+}
+
+// AnonymizeClosedAccounts scrubs the personal information from every account closed more than the
+// grace period before the given time, and returns how many it anonymized.
+func (am *AccountManager) AnonymizeClosedAccounts(ctx context.Context, now time.Time) (int, error) {
+	userIDs, err := readIDs(am.Datasource.ExecuteBatchReadQuery(ctx, getAccountsToAnonymizeQuery, []interface{}{now.Add(-ACCOUNT_CLOSURE_GRACE_PERIOD).Unix()}))
+	if err != nil {
+		return 0, err
+	}
+
+	for i, userID := range userIDs {
+		err := am.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+			return (&AccountManager{Datasource: tx}).anonymizeAccount(ctx, userID, now)
+		})
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(userIDs), nil
+}
+
+func (am *AccountManager) ExportAccount(ctx context.Context, userID int64) (*AccountExport, error) {
+	userManager := &UserManager{Datasource: am.Datasource}
+	itemManager := &ItemManager{Datasource: am.Datasource}
+	twoFactorManager := &TwoFactorManager{Datasource: am.Datasource}
+	apiTokenManager := &ApiTokenManager{Datasource: am.Datasource}
+
+	export := &AccountExport{ExportedTime: time.Now().Unix()}
+	var err error
+	if export.Profile, err = userManager.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if export.Items, err = itemManager.GetItemsForShelter(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if export.Claims, err = itemManager.GetItemsForSamaritan(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if export.Sessions, err = am.getUserSessions(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if export.ApiTokens, err = apiTokenManager.GetApiTokensForUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	export.TwoFactorEnabled, err = twoFactorManager.IsEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// WriteZip writes the export as a ZIP archive with one JSON file per kind of data.
+func (export *AccountExport) WriteZip(w io.Writer) error {
+	archive := zip.NewWriter(w)
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", map[string]interface{}{"Profile": export.Profile, "TwoFactorEnabled": export.TwoFactorEnabled, "ExportedTime": export.ExportedTime}},
+		{"items.json", export.Items},
+		{"claims.json", export.Claims},
+		{"sessions.json", export.Sessions},
+		{"apiTokens.json", export.ApiTokens},
+	}
+
+	for _, file := range files {
+		fileWriter, err := archive.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: time.Unix(export.ExportedTime, 0)})
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(fileWriter)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+func (am *AccountManager) anonymizeAccount(ctx context.Context, userID int64, now time.Time) error {
+	// The random password can never be logged in with; it only keeps the column populated.
+	password, err := generateApiToken()
+	if err != nil {
+		return err
+	}
+
+	encryptedPassword, err := (&UserManager{}).encryptPassword(password)
+	if err != nil {
+		return err
+	}
+
+	placeholder := fmt.Sprintf("deleted-%d", userID)
+	values := []interface{}{ANONYMIZED_USER_NAME, placeholder + "@deleted.invalid", placeholder, encryptedPassword, now.Unix(), userID}
+	if _, err = am.Datasource.ExecuteWriteQuery(ctx, anonymizeAccountQuery, values); err != nil {
+		return err
+	}
+
+	if err = (&TwoFactorManager{Datasource: am.Datasource}).DisableTwoFactor(ctx, userID); err != nil {
+		return err
+	}
+
+	if _, err = am.Datasource.ExecuteWriteQuery(ctx, deleteApiTokensForUserQuery, []interface{}{userID}); err != nil {
+		return err
+	}
+
+	if err = am.signOutEverywhere(ctx, userID); err != nil {
+		return err
+	}
+
+	// Earlier profile snapshots in the audit log hold the personal information being scrubbed.
+	if err = redactAuditStates(ctx, am.Datasource, AUDIT_USER, userID); err != nil {
+		return err
+	}
+	return recordAuditEvent(ctx, am.Datasource, AUDIT_ACCOUNT_ANONYMIZE, AUDIT_USER, userID, nil, nil)
+}
+
+func (am *AccountManager) signOutEverywhere(ctx context.Context, userID int64) error {
+	_, err := am.Datasource.ExecuteWriteQuery(ctx, deleteUserSessionsForUserQuery, []interface{}{userID})
+	return err
+}
+
+func (am *AccountManager) getUserSessions(ctx context.Context, userID int64) ([]*UserSession, error) {
+	result, err := am.Datasource.ExecuteBatchReadQuery(ctx, getUserSessionsForUserQuery, []interface{}{userID})
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	sessions := make([]*UserSession, 0)
+	for result.Next() {
+		var loginTime int64
+		var lastSeenTime int64
+		if err := result.Scan(&loginTime, &lastSeenTime); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &UserSession{UserID: userID, LoginTime: loginTime, LastSeenTime: lastSeenTime})
+	}
+	return sessions, nil
+}
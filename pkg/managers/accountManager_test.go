@@ -0,0 +1,188 @@
+package managers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+func initAccountManager() (*AccountManager, *UserManager, *ItemManager) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	datasource := database.StandardDatasource{Database: dbToClose}
+	return &AccountManager{Datasource: datasource}, &UserManager{Datasource: datasource}, &ItemManager{Datasource: datasource}
+}
+
+func writeAccountTestUser(t *testing.T, userManager *UserManager, id int, userType UserType) *User {
+	user := generateUser(id)
+	user.UserType = userType
+	userID, err := userManager.WriteUser(context.Background(), user, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user.ID = userID
+	return user
+}
+
+func TestClosingShelterWithdrawsOpenItemsAndKeepsHistory(t *testing.T) {
+	accountManager, userManager, itemManager := initAccountManager()
+	defer cleanDatabase()
+	shelter := writeAccountTestUser(t, userManager, 0, SHELTER)
+	samaritan := writeAccountTestUser(t, userManager, 1, SAMARITAN)
+
+	openItem := generateItem()
+	openItem.ShelterID = shelter.ID
+	itemManager.WriteItem(context.Background(), openItem)
+
+	deliveredItem := generateItem()
+	deliveredItem.ShelterID = shelter.ID
+	deliveredItem.ID, _ = itemManager.WriteItem(context.Background(), deliveredItem)
+	deliveredItem.SamaritanID = samaritan.ID
+	deliveredItem.Status = DELIVERED
+	itemManager.UpdateItem(context.Background(), deliveredItem)
+
+	deletionTime, err := accountManager.CloseAccount(context.Background(), shelter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deletionTime.Before(time.Now().Add(ACCOUNT_CLOSURE_GRACE_PERIOD - time.Minute)) {
+		t.Errorf("Expected deletion after the grace period, got %v", deletionTime)
+	}
+
+	items, _ := itemManager.GetItemsForShelter(context.Background(), shelter.ID)
+	if len(items) != 1 || items[0].ID != deliveredItem.ID {
+		t.Errorf("Expected only the delivered item to remain, got %v", items)
+	}
+
+	shelters, _ := userManager.GetUsers(context.Background())
+	if len(shelters) != 0 {
+		t.Errorf("Expected closed shelter to be hidden, got %v", shelters)
+	}
+
+	deletionTime, err = accountManager.CloseAccount(context.Background(), shelter)
+	if err != nil || !deletionTime.IsZero() {
+		t.Errorf("Expected closing a closed account to do nothing, got %v: %v", deletionTime, err)
+	}
+}
+
+func TestClosingSamaritanReleasesClaims(t *testing.T) {
+	accountManager, userManager, itemManager := initAccountManager()
+	defer cleanDatabase()
+	shelter := writeAccountTestUser(t, userManager, 0, SHELTER)
+	samaritan := writeAccountTestUser(t, userManager, 1, SAMARITAN)
+
+	claimedItem := generateItem()
+	claimedItem.ShelterID = shelter.ID
+	claimedItem.ID, _ = itemManager.WriteItem(context.Background(), claimedItem)
+	claimedItem.SamaritanID = samaritan.ID
+	claimedItem.Status = CLAIMED
+	itemManager.UpdateItem(context.Background(), claimedItem)
+
+	if _, err := accountManager.CloseAccount(context.Background(), samaritan); err != nil {
+		t.Fatal(err)
+	}
+
+	item, _ := itemManager.GetItem(context.Background(), claimedItem.ID)
+	if item.Status != CREATED || item.SamaritanID != 0 {
+		t.Errorf("Expected claim to be released, got %v", item)
+	}
+}
+
+func TestReopeningAccountCancelsClosure(t *testing.T) {
+	accountManager, userManager, _ := initAccountManager()
+	defer cleanDatabase()
+	shelter := writeAccountTestUser(t, userManager, 0, SHELTER)
+	accountManager.CloseAccount(context.Background(), shelter)
+
+	reopened, err := accountManager.ReopenAccount(context.Background(), shelter.ID)
+	if err != nil || !reopened {
+		t.Errorf("Expected closed account to be reopened: %v", err)
+	}
+
+	anonymized, err := accountManager.AnonymizeClosedAccounts(context.Background(), time.Now().Add(2*ACCOUNT_CLOSURE_GRACE_PERIOD))
+	if err != nil || anonymized != 0 {
+		t.Errorf("Expected reopened account not to be anonymized, got %v: %v", anonymized, err)
+	}
+}
+
+func TestClosingAccountRevokesItsApiTokens(t *testing.T) {
+	accountManager, userManager, _ := initAccountManager()
+	defer cleanDatabase()
+	shelter := writeAccountTestUser(t, userManager, 0, SHELTER)
+	apiTokenManager := &ApiTokenManager{Datasource: accountManager.Datasource}
+	plaintext, _, _ := apiTokenManager.WriteApiToken(context.Background(), shelter.ID, "test", []string{ITEMS_READ})
+
+	accountManager.CloseAccount(context.Background(), shelter)
+	accountManager.ReopenAccount(context.Background(), shelter.ID)
+
+	userSession, err := apiTokenManager.GetUserSession(context.Background(), plaintext)
+	if err != nil || userSession != nil {
+		t.Errorf("Expected tokens to stay revoked after the account is reopened, got %v: %v", userSession, err)
+	}
+}
+
+func TestItAnonymizesAccountsAfterGracePeriod(t *testing.T) {
+	accountManager, userManager, _ := initAccountManager()
+	defer cleanDatabase()
+	shelter := writeAccountTestUser(t, userManager, 0, SHELTER)
+	accountManager.CloseAccount(context.Background(), shelter)
+
+	anonymized, err := accountManager.AnonymizeClosedAccounts(context.Background(), time.Now())
+	if err != nil || anonymized != 0 {
+		t.Errorf("Expected account in its grace period to be kept, got %v: %v", anonymized, err)
+	}
+
+	anonymized, err = accountManager.AnonymizeClosedAccounts(context.Background(), time.Now().Add(ACCOUNT_CLOSURE_GRACE_PERIOD+time.Minute))
+	if err != nil || anonymized != 1 {
+		t.Errorf("Expected account past its grace period to be anonymized, got %v: %v", anonymized, err)
+	}
+
+	user, _ := userManager.GetUser(context.Background(), shelter.ID)
+	if user.Name != ANONYMIZED_USER_NAME || user.Email == shelter.Email || user.Street != "" {
+		t.Errorf("Expected personal information to be removed, got %v", user.ContactInformation)
+	}
+
+	if _, err := userManager.GetPasswordForLogin(context.Background(), shelter.Email); err == nil {
+		t.Error("Expected anonymized account to be unable to log in")
+	}
+
+	if reopened, _ := accountManager.ReopenAccount(context.Background(), shelter.ID); reopened {
+		t.Error("Expected anonymized account to be impossible to reopen")
+	}
+}
+
+func TestItExportsAccountData(t *testing.T) {
+	accountManager, userManager, itemManager := initAccountManager()
+	defer cleanDatabase()
+	shelter := writeAccountTestUser(t, userManager, 0, SHELTER)
+	item := generateItem()
+	item.ShelterID = shelter.ID
+	itemManager.WriteItem(context.Background(), item)
+
+	export, err := accountManager.ExportAccount(context.Background(), shelter.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if export.Profile.Email != shelter.Email || len(export.Items) != 1 || len(export.Claims) != 0 {
+		t.Errorf("Unexpected export %v", export)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := export.WriteZip(buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(archive.File) != 5 || archive.File[0].Name != "profile.json" {
+		t.Errorf("Unexpected export archive contents %v", archive.File)
+	}
+}
@@ -0,0 +1,197 @@
+package managers
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var createApiTokenQuery = database.Insert("apiTokens", "UserID", "Name", "TokenHash", "Scopes", "CreatedTime").Returning("ID")
+var getApiTokensForUserQuery = database.Select("apiTokens", "ID", "UserID", "Name", "Scopes", "CreatedTime", "LastUsedTime", "RevokedTime").Where("UserID = ?").OrderBy("CreatedTime DESC")
+var getApiTokenSessionQuery = database.Select("apiTokens", "apiTokens.ID", "apiTokens.UserID", "users.UserType", "apiTokens.Scopes").Join("users ON users.ID = apiTokens.UserID").Where("apiTokens.TokenHash = ?", "apiTokens.RevokedTime IS NULL", "users.ClosedTime IS NULL", "users.DeletedTime IS NULL")
+var updateApiTokenLastUsedQuery = database.Update("apiTokens").Set("LastUsedTime").Where("ID = ?")
+var revokeApiTokenQuery = database.Update("apiTokens").Set("RevokedTime").Where("ID = ?", "UserID = ?", "RevokedTime IS NULL")
+
+const API_TOKEN_PREFIX = "nbr_"
+const API_TOKEN_BYTES = 32
+
+const ITEMS_READ = "items:read"
+const ITEMS_WRITE = "items:write"
+const PROFILE_READ = "profile:read"
+const PROFILE_WRITE = "profile:write"
+
+var ApiTokenScopes = []string{ITEMS_READ, ITEMS_WRITE, PROFILE_READ, PROFILE_WRITE}
+
+var ErrInvalidApiTokenScope error = &ValidationError{Field: "Scopes", Message: "Unknown API token scope"}
+var ErrMissingApiTokenName error = &ValidationError{Field: "Name", Message: "API tokens need a name"}
+
+// ApiTokenManager issues personal API tokens for scripts and integrations. Only a hash of each
+// token is stored, so the plaintext is shown to the user once, when it is created.
+type ApiTokenManager struct {
+	Datasource database.Datasource
+}
+
+type ApiToken struct {
+	ID           int64
+	UserID       int64
+	Name         string
+	Scopes       []string
+	CreatedTime  int64
+	LastUsedTime int64
+	RevokedTime  int64
+}
+
+func (token *ApiToken) IsRevoked() bool {
+	return token.RevokedTime > 0
+}
+
+func (am *ApiTokenManager) WriteApiToken(ctx context.Context, userID int64, name string, scopes []string) (string, *ApiToken, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil, ErrMissingApiTokenName
+	}
+
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	plaintext, err := generateApiToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	createdTime := time.Now().Unix()
+	values := []interface{}{userID, name, HashSecret(plaintext), strings.Join(scopes, " "), createdTime}
+	var token *ApiToken
+	err = am.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, createApiTokenQuery, values)
+		if err != nil {
+			return err
+		}
+
+		tokenID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		token = &ApiToken{ID: tokenID, UserID: userID, Name: name, Scopes: scopes, CreatedTime: createdTime}
+		return recordAuditEvent(ctx, tx, AUDIT_API_TOKEN_CREATE, AUDIT_API_TOKEN, tokenID, nil, token)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return plaintext, token, nil
+}
+
+func (am *ApiTokenManager) GetApiTokensForUser(ctx context.Context, userID int64) ([]*ApiToken, error) {
+	result, err := am.Datasource.ExecuteBatchReadQuery(ctx, getApiTokensForUserQuery, []interface{}{userID})
+	if err != nil {
+		return nil, err
+	}
+	return am.buildApiTokens(result)
+}
+
+// GetUserSession returns a session for the owner of an unrevoked token, limited to the token's
+// scopes, or nil if the token isn't recognised.
+func (am *ApiTokenManager) GetUserSession(ctx context.Context, plaintext string) (*UserSession, error) {
+	row := am.Datasource.ExecuteSingleReadQuery(ctx, getApiTokenSessionQuery, []interface{}{HashSecret(plaintext)})
+	var tokenID int64
+	var userID int64
+	var userType UserType
+	var scopes string
+	if err := row.Scan(&tokenID, &userID, &userType, &scopes); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Tokens stay valid until they're revoked, so each request counts as a fresh login.
+	currentTime := time.Now().Unix()
+	_, err := am.Datasource.ExecuteWriteQuery(ctx, updateApiTokenLastUsedQuery, []interface{}{currentTime, tokenID})
+	if err != nil {
+		return nil, err
+	}
+	return &UserSession{UserID: userID, UserType: userType, LoginTime: currentTime, LastSeenTime: currentTime, Scopes: strings.Fields(scopes), ApiTokenID: tokenID}, nil
+}
+
+func (am *ApiTokenManager) RevokeApiToken(ctx context.Context, userID int64, tokenID int64) (int64, error) {
+	values := []interface{}{time.Now().Unix(), tokenID, userID}
+	var rowsAffected int64
+	err := am.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, revokeApiTokenQuery, values)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_API_TOKEN_REVOKE, AUDIT_API_TOKEN, tokenID, nil, nil)
+	})
+	if err != nil {
+		return -1, err
+	}
+	return rowsAffected, nil
+}
+
+func (am *ApiTokenManager) buildApiTokens(result *sql.Rows) ([]*ApiToken, error) {
+	response := make([]*ApiToken, 0)
+	for result.Next() {
+		var id int64
+		var userID int64
+		var name string
+		var scopes string
+		var createdTime int64
+		var lastUsedTime sql.NullInt64
+		var revokedTime sql.NullInt64
+		if err := result.Scan(&id, &userID, &name, &scopes, &createdTime, &lastUsedTime, &revokedTime); err != nil {
+			return nil, err
+		}
+		response = append(response, &ApiToken{
+			ID:           id,
+			UserID:       userID,
+			Name:         name,
+			Scopes:       strings.Fields(scopes),
+			CreatedTime:  createdTime,
+			LastUsedTime: lastUsedTime.Int64,
+			RevokedTime:  revokedTime.Int64,
+		})
+	}
+	return response, nil
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	requested := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		requested[strings.ToLower(strings.TrimSpace(scope))] = true
+	}
+
+	normalized := make([]string, 0, len(requested))
+	for _, scope := range ApiTokenScopes {
+		if requested[scope] {
+			normalized = append(normalized, scope)
+			delete(requested, scope)
+		}
+	}
+
+	if len(requested) > 0 || len(normalized) == 0 {
+		return nil, ErrInvalidApiTokenScope
+	}
+	return normalized, nil
+}
+
+func generateApiToken() (string, error) {
+	token := make([]byte, API_TOKEN_BYTES)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return API_TOKEN_PREFIX + base64.RawURLEncoding.EncodeToString(token), nil
+}
@@ -0,0 +1,102 @@
+package managers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+func initApiTokenManager() (*ApiTokenManager, int64) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	datasource := database.StandardDatasource{Database: dbToClose}
+	userManager := &UserManager{Datasource: datasource}
+	user := generateUser(0)
+	user.UserType = SHELTER
+	userID, _ := userManager.WriteUser(context.Background(), user, "password")
+	return &ApiTokenManager{Datasource: datasource}, userID
+}
+
+func TestCanAuthenticateWithApiToken(t *testing.T) {
+	manager, userID := initApiTokenManager()
+	defer cleanDatabase()
+
+	plaintext, token, err := manager.WriteApiToken(context.Background(), userID, "Intake spreadsheet", []string{ITEMS_WRITE, " items:READ "})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(plaintext, API_TOKEN_PREFIX) || token.Scopes[0] != ITEMS_READ || token.Scopes[1] != ITEMS_WRITE {
+		t.Errorf("Unexpected token %v with scopes %v", plaintext, token.Scopes)
+	}
+
+	userSession, err := manager.GetUserSession(context.Background(), plaintext)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if userSession == nil || userSession.UserID != userID || userSession.UserType != SHELTER {
+		t.Fatalf("Expected session for user %v, got %v", userID, userSession)
+	}
+
+	if !userSession.HasScope(ITEMS_WRITE) || userSession.HasScope(PROFILE_WRITE) {
+		t.Errorf("Expected session to be limited to %v, got %v", token.Scopes, userSession.Scopes)
+	}
+
+	tokens, _ := manager.GetApiTokensForUser(context.Background(), userID)
+	if len(tokens) != 1 || tokens[0].LastUsedTime == 0 {
+		t.Errorf("Expected token use to be recorded, got %v", tokens)
+	}
+}
+
+func TestCannotAuthenticateWithRevokedApiToken(t *testing.T) {
+	manager, userID := initApiTokenManager()
+	defer cleanDatabase()
+	plaintext, token, _ := manager.WriteApiToken(context.Background(), userID, "test", []string{ITEMS_READ})
+
+	revoked, err := manager.RevokeApiToken(context.Background(), userID+1, token.ID)
+	if err != nil || revoked != 0 {
+		t.Errorf("Expected users not to be able to revoke other users' tokens: %v", err)
+	}
+
+	revoked, err = manager.RevokeApiToken(context.Background(), userID, token.ID)
+	if err != nil || revoked != 1 {
+		t.Errorf("Expected token to be revoked: %v", err)
+	}
+
+	userSession, err := manager.GetUserSession(context.Background(), plaintext)
+	if err != nil || userSession != nil {
+		t.Errorf("Expected revoked token not to authenticate, got %v: %v", userSession, err)
+	}
+}
+
+func TestCannotAuthenticateWithApiTokenForClosedAccount(t *testing.T) {
+	manager, userID := initApiTokenManager()
+	defer cleanDatabase()
+	plaintext, _, _ := manager.WriteApiToken(context.Background(), userID, "test", []string{ITEMS_READ})
+
+	if _, err := dbToClose.Exec("UPDATE users SET ClosedTime = 1 WHERE ID = ?", userID); err != nil {
+		t.Fatal(err)
+	}
+
+	userSession, err := manager.GetUserSession(context.Background(), plaintext)
+	if err != nil || userSession != nil {
+		t.Errorf("Expected token for a closed account not to authenticate, got %v: %v", userSession, err)
+	}
+}
+
+func TestItRejectsUnknownApiTokenScopes(t *testing.T) {
+	manager, userID := initApiTokenManager()
+	defer cleanDatabase()
+
+	_, _, err := manager.WriteApiToken(context.Background(), userID, "test", []string{ITEMS_READ, "admin"})
+	if err != ErrInvalidApiTokenScope {
+		t.Errorf("Expected %v to equal %v", err, ErrInvalidApiTokenScope)
+	}
+
+	_, _, err = manager.WriteApiToken(context.Background(), userID, "test", nil)
+	if err != ErrInvalidApiTokenScope {
+		t.Errorf("Expected %v to equal %v", err, ErrInvalidApiTokenScope)
+	}
+}
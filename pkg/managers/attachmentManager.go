@@ -0,0 +1,266 @@
+package managers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/storage"
+)
+
+var attachmentColumns = []string{"ID", "ItemID", "UploaderID", "Kind", "ContentType", "Size", "StorageKey", "ThumbnailKey", "CreatedTime"}
+var createAttachmentQuery = database.Insert("attachments", "ItemID", "UploaderID", "Kind", "ContentType", "Size", "StorageKey", "ThumbnailKey", "CreatedTime").Returning("ID")
+var getAttachmentQuery = database.Select("attachments", attachmentColumns...).Where("ID = ?")
+var getAttachmentsForItemQuery = database.Select("attachments", attachmentColumns...).Where("ItemID = ?").OrderBy("CreatedTime", "ID")
+var getDetachedAttachmentsQuery = database.Select("attachments", attachmentColumns...).Where("ItemID NOT IN (SELECT ID FROM items)")
+var deleteAttachmentQuery = database.Delete("attachments").Where("ID = ?")
+
+// MAX_ATTACHMENT_SIZE is the largest file that can be attached, in bytes.
+const MAX_ATTACHMENT_SIZE = 5 << 20
+
+// MAX_ATTACHMENT_PIXELS keeps small files that decode into huge images from being thumbnailed.
+const MAX_ATTACHMENT_PIXELS = 25000000
+
+// ATTACHMENT_TYPES maps the types attachments can be to the extension they're stored with.
+var ATTACHMENT_TYPES = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type AttachmentKind string
+
+const (
+	// PHOTO is an example of the item a shelter is asking for.
+	PHOTO AttachmentKind = "photo"
+	// RECEIPT is a samaritan's proof that they dropped the item off.
+	RECEIPT AttachmentKind = "receipt"
+)
+
+var ErrAttachmentTooLarge error = &ValidationError{Field: "File", Message: fmt.Sprintf("Attachments can be at most %d MB", MAX_ATTACHMENT_SIZE>>20)}
+var ErrEmptyAttachment error = &ValidationError{Field: "File", Message: "The attachment is empty"}
+var ErrUnsupportedAttachment error = &ValidationError{Field: "File", Message: "Attachments must be JPEG, PNG or GIF images"}
+var ErrInvalidAttachmentKind error = &ValidationError{Field: "Kind", Message: "Kind must be photo or receipt"}
+
+// AttachmentManager keeps photos and receipts attached to items. Files go to the Store, and the
+// attachments table records which item each belongs to.
+type AttachmentManager struct {
+	Datasource database.Datasource
+	Store      storage.Store
+}
+
+type Attachment struct {
+	ID           int64
+	ItemID       int64
+	UploaderID   int64
+	Kind         AttachmentKind
+	ContentType  string
+	Size         int64
+	StorageKey   string `json:"-"`
+	ThumbnailKey string `json:"-"`
+	CreatedTime  int64
+}
+
+// AddAttachment checks that content is a supported image, stores it with a thumbnail, and
+// returns the new attachment's ID.
+func (am *AttachmentManager) AddAttachment(ctx context.Context, attachment *Attachment, content []byte) (int64, error) {
+	if attachment.Kind != PHOTO && attachment.Kind != RECEIPT {
+		return -1, ErrInvalidAttachmentKind
+	}
+
+	contentType, err := detectAttachmentType(content)
+	if err != nil {
+		return -1, err
+	}
+
+	thumbnail, err := buildThumbnail(content)
+	if err != nil {
+		return -1, ErrUnsupportedAttachment
+	}
+
+	name, err := generateAttachmentName()
+	if err != nil {
+		return -1, err
+	}
+
+	attachment.ContentType = contentType
+	attachment.Size = int64(len(content))
+	attachment.StorageKey = fmt.Sprintf("items/%d/%s%s", attachment.ItemID, name, ATTACHMENT_TYPES[contentType])
+	attachment.ThumbnailKey = fmt.Sprintf("items/%d/%s-thumbnail.jpg", attachment.ItemID, name)
+	attachment.CreatedTime = time.Now().Unix()
+	if err := am.Store.Put(ctx, attachment.StorageKey, contentType, content); err != nil {
+		return -1, err
+	}
+
+	if err := am.Store.Put(ctx, attachment.ThumbnailKey, "image/jpeg", thumbnail); err != nil {
+		am.deleteFiles(ctx, attachment)
+		return -1, err
+	}
+
+	values := []interface{}{attachment.ItemID, attachment.UploaderID, attachment.Kind, attachment.ContentType, attachment.Size, attachment.StorageKey, attachment.ThumbnailKey, attachment.CreatedTime}
+	err = am.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, createAttachmentQuery, values)
+		if err != nil {
+			return err
+		}
+
+		attachment.ID, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_ATTACHMENT_CREATE, AUDIT_ATTACHMENT, attachment.ID, nil, attachment)
+	})
+	if err != nil {
+		am.deleteFiles(ctx, attachment)
+		return -1, err
+	}
+	return attachment.ID, nil
+}
+
+func (am *AttachmentManager) GetAttachment(ctx context.Context, attachmentID int64) (*Attachment, error) {
+	result, err := am.Datasource.ExecuteBatchReadQuery(ctx, getAttachmentQuery, []interface{}{attachmentID})
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := buildAttachments(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attachments) == 0 {
+		return nil, &NotFoundError{Entity: "Attachment", ID: attachmentID}
+	}
+	return attachments[0], nil
+}
+
+func (am *AttachmentManager) GetAttachmentsForItem(ctx context.Context, itemID int64) ([]*Attachment, error) {
+	result, err := am.Datasource.ExecuteBatchReadQuery(ctx, getAttachmentsForItemQuery, []interface{}{itemID})
+	if err != nil {
+		return nil, err
+	}
+	return buildAttachments(result)
+}
+
+// OpenAttachment reads the attached file, or its thumbnail.
+func (am *AttachmentManager) OpenAttachment(ctx context.Context, attachment *Attachment, thumbnail bool) (io.ReadCloser, error) {
+	key := attachment.StorageKey
+	if thumbnail {
+		key = attachment.ThumbnailKey
+	}
+
+	file, err := am.Store.Open(ctx, key)
+	if err == storage.ErrNotFound {
+		return nil, &NotFoundError{Entity: "Attachment", ID: attachment.ID}
+	}
+	return file, err
+}
+
+func (am *AttachmentManager) DeleteAttachment(ctx context.Context, attachment *Attachment) error {
+	err := am.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		if _, err := tx.ExecuteWriteQuery(ctx, deleteAttachmentQuery, []interface{}{attachment.ID}); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_ATTACHMENT_DELETE, AUDIT_ATTACHMENT, attachment.ID, attachment, nil)
+	})
+	if err != nil {
+		return err
+	}
+	am.deleteFiles(ctx, attachment)
+	return nil
+}
+
+// PurgeDetachedAttachments deletes the files of items that have been purged, and returns how many
+// attachments it removed.
+func (am *AttachmentManager) PurgeDetachedAttachments(ctx context.Context) (int, error) {
+	result, err := am.Datasource.ExecuteBatchReadQuery(ctx, getDetachedAttachmentsQuery, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	attachments, err := buildAttachments(result)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, attachment := range attachments {
+		for _, key := range []string{attachment.StorageKey, attachment.ThumbnailKey} {
+			if err := am.Store.Delete(ctx, key); err != nil {
+				return i, err
+			}
+		}
+
+		if _, err := am.Datasource.ExecuteWriteQuery(ctx, deleteAttachmentQuery, []interface{}{attachment.ID}); err != nil {
+			return i, err
+		}
+	}
+	return len(attachments), nil
+}
+
+// deleteFiles removes an attachment's files once nothing refers to them. Failures are only logged,
+// since the files can no longer be reached.
+func (am *AttachmentManager) deleteFiles(ctx context.Context, attachment *Attachment) {
+	for _, key := range []string{attachment.StorageKey, attachment.ThumbnailKey} {
+		if err := am.Store.Delete(ctx, key); err != nil {
+			logging.FromContext(ctx).Error("Couldn't delete attachment file", logging.Fields{"error": err, "key": key})
+		}
+	}
+}
+
+// detectAttachmentType sniffs the type of an image from its content rather than trusting the
+// uploader, and checks it's small enough to thumbnail.
+func detectAttachmentType(content []byte) (string, error) {
+	if len(content) == 0 {
+		return "", ErrEmptyAttachment
+	}
+
+	if len(content) > MAX_ATTACHMENT_SIZE {
+		return "", ErrAttachmentTooLarge
+	}
+
+	contentType := http.DetectContentType(content)
+	if _, supported := ATTACHMENT_TYPES[contentType]; !supported {
+		return "", ErrUnsupportedAttachment
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return "", ErrUnsupportedAttachment
+	}
+
+	if config.Width*config.Height > MAX_ATTACHMENT_PIXELS {
+		return "", &ValidationError{Field: "File", Message: fmt.Sprintf("Images can be at most %d megapixels", MAX_ATTACHMENT_PIXELS/1000000)}
+	}
+	return contentType, nil
+}
+
+func generateAttachmentName() (string, error) {
+	name := make([]byte, 16)
+	if _, err := rand.Read(name); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(name), nil
+}
+
+func buildAttachments(result *sql.Rows) ([]*Attachment, error) {
+	defer result.Close()
+	attachments := make([]*Attachment, 0)
+	for result.Next() {
+		attachment := &Attachment{}
+		err := result.Scan(&attachment.ID, &attachment.ItemID, &attachment.UploaderID, &attachment.Kind, &attachment.ContentType, &attachment.Size, &attachment.StorageKey, &attachment.ThumbnailKey, &attachment.CreatedTime)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, result.Err()
+}
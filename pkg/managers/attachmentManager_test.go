@@ -0,0 +1,150 @@
+package managers
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/storage"
+)
+
+func initAttachmentManager(t *testing.T) (*AttachmentManager, func()) {
+	directory, err := ioutil.TempDir("", "neighbors")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	manager := &AttachmentManager{Datasource: database.StandardDatasource{Database: dbToClose}, Store: &storage.FileStore{Directory: directory}}
+	return manager, func() {
+		cleanDatabase()
+		os.RemoveAll(directory)
+	}
+}
+
+func buildPNG(t *testing.T, width int, height int) []byte {
+	picture := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		picture.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+
+	encoded := &bytes.Buffer{}
+	if err := png.Encode(encoded, picture); err != nil {
+		t.Fatal(err)
+	}
+	return encoded.Bytes()
+}
+
+func TestItStoresAttachmentsWithThumbnails(t *testing.T) {
+	manager, cleanup := initAttachmentManager(t)
+	defer cleanup()
+	ctx := context.Background()
+	content := buildPNG(t, 960, 480)
+
+	id, err := manager.AddAttachment(ctx, &Attachment{ItemID: 7, UploaderID: testShelterID, Kind: PHOTO}, content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attachment, err := manager.GetAttachment(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if attachment.ContentType != "image/png" || attachment.Size != int64(len(content)) || attachment.Kind != PHOTO {
+		t.Errorf("Expected the attachment to be recorded, got %+v", attachment)
+	}
+
+	file, err := manager.OpenAttachment(ctx, attachment, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, _ := ioutil.ReadAll(file)
+	file.Close()
+	if !bytes.Equal(stored, content) {
+		t.Error("Expected the attachment to be stored as uploaded")
+	}
+
+	file, err = manager.OpenAttachment(ctx, attachment, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumbnail, err := jpeg.DecodeConfig(file)
+	file.Close()
+	if err != nil || thumbnail.Width != THUMBNAIL_SIZE || thumbnail.Height != THUMBNAIL_SIZE/2 {
+		t.Errorf("Expected a %dx%d JPEG thumbnail, got %+v %v", THUMBNAIL_SIZE, THUMBNAIL_SIZE/2, thumbnail, err)
+	}
+
+	attachments, err := manager.GetAttachmentsForItem(ctx, 7)
+	if err != nil || len(attachments) != 1 || attachments[0].ID != id {
+		t.Errorf("Expected the item's attachment, got %v %v", attachments, err)
+	}
+
+	if err := manager.DeleteAttachment(ctx, attachment); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := manager.OpenAttachment(ctx, attachment, false); err == nil {
+		t.Error("Expected the deleted attachment's file to be removed")
+	}
+
+	if _, err := manager.GetAttachment(ctx, id); err == nil {
+		t.Error("Expected the deleted attachment to be gone")
+	}
+}
+
+func TestItRejectsInvalidAttachments(t *testing.T) {
+	manager, cleanup := initAttachmentManager(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	expected := map[error][]byte{
+		ErrEmptyAttachment:       {},
+		ErrUnsupportedAttachment: []byte("%PDF-1.4 not an image"),
+		ErrAttachmentTooLarge:    append(buildPNG(t, 1, 1), make([]byte, MAX_ATTACHMENT_SIZE)...),
+	}
+	for expectedErr, content := range expected {
+		if _, err := manager.AddAttachment(ctx, &Attachment{ItemID: 7, Kind: PHOTO}, content); err != expectedErr {
+			t.Errorf("Expected %v, got %v", expectedErr, err)
+		}
+	}
+
+	if _, err := manager.AddAttachment(ctx, &Attachment{ItemID: 7, Kind: "video"}, buildPNG(t, 1, 1)); err != ErrInvalidAttachmentKind {
+		t.Errorf("Expected the kind to be rejected, got %v", err)
+	}
+}
+
+func TestItPurgesTheAttachmentsOfPurgedItems(t *testing.T) {
+	manager, cleanup := initAttachmentManager(t)
+	defer cleanup()
+	ctx := context.Background()
+	itemManager := &ItemManager{Datasource: manager.Datasource}
+	itemID, err := itemManager.WriteItem(ctx, generateItem())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kept, _ := manager.AddAttachment(ctx, &Attachment{ItemID: itemID, Kind: PHOTO}, buildPNG(t, 1, 1))
+	purgedID, _ := manager.AddAttachment(ctx, &Attachment{ItemID: itemID + 1, Kind: PHOTO}, buildPNG(t, 1, 1))
+	purged, _ := manager.GetAttachment(ctx, purgedID)
+
+	count, err := manager.PurgeDetachedAttachments(ctx)
+	if err != nil || count != 1 {
+		t.Fatalf("Expected one attachment to be purged, got %v %v", count, err)
+	}
+
+	if _, err := manager.GetAttachment(ctx, kept); err != nil {
+		t.Errorf("Expected the attachment of an existing item to be kept, got %v", err)
+	}
+
+	if _, err := manager.Store.Open(ctx, purged.StorageKey); err != storage.ErrNotFound {
+		t.Errorf("Expected the purged attachment's file to be deleted, got %v", err)
+	}
+}
@@ -0,0 +1,315 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var auditEventColumns = []string{"ID", "ActorID", "Session", "IPAddress", "Action", "Entity", "EntityID", "BeforeState", "AfterState", "EventTime"}
+
+var createAuditEventQuery = database.Insert("audit_events", "ActorID", "Session", "IPAddress", "Action", "Entity", "EntityID", "BeforeState", "AfterState", "EventTime")
+var searchAuditEventsQuery = database.Select("audit_events", auditEventColumns...).Where(
+	"(? = 0 OR ActorID = ?)",
+	"(? = '' OR Entity = ?)",
+	"(? = 0 OR EntityID = ?)",
+	"(? = '' OR Action = ?)",
+	"EventTime >= ?",
+	"EventTime < ?",
+	`(? = '' OR LOWER(IPAddress) LIKE ? ESCAPE '\' OR LOWER(BeforeState) LIKE ? ESCAPE '\' OR LOWER(AfterState) LIKE ? ESCAPE '\')`,
+).OrderBy("EventTime DESC", "ID DESC").Limit().Offset()
+var redactAuditStatesQuery = database.Update("audit_events").SetExpression("BeforeState = NULL", "AfterState = NULL").Where("Entity = ?", "EntityID = ?")
+
+// likeEscaper makes search text match literally in a LIKE pattern escaped with a backslash.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+const (
+	AUDIT_ITEM       = "item"
+	AUDIT_USER       = "user"
+	AUDIT_API_TOKEN  = "apiToken"
+	AUDIT_ATTACHMENT = "attachment"
+	AUDIT_WISHLIST   = "wishlist"
+	AUDIT_SETTINGS   = "settings"
+)
+
+const (
+	AUDIT_ITEM_CREATE               = "item.create"
+	AUDIT_ITEM_UPDATE               = "item.update"
+	AUDIT_ITEM_DELETE               = "item.delete"
+	AUDIT_ITEM_RESTORE              = "item.restore"
+	AUDIT_ITEM_PURGE                = "item.purge"
+	AUDIT_ITEM_RECEIVE              = "item.receive"
+	AUDIT_ITEM_SCHEDULE_DROP_OFF    = "item.scheduleDropOff"
+	AUDIT_WISHLIST_CREATE           = "wishlist.create"
+	AUDIT_WISHLIST_UPDATE           = "wishlist.update"
+	AUDIT_WISHLIST_DELETE           = "wishlist.delete"
+	AUDIT_USER_CREATE               = "user.create"
+	AUDIT_USER_UPDATE               = "user.update"
+	AUDIT_USER_DELETE               = "user.delete"
+	AUDIT_USER_RESTORE              = "user.restore"
+	AUDIT_USER_PURGE                = "user.purge"
+	AUDIT_INTAKE_SCHEDULE_UPDATE    = "user.updateIntakeSchedule"
+	AUDIT_WIDGET_SETTINGS_UPDATE    = "user.updateWidgetSettings"
+	AUDIT_PASSWORD_RESET            = "user.passwordReset"
+	AUDIT_ACCOUNT_CLOSE             = "account.close"
+	AUDIT_ACCOUNT_REOPEN            = "account.reopen"
+	AUDIT_ACCOUNT_ANONYMIZE         = "account.anonymize"
+	AUDIT_LOGIN                     = "session.login"
+	AUDIT_LOGIN_FAILED              = "session.loginFailed"
+	AUDIT_LOGOUT                    = "session.logout"
+	AUDIT_TWO_FACTOR_ENABLE         = "twoFactor.enable"
+	AUDIT_TWO_FACTOR_DISABLE        = "twoFactor.disable"
+	AUDIT_RECOVERY_CODES_REGENERATE = "twoFactor.regenerateRecoveryCodes"
+	AUDIT_RECOVERY_CODE_USE         = "twoFactor.useRecoveryCode"
+	AUDIT_API_TOKEN_CREATE          = "apiToken.create"
+	AUDIT_API_TOKEN_REVOKE          = "apiToken.revoke"
+	AUDIT_ATTACHMENT_CREATE         = "attachment.create"
+	AUDIT_ATTACHMENT_DELETE         = "attachment.delete"
+	AUDIT_SETTINGS_UPDATE           = "settings.update"
+)
+
+// AUDIT_PAGE_SIZE is the default number of events returned by a search.
+const AUDIT_PAGE_SIZE = 50
+const AUDIT_EXPORT_BATCH_SIZE = 1000
+
+// AuditManager reads the audit log. Events are written by the other managers as they make changes,
+// and are never updated, except to redact personal information when an account is anonymized.
+type AuditManager struct {
+	Datasource database.Datasource
+}
+
+// AuditActor identifies who is making the changes in a request. It's attached to the request
+// context, and filled in once the request's session is known.
+type AuditActor struct {
+	UserID int64
+	// Session is a hash of the session key, or the ID of the API token used.
+	Session   string
+	IPAddress string
+}
+
+type AuditEvent struct {
+	ID          int64
+	ActorID     int64
+	Session     string
+	IPAddress   string
+	Action      string
+	Entity      string
+	EntityID    int64
+	BeforeState string
+	AfterState  string
+	EventTime   int64
+}
+
+// AuditSearch filters the audit log. Zero values match every event, and Text matches the IP
+// address or either state.
+type AuditSearch struct {
+	ActorID  int64
+	Entity   string
+	EntityID int64
+	Action   string
+	Since    int64
+	Until    int64
+	Text     string
+	Limit    int
+	Offset   int
+}
+
+type auditActorKey struct{}
+
+func WithAuditActor(ctx context.Context, actor *AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFromContext returns the actor for the context, or nil outside of a request.
+func AuditActorFromContext(ctx context.Context) *AuditActor {
+	if ctx == nil {
+		return nil
+	}
+	actor, _ := ctx.Value(auditActorKey{}).(*AuditActor)
+	return actor
+}
+
+// SetSession records the session a request was made with.
+func (actor *AuditActor) SetSession(userSession *UserSession) {
+	actor.UserID = userSession.UserID
+	if userSession.ApiTokenID > 0 {
+		actor.Session = "apiToken:" + strconv.FormatInt(userSession.ApiTokenID, 10)
+	} else {
+		actor.Session = HashSecret(userSession.SessionKey)
+	}
+}
+
+func (am *AuditManager) SearchAuditEvents(ctx context.Context, search *AuditSearch) ([]*AuditEvent, error) {
+	until := search.Until
+	if until <= 0 {
+		until = time.Now().Unix() + 1
+	}
+
+	limit := search.Limit
+	if limit <= 0 {
+		limit = AUDIT_PAGE_SIZE
+	}
+
+	text := ""
+	if search.Text != "" {
+		text = "%" + likeEscaper.Replace(strings.ToLower(search.Text)) + "%"
+	}
+
+	values := []interface{}{
+		search.ActorID, search.ActorID,
+		search.Entity, search.Entity,
+		search.EntityID, search.EntityID,
+		search.Action, search.Action,
+		search.Since,
+		until,
+		text, text, text, text,
+		limit,
+		search.Offset,
+	}
+	result, err := am.Datasource.ExecuteBatchReadQuery(ctx, searchAuditEventsQuery, values)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	events := make([]*AuditEvent, 0)
+	for result.Next() {
+		event := &AuditEvent{}
+		var actorID, entityID sql.NullInt64
+		var session, ipAddress, beforeState, afterState sql.NullString
+		err := result.Scan(&event.ID, &actorID, &session, &ipAddress, &event.Action, &event.Entity, &entityID, &beforeState, &afterState, &event.EventTime)
+		if err != nil {
+			return nil, err
+		}
+		event.ActorID, event.EntityID = actorID.Int64, entityID.Int64
+		event.Session, event.IPAddress = session.String, ipAddress.String
+		event.BeforeState, event.AfterState = beforeState.String, afterState.String
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// WriteAuditCSV writes every event matching the search as CSV, ignoring its limit and offset, for
+// incident reviews.
+func (am *AuditManager) WriteAuditCSV(ctx context.Context, search *AuditSearch, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"ID", "Time", "ActorID", "Session", "IPAddress", "Action", "Entity", "EntityID", "Before", "After"})
+
+	batch := *search
+	batch.Limit = AUDIT_EXPORT_BATCH_SIZE
+	batch.Offset = 0
+	if batch.Until <= 0 {
+		// Pin the end of the export so events recorded while it runs don't shift the batches.
+		batch.Until = time.Now().Unix() + 1
+	}
+
+	for {
+		events, err := am.SearchAuditEvents(ctx, &batch)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			writer.Write(spreadsheetSafe([]string{
+				strconv.FormatInt(event.ID, 10),
+				time.Unix(event.EventTime, 0).UTC().Format(time.RFC3339),
+				formatAuditID(event.ActorID),
+				event.Session,
+				event.IPAddress,
+				event.Action,
+				event.Entity,
+				formatAuditID(event.EntityID),
+				event.BeforeState,
+				event.AfterState,
+			}))
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil || len(events) < batch.Limit {
+			return err
+		}
+		batch.Offset += len(events)
+	}
+}
+
+func formatAuditID(id int64) string {
+	if id <= 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// recordAuditEvent appends an event for a change. It should be called in the change's transaction,
+// so the change doesn't go through without its event. Changes made outside of a request, such as
+// by scheduled jobs, have no actor. Users creating their account or logging in are their own actor.
+func recordAuditEvent(ctx context.Context, datasource database.Datasource, action string, entity string, entityID int64, before interface{}, after interface{}) error {
+	var actorID, session, ipAddress interface{}
+	if actor := AuditActorFromContext(ctx); actor != nil {
+		if actor.UserID > 0 {
+			actorID = actor.UserID
+		} else if entity == AUDIT_USER && entityID > 0 {
+			actorID = entityID
+		}
+
+		if actor.Session != "" {
+			session = actor.Session
+		}
+		ipAddress = actor.IPAddress
+	}
+
+	var entityIDValue interface{}
+	if entityID > 0 {
+		entityIDValue = entityID
+	}
+
+	beforeState, err := encodeAuditState(before)
+	if err != nil {
+		return err
+	}
+
+	afterState, err := encodeAuditState(after)
+	if err != nil {
+		return err
+	}
+
+	values := []interface{}{actorID, session, ipAddress, action, entity, entityIDValue, beforeState, afterState, time.Now().Unix()}
+	_, err = datasource.ExecuteWriteQuery(ctx, createAuditEventQuery, values)
+	return err
+}
+
+func encodeAuditState(state interface{}) (interface{}, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	if user, ok := state.(*User); ok {
+		state = auditUserState(user)
+	}
+
+	encoded, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+// auditUserState copies a user without their password, which may be set on users decoded from requests.
+func auditUserState(user *User) *User {
+	if user == nil {
+		return nil
+	}
+	copied := *user
+	copied.Password = ""
+	return &copied
+}
+
+func redactAuditStates(ctx context.Context, datasource database.Datasource, entity string, entityID int64) error {
+	_, err := datasource.ExecuteWriteQuery(ctx, redactAuditStatesQuery, []interface{}{entity, entityID})
+	return err
+}
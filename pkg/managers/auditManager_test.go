@@ -0,0 +1,199 @@
+package managers
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+func initAuditManager() (*AuditManager, *UserManager, *ItemManager) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	datasource := database.StandardDatasource{Database: dbToClose}
+	return &AuditManager{Datasource: datasource}, &UserManager{Datasource: datasource}, &ItemManager{Datasource: datasource}
+}
+
+func TestItRecordsWhoChangedAnItem(t *testing.T) {
+	auditManager, _, itemManager := initAuditManager()
+	defer cleanDatabase()
+	actor := &AuditActor{IPAddress: testIPAddress}
+	ctx := WithAuditActor(context.Background(), actor)
+	actor.SetSession(&UserSession{SessionKey: "42-session", UserID: 42})
+
+	item := generateItem()
+	item.ID, _ = itemManager.WriteItem(ctx, item)
+	item.Quantity = testQuantity + 1
+	if err := itemManager.UpdateItem(ctx, item); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := auditManager.SearchAuditEvents(context.Background(), &AuditSearch{Entity: AUDIT_ITEM, EntityID: item.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) != 2 || events[0].Action != AUDIT_ITEM_UPDATE || events[1].Action != AUDIT_ITEM_CREATE {
+		t.Fatalf("Expected an update and a create, got %v", events)
+	}
+
+	update := events[0]
+	if update.ActorID != 42 || update.IPAddress != testIPAddress || update.Session != HashSecret("42-session") {
+		t.Errorf("Expected the update to be attributed to the actor, got %+v", update)
+	}
+
+	if !strings.Contains(update.BeforeState, `"Version":1`) || !strings.Contains(update.AfterState, `"Version":2`) {
+		t.Errorf("Expected the update to record both states, got %v and %v", update.BeforeState, update.AfterState)
+	}
+}
+
+func TestAChangeIsRolledBackWhenItsAuditEventCantBeWritten(t *testing.T) {
+	_, _, itemManager := initAuditManager()
+	defer cleanDatabase()
+
+	item := generateItem()
+	item.ID, _ = itemManager.WriteItem(context.Background(), item)
+	if _, err := dbToClose.Exec("DROP TABLE audit_events"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := itemManager.DeleteItem(context.Background(), item.ID); err == nil {
+		t.Fatal("Expected the delete to fail without an audit event")
+	}
+
+	if storedItem, err := itemManager.GetItem(context.Background(), item.ID); err != nil || storedItem == nil {
+		t.Errorf("Expected the item to still exist, got %v and %v", storedItem, err)
+	}
+}
+
+func TestAuditedUsersNeverIncludePasswords(t *testing.T) {
+	auditManager, userManager, _ := initAuditManager()
+	defer cleanDatabase()
+
+	user := generateUser(0)
+	user.Password = "password"
+	user.ID, _ = userManager.WriteUser(context.Background(), user, "password")
+	user.Name = "Updated"
+	if err := userManager.UpdateUser(context.Background(), user); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := auditManager.SearchAuditEvents(context.Background(), &AuditSearch{Text: "password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, event := range events {
+		if strings.Contains(event.BeforeState+event.AfterState, `"Password":"password"`) {
+			t.Errorf("Expected %v not to record the password", event.Action)
+		}
+	}
+}
+
+func TestAnonymizingAccountRedactsItsAuditStates(t *testing.T) {
+	auditManager, userManager, _ := initAuditManager()
+	defer cleanDatabase()
+	accountManager := &AccountManager{Datasource: auditManager.Datasource}
+
+	user := writeAccountTestUser(t, userManager, 0, SAMARITAN)
+	accountManager.CloseAccount(context.Background(), user)
+	accountManager.AnonymizeClosedAccounts(context.Background(), time.Now().Add(ACCOUNT_CLOSURE_GRACE_PERIOD+time.Hour))
+
+	events, err := auditManager.SearchAuditEvents(context.Background(), &AuditSearch{Entity: AUDIT_USER, EntityID: user.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) == 0 || events[0].Action != AUDIT_ACCOUNT_ANONYMIZE {
+		t.Fatalf("Expected the anonymization to be recorded, got %v", events)
+	}
+
+	for _, event := range events {
+		if strings.Contains(event.BeforeState+event.AfterState, user.Email) {
+			t.Errorf("Expected %v to be redacted", event.Action)
+		}
+	}
+}
+
+func TestItPagesAndExportsAuditEvents(t *testing.T) {
+	auditManager, _, itemManager := initAuditManager()
+	defer cleanDatabase()
+
+	for i := 0; i < 3; i++ {
+		itemManager.WriteItem(context.Background(), generateItem())
+	}
+
+	page, err := auditManager.SearchAuditEvents(context.Background(), &AuditSearch{Action: AUDIT_ITEM_CREATE, Limit: 2, Offset: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page) != 1 {
+		t.Errorf("Expected 1 event on the second page, got %v", len(page))
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := auditManager.WriteAuditCSV(context.Background(), &AuditSearch{Action: AUDIT_ITEM_CREATE, Limit: 1}, buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 4 || records[0][0] != "ID" || records[1][5] != AUDIT_ITEM_CREATE {
+		t.Errorf("Expected a header and 3 events, got %v", records)
+	}
+}
+
+func TestAuditExportsAreNotRunAsFormulas(t *testing.T) {
+	auditManager, _, itemManager := initAuditManager()
+	defer cleanDatabase()
+
+	ctx := WithAuditActor(context.Background(), &AuditActor{Session: "-2+3", IPAddress: "=1+1"})
+	if _, err := itemManager.WriteItem(ctx, generateItem()); err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := auditManager.WriteAuditCSV(context.Background(), &AuditSearch{Action: AUDIT_ITEM_CREATE}, buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != 2 || records[1][3] != "'-2+3" || records[1][4] != "'=1+1" {
+		t.Errorf("Expected formula-like cells to be quoted, got %v", records)
+	}
+}
+
+func TestAuditTextIsSearchedLiterally(t *testing.T) {
+	auditManager, _, itemManager := initAuditManager()
+	defer cleanDatabase()
+
+	for _, ipAddress := range []string{"10.0.0.1", "10_0_0_1"} {
+		ctx := WithAuditActor(context.Background(), &AuditActor{IPAddress: ipAddress})
+		if _, err := itemManager.WriteItem(ctx, generateItem()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string]int{"10_0": 1, "10%1": 0, "%": 0, `\`: 0}
+	for text, expected := range tests {
+		events, err := auditManager.SearchAuditEvents(context.Background(), &AuditSearch{Text: text})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(events) != expected {
+			t.Errorf("Expected %v to match %v events, got %v", text, expected, len(events))
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package managers
+
+import "strings"
+
+// formulaPrefixes are how cells start when spreadsheets would run them as formulas.
+const formulaPrefixes = "=+-@\t\r"
+
+// spreadsheetSafe keeps the cells of an export from being run as formulas when it's opened in a
+// spreadsheet, by prefixing any that start like one with a quote, which spreadsheets hide.
+func spreadsheetSafe(record []string) []string {
+	safe := make([]string, len(record))
+	for i, cell := range record {
+		if cell != "" && strings.ContainsRune(formulaPrefixes, rune(cell[0])) {
+			cell = "'" + cell
+		}
+		safe[i] = cell
+	}
+	return safe
+}
+
+// fromSpreadsheetSafe undoes spreadsheetSafe for a cell read back in.
+func fromSpreadsheetSafe(cell string) string {
+	if len(cell) > 1 && cell[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(cell[1])) {
+		return cell[1:]
+	}
+	return cell
+}
@@ -0,0 +1,138 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var upsertDeliveryCodeQuery = database.Insert("deliveryCodes", "ItemID", "SamaritanID", "CodeHash", "CreatedTime").OnConflict("ItemID").DoUpdate("SamaritanID", "CodeHash", "CreatedTime")
+var getDeliveryCodeQuery = database.Select("deliveryCodes", "deliveryCodes.ItemID", "deliveryCodes.SamaritanID", "deliveryCodes.CreatedTime").Join("items ON items.ID = deliveryCodes.ItemID").Where("deliveryCodes.CodeHash = ?", "items.ShelterID = ?", "deliveryCodes.UsedTime IS NULL", "items.DeletedTime IS NULL")
+var useDeliveryCodeQuery = database.Update("deliveryCodes").Set("ReceiverID", "UsedTime").Where("ItemID = ?", "UsedTime IS NULL")
+var getDeliveryReceiptQuery = database.Select("deliveryCodes", "ItemID", "SamaritanID", "ReceiverID", "UsedTime").Where("ItemID = ?", "UsedTime IS NOT NULL")
+
+const DELIVERY_CODE_LENGTH = 8
+
+// DELIVERY_CODE_LIFETIME is how long a samaritan has to drop an item off with the code they were given.
+const DELIVERY_CODE_LIFETIME = 7 * 24 * time.Hour
+
+var ErrInvalidDeliveryCode error = &ValidationError{Field: "Code", Message: "That delivery code isn't valid. It may have expired, been replaced by a newer code or been used already"}
+var ErrItemNotAwaitingDelivery error = &ConflictError{Message: "Delivery codes are only given out for items that have been claimed and not yet received"}
+
+// DeliveryManager confirms drop-offs. The samaritan who claimed an item is given a one-time code,
+// and the shelter enters it at intake to mark the item received. Only a hash of the code is kept,
+// so a new code is issued each time the samaritan asks for one, replacing the last.
+type DeliveryManager struct {
+	Datasource database.Datasource
+}
+
+// DeliveryReceipt records who received a delivered item, and when.
+type DeliveryReceipt struct {
+	ItemID       int64
+	SamaritanID  int64
+	ReceiverID   int64
+	ReceivedTime int64
+}
+
+// IssueDeliveryCode gives the item's samaritan a new delivery code.
+func (dm *DeliveryManager) IssueDeliveryCode(ctx context.Context, item *Item) (string, error) {
+	if !item.IsAwaitingDelivery() {
+		return "", ErrItemNotAwaitingDelivery
+	}
+
+	code, err := generateReadableCode(DELIVERY_CODE_LENGTH)
+	if err != nil {
+		return "", err
+	}
+
+	values := []interface{}{item.ID, item.SamaritanID, HashSecret(normalizeReadableCode(code)), time.Now().Unix()}
+	if _, err := dm.Datasource.ExecuteWriteQuery(ctx, upsertDeliveryCodeQuery, values); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// ConfirmDelivery marks the item a code was issued for as received by receiverID, which must be
+// the item's shelter. It returns the item as it was and as it is now.
+func (dm *DeliveryManager) ConfirmDelivery(ctx context.Context, receiverID int64, code string) (*Item, *Item, error) {
+	var previousItem *Item
+	var receivedItem *Item
+	err := dm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		var itemID int64
+		var samaritanID int64
+		var createdTime int64
+		row := tx.ExecuteSingleReadQuery(ctx, getDeliveryCodeQuery, []interface{}{HashSecret(normalizeReadableCode(code)), receiverID})
+		if err := row.Scan(&itemID, &samaritanID, &createdTime); err != nil {
+			if err == sql.ErrNoRows {
+				return ErrInvalidDeliveryCode
+			}
+			return err
+		}
+
+		now := time.Now()
+		if now.Sub(time.Unix(createdTime, 0)) > DELIVERY_CODE_LIFETIME {
+			return ErrInvalidDeliveryCode
+		}
+
+		itemManager := &ItemManager{Datasource: tx}
+		item, err := itemManager.GetItem(ctx, itemID)
+		if err != nil {
+			return err
+		}
+
+		// Codes issued before the item was unclaimed, or claimed by someone else, don't count.
+		if !item.IsAwaitingDelivery() || item.SamaritanID != samaritanID {
+			return ErrInvalidDeliveryCode
+		}
+
+		previous := *item
+		item.Status = RECEIVED
+		if err := itemManager.UpdateItem(ctx, item); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecuteWriteQuery(ctx, useDeliveryCodeQuery, []interface{}{receiverID, now.Unix(), itemID}); err != nil {
+			return err
+		}
+
+		receipt := &DeliveryReceipt{ItemID: itemID, SamaritanID: samaritanID, ReceiverID: receiverID, ReceivedTime: now.Unix()}
+		previousItem, receivedItem = &previous, item
+		return recordAuditEvent(ctx, tx, AUDIT_ITEM_RECEIVE, AUDIT_ITEM, itemID, nil, receipt)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return previousItem, receivedItem, nil
+}
+
+// GetDeliveryReceipt returns the receipt for an item received with a delivery code, or nil if it
+// wasn't.
+func (dm *DeliveryManager) GetDeliveryReceipt(ctx context.Context, itemID int64) (*DeliveryReceipt, error) {
+	receipt := &DeliveryReceipt{}
+	row := dm.Datasource.ExecuteSingleReadQuery(ctx, getDeliveryReceiptQuery, []interface{}{itemID})
+	var receiverID sql.NullInt64
+	if err := row.Scan(&receipt.ItemID, &receipt.SamaritanID, &receiverID, &receipt.ReceivedTime); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	receipt.ReceiverID = receiverID.Int64
+	return receipt, nil
+}
+
+// IsAwaitingDelivery reports whether the item has been claimed but not yet received.
+func (item *Item) IsAwaitingDelivery() bool {
+	return item.SamaritanID > 0 && (item.Status == CLAIMED || item.Status == DELIVERED)
+}
+
+// ClaimDeadline is when the item's samaritan should drop it off by. It's the zero time if the item
+// isn't awaiting delivery, or was claimed before claim times were kept.
+func (item *Item) ClaimDeadline() time.Time {
+	if !item.IsAwaitingDelivery() || item.ClaimedTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(item.ClaimedTime, 0).Add(CLAIM_PERIOD)
+}
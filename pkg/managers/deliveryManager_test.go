@@ -0,0 +1,103 @@
+package managers
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func writeClaimedItem(t *testing.T, itemManager *ItemManager, samaritanID int64) *Item {
+	item := generateItem()
+	id, err := itemManager.WriteItem(context.Background(), item)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item.ID = id
+	item.Status = CLAIMED
+	item.SamaritanID = samaritanID
+	if err := itemManager.UpdateItem(context.Background(), item); err != nil {
+		t.Fatal(err)
+	}
+	return item
+}
+
+func TestDeliveryCodesMarkItemsReceivedOnce(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &DeliveryManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+	item := writeClaimedItem(t, itemManager, 42)
+
+	code, err := manager.IssueDeliveryCode(ctx, item)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := manager.ConfirmDelivery(ctx, testShelterID+1, code); err != ErrInvalidDeliveryCode {
+		t.Errorf("Expected other shelters to be unable to use the code, got %v", err)
+	}
+
+	previousItem, receivedItem, err := manager.ConfirmDelivery(ctx, testShelterID, strings.ToUpper(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if previousItem.Status != CLAIMED || receivedItem.Status != RECEIVED {
+		t.Errorf("Expected the item to go from claimed to received, got %v to %v", previousItem.Status, receivedItem.Status)
+	}
+
+	receipt, err := manager.GetDeliveryReceipt(ctx, item.ID)
+	if err != nil || receipt == nil || receipt.ReceiverID != testShelterID || receipt.SamaritanID != 42 || receipt.ReceivedTime == 0 {
+		t.Errorf("Expected who received the item to be recorded, got %+v %v", receipt, err)
+	}
+
+	if _, _, err := manager.ConfirmDelivery(ctx, testShelterID, code); err != ErrInvalidDeliveryCode {
+		t.Errorf("Expected the code to only work once, got %v", err)
+	}
+}
+
+func TestNewDeliveryCodesReplaceOldOnes(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &DeliveryManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+	item := writeClaimedItem(t, itemManager, 42)
+
+	oldCode, _ := manager.IssueDeliveryCode(ctx, item)
+	newCode, _ := manager.IssueDeliveryCode(ctx, item)
+	if _, _, err := manager.ConfirmDelivery(ctx, testShelterID, oldCode); err != ErrInvalidDeliveryCode {
+		t.Errorf("Expected the old code to be replaced, got %v", err)
+	}
+
+	if receipt, _ := manager.GetDeliveryReceipt(ctx, item.ID); receipt != nil {
+		t.Errorf("Expected no receipt before the item is received, got %+v", receipt)
+	}
+
+	if _, _, err := manager.ConfirmDelivery(ctx, testShelterID, newCode); err != nil {
+		t.Errorf("Expected the new code to work, got %v", err)
+	}
+}
+
+func TestDeliveryCodesNeedAClaimedItem(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &DeliveryManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+	item := writeClaimedItem(t, itemManager, 42)
+
+	code, _ := manager.IssueDeliveryCode(ctx, item)
+	item.Status = CREATED
+	item.SamaritanID = 0
+	if err := itemManager.UpdateItem(ctx, item); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := manager.IssueDeliveryCode(ctx, item); err != ErrItemNotAwaitingDelivery {
+		t.Errorf("Expected no code for an unclaimed item, got %v", err)
+	}
+
+	if _, _, err := manager.ConfirmDelivery(ctx, testShelterID, code); err != ErrInvalidDeliveryCode {
+		t.Errorf("Expected codes to stop working once the item is unclaimed, got %v", err)
+	}
+}
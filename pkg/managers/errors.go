@@ -0,0 +1,43 @@
+package managers
+
+import "fmt"
+
+// NotFoundError is returned when the record asked for doesn't exist, or has been deleted.
+type NotFoundError struct {
+	Entity string
+	ID     interface{}
+}
+
+func (err *NotFoundError) Error() string {
+	return fmt.Sprintf("%s %v was not found", err.Entity, err.ID)
+}
+
+// ValidationError is returned when a request can't be carried out as given. Field names the
+// field at fault, when there is one.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	return err.Message
+}
+
+// ConflictError is returned when a change can't be made because of the record's current state,
+// such as a duplicate email or an update based on an old version.
+type ConflictError struct {
+	Message string
+}
+
+func (err *ConflictError) Error() string {
+	return err.Message
+}
+
+// ForbiddenError is returned when the user is signed in but isn't allowed to make a change.
+type ForbiddenError struct {
+	Message string
+}
+
+func (err *ForbiddenError) Error() string {
+	return err.Message
+}
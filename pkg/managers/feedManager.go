@@ -0,0 +1,53 @@
+package managers
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var upsertFeedTokenQuery = database.Insert("feedTokens", "UserID", "TokenHash", "CreatedTime").OnConflict("UserID").DoUpdate("TokenHash", "CreatedTime")
+var getFeedTokenOwnerQuery = database.Select("feedTokens", "users.ID", "users.UserType").Join("users ON users.ID = feedTokens.UserID").Where("feedTokens.TokenHash = ?", "users.DeletedTime IS NULL")
+
+const FEED_TOKEN_BYTES = 32
+
+// FeedManager issues the secrets in private feed URLs, which calendar apps and feed readers fetch
+// without logging in. Only a hash of each token is stored, and issuing a new one replaces the
+// last, so a leaked URL stops working once the user asks for another.
+type FeedManager struct {
+	Datasource database.Datasource
+}
+
+// IssueFeedToken gives the user a new feed token.
+func (fm *FeedManager) IssueFeedToken(ctx context.Context, userID int64) (string, error) {
+	token := make([]byte, FEED_TOKEN_BYTES)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	plaintext := base64.RawURLEncoding.EncodeToString(token)
+	values := []interface{}{userID, HashSecret(plaintext), time.Now().Unix()}
+	if _, err := fm.Datasource.ExecuteWriteQuery(ctx, upsertFeedTokenQuery, values); err != nil {
+		return "", err
+	}
+	return plaintext, nil
+}
+
+// GetFeedTokenOwner returns the ID and type of the user the token was issued to, or 0 if it isn't
+// their current token.
+func (fm *FeedManager) GetFeedTokenOwner(ctx context.Context, plaintext string) (int64, UserType, error) {
+	var userID int64
+	var userType UserType
+	row := fm.Datasource.ExecuteSingleReadQuery(ctx, getFeedTokenOwnerQuery, []interface{}{HashSecret(plaintext)})
+	if err := row.Scan(&userID, &userType); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	return userID, userType, nil
+}
@@ -0,0 +1,32 @@
+package managers
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewFeedTokensReplaceOldOnes(t *testing.T) {
+	apiTokenManager, userID := initApiTokenManager()
+	defer cleanDatabase()
+	manager := &FeedManager{Datasource: apiTokenManager.Datasource}
+	ctx := context.Background()
+
+	oldToken, err := manager.IssueFeedToken(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newToken, err := manager.IssueFeedToken(ctx, userID)
+	if err != nil || newToken == oldToken {
+		t.Fatalf("Expected a new token, got %v: %v", newToken, err)
+	}
+
+	ownerID, userType, err := manager.GetFeedTokenOwner(ctx, newToken)
+	if err != nil || ownerID != userID || userType != SHELTER {
+		t.Errorf("Expected the token to belong to shelter %v, got %v %v: %v", userID, ownerID, userType, err)
+	}
+
+	if ownerID, _, err := manager.GetFeedTokenOwner(ctx, oldToken); err != nil || ownerID != 0 {
+		t.Errorf("Expected the replaced token to stop working, got %v: %v", ownerID, err)
+	}
+}
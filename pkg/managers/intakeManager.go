@@ -0,0 +1,363 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+	// Shelters pick their time zone by name, which needs the zone database even where the host
+	// doesn't have one installed.
+	_ "time/tzdata"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var getIntakeScheduleQuery = database.Select("intakeSchedules", "TimeZone", "SlotMinutes", "Instructions").Where("ShelterID = ?")
+var lockIntakeScheduleQuery = database.Select("intakeSchedules", "ShelterID").Where("ShelterID = ?").ForUpdate()
+var upsertIntakeScheduleQuery = database.Insert("intakeSchedules", "ShelterID", "TimeZone", "SlotMinutes", "Instructions").OnConflict("ShelterID").DoUpdate("TimeZone", "SlotMinutes", "Instructions")
+var getIntakeHoursQuery = database.Select("intakeHours", "Weekday", "OpensAt", "ClosesAt", "Capacity").Where("ShelterID = ?").OrderBy("Weekday", "OpensAt")
+var createIntakeHoursQuery = database.Insert("intakeHours", "ShelterID", "Weekday", "OpensAt", "ClosesAt", "Capacity")
+var deleteIntakeHoursQuery = database.Delete("intakeHours").Where("ShelterID = ?")
+var getBlackoutDatesQuery = database.Select("blackoutDates", "Day", "Reason").Where("ShelterID = ?").OrderBy("Day")
+var createBlackoutDateQuery = database.Insert("blackoutDates", "ShelterID", "Day", "Reason")
+var deleteBlackoutDatesQuery = database.Delete("blackoutDates").Where("ShelterID = ?")
+var upsertDropOffQuery = database.Insert("dropOffs", "ItemID", "ShelterID", "SamaritanID", "StartTime", "EndTime", "CreatedTime").OnConflict("ItemID").DoUpdate("ShelterID", "SamaritanID", "StartTime", "EndTime", "CreatedTime")
+var deleteDropOffQuery = database.Delete("dropOffs").Where("ItemID = ?")
+
+// Bookings only count while their item is still claimed by the samaritan who made them, so an
+// unclaimed or reclaimed item gives its slot back without anything having to clean up after it.
+var getDropOffQuery = database.Select("dropOffs", "dropOffs.ItemID", "dropOffs.ShelterID", "dropOffs.SamaritanID", "dropOffs.StartTime", "dropOffs.EndTime").Join("items ON items.ID = dropOffs.ItemID").Where("dropOffs.ItemID = ?", "items.SamaritanID = dropOffs.SamaritanID", activeDropOffCondition, "items.DeletedTime IS NULL")
+var countDropOffsQuery = database.Select("dropOffs", "dropOffs.StartTime", "COUNT(*)").Join("items ON items.ID = dropOffs.ItemID").Where("dropOffs.ShelterID = ?", "dropOffs.StartTime >= ?", "dropOffs.ItemID <> ?", "items.SamaritanID = dropOffs.SamaritanID", activeDropOffCondition, "items.DeletedTime IS NULL").GroupBy("dropOffs.StartTime")
+
+var activeDropOffCondition = fmt.Sprintf("items.Status IN (%d, %d)", CLAIMED, DELIVERED)
+
+const DEFAULT_INTAKE_TIME_ZONE = "America/New_York"
+const DEFAULT_SLOT_MINUTES = 60
+const MIN_SLOT_MINUTES = 15
+const MAX_SLOT_MINUTES = 240
+const MAX_SLOT_CAPACITY = 100
+
+// DROP_OFF_BOOKING_DAYS is how far ahead samaritans can book a drop-off.
+const DROP_OFF_BOOKING_DAYS = 14
+
+// BLACKOUT_DATE_FORMAT is how blackout dates are written, in the shelter's time zone.
+const BLACKOUT_DATE_FORMAT = "2006-01-02"
+
+var ErrDropOffSlotRequired error = &ValidationError{Field: "DropOffTime", Message: "Pick a time to drop the item off"}
+var ErrDropOffSlotUnavailable error = &ValidationError{Field: "DropOffTime", Message: "That drop-off time isn't available. It may be full or outside the shelter's intake hours"}
+
+// IntakeManager keeps the hours shelters take drop-offs in, and books samaritans into them. Each
+// day's hours are split into slots of SlotMinutes, and each slot takes up to the hours' Capacity
+// drop-offs.
+type IntakeManager struct {
+	Datasource database.Datasource
+}
+
+type IntakeSchedule struct {
+	ShelterID    int64
+	TimeZone     string
+	SlotMinutes  int
+	Instructions string
+	Hours        []*IntakeHours
+	// BlackoutDates are days the shelter doesn't take drop-offs, even in its usual hours.
+	BlackoutDates []*BlackoutDate
+}
+
+type IntakeHours struct {
+	Weekday time.Weekday
+	// OpensAt and ClosesAt are minutes after midnight in the shelter's time zone.
+	OpensAt  int
+	ClosesAt int
+	Capacity int
+}
+
+type BlackoutDate struct {
+	Day    string
+	Reason string
+}
+
+// DropOffSlot is a time samaritans can book, and how many more drop-offs it can take.
+type DropOffSlot struct {
+	Start     time.Time
+	End       time.Time
+	Remaining int
+}
+
+// DropOff is the slot a claimed item is to be dropped off in.
+type DropOff struct {
+	ItemID      int64
+	ShelterID   int64
+	SamaritanID int64
+	StartTime   int64
+	EndTime     int64
+}
+
+// TakesBookings reports whether samaritans have to pick a slot to drop items off. Shelters that
+// haven't set any hours take drop-offs whenever they're open.
+func (schedule *IntakeSchedule) TakesBookings() bool {
+	return len(schedule.Hours) > 0
+}
+
+// Location returns the shelter's time zone.
+func (schedule *IntakeSchedule) Location() *time.Location {
+	location, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Opens and Closes write the hours' times like 09:30.
+func (hours *IntakeHours) Opens() string {
+	return formatMinutes(hours.OpensAt)
+}
+
+// Closes writes midnight as 00:00, which is how time inputs take it.
+func (hours *IntakeHours) Closes() string {
+	return formatMinutes(hours.ClosesAt % (24 * 60))
+}
+
+func formatMinutes(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// In returns the drop-off's slot in the shelter's time zone.
+func (dropOff *DropOff) In(schedule *IntakeSchedule) *DropOffSlot {
+	location := schedule.Location()
+	return &DropOffSlot{Start: time.Unix(dropOff.StartTime, 0).In(location), End: time.Unix(dropOff.EndTime, 0).In(location)}
+}
+
+// GetIntakeSchedule returns the shelter's schedule, or an empty default if it hasn't set one.
+func (im *IntakeManager) GetIntakeSchedule(ctx context.Context, shelterID int64) (*IntakeSchedule, error) {
+	schedule := &IntakeSchedule{ShelterID: shelterID, TimeZone: DEFAULT_INTAKE_TIME_ZONE, SlotMinutes: DEFAULT_SLOT_MINUTES, Hours: make([]*IntakeHours, 0), BlackoutDates: make([]*BlackoutDate, 0)}
+	row := im.Datasource.ExecuteSingleReadQuery(ctx, getIntakeScheduleQuery, []interface{}{shelterID})
+	if err := row.Scan(&schedule.TimeZone, &schedule.SlotMinutes, &schedule.Instructions); err != nil {
+		if err == sql.ErrNoRows {
+			return schedule, nil
+		}
+		return nil, err
+	}
+
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, getIntakeHoursQuery, []interface{}{shelterID})
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	for result.Next() {
+		hours := &IntakeHours{}
+		if err := result.Scan(&hours.Weekday, &hours.OpensAt, &hours.ClosesAt, &hours.Capacity); err != nil {
+			return nil, err
+		}
+		schedule.Hours = append(schedule.Hours, hours)
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+
+	blackouts, err := im.Datasource.ExecuteBatchReadQuery(ctx, getBlackoutDatesQuery, []interface{}{shelterID})
+	if err != nil {
+		return nil, err
+	}
+	defer blackouts.Close()
+	for blackouts.Next() {
+		blackout := &BlackoutDate{}
+		if err := blackouts.Scan(&blackout.Day, &blackout.Reason); err != nil {
+			return nil, err
+		}
+		schedule.BlackoutDates = append(schedule.BlackoutDates, blackout)
+	}
+	return schedule, blackouts.Err()
+}
+
+// SaveIntakeSchedule replaces the shelter's schedule. Drop-offs already booked are kept, even if
+// they no longer fit.
+func (im *IntakeManager) SaveIntakeSchedule(ctx context.Context, schedule *IntakeSchedule) error {
+	return im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		previous, err := (&IntakeManager{Datasource: tx}).GetIntakeSchedule(ctx, schedule.ShelterID)
+		if err != nil {
+			return err
+		}
+
+		values := []interface{}{schedule.ShelterID, schedule.TimeZone, schedule.SlotMinutes, schedule.Instructions}
+		if _, err := tx.ExecuteWriteQuery(ctx, upsertIntakeScheduleQuery, values); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecuteWriteQuery(ctx, deleteIntakeHoursQuery, []interface{}{schedule.ShelterID}); err != nil {
+			return err
+		}
+		for _, hours := range schedule.Hours {
+			values := []interface{}{schedule.ShelterID, int(hours.Weekday), hours.OpensAt, hours.ClosesAt, hours.Capacity}
+			if _, err := tx.ExecuteWriteQuery(ctx, createIntakeHoursQuery, values); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ExecuteWriteQuery(ctx, deleteBlackoutDatesQuery, []interface{}{schedule.ShelterID}); err != nil {
+			return err
+		}
+		for _, blackout := range schedule.BlackoutDates {
+			if _, err := tx.ExecuteWriteQuery(ctx, createBlackoutDateQuery, []interface{}{schedule.ShelterID, blackout.Day, blackout.Reason}); err != nil {
+				return err
+			}
+		}
+
+		return recordAuditEvent(ctx, tx, AUDIT_INTAKE_SCHEDULE_UPDATE, AUDIT_USER, schedule.ShelterID, previous, schedule)
+	})
+}
+
+// AvailableSlots returns the slots samaritans can book with the shelter from now until
+// DROP_OFF_BOOKING_DAYS ahead, leaving out those that are full.
+func (im *IntakeManager) AvailableSlots(ctx context.Context, schedule *IntakeSchedule, now time.Time) ([]*DropOffSlot, error) {
+	return im.availableSlots(ctx, schedule, now, 0)
+}
+
+// availableSlots leaves the booking for itemID out of the counts, so it can be moved to any slot
+// with room, including the one it's in.
+func (im *IntakeManager) availableSlots(ctx context.Context, schedule *IntakeSchedule, now time.Time, itemID int64) ([]*DropOffSlot, error) {
+	booked := make(map[int64]int)
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, countDropOffsQuery, []interface{}{schedule.ShelterID, now.Unix(), itemID})
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	for result.Next() {
+		var startTime int64
+		var count int
+		if err := result.Scan(&startTime, &count); err != nil {
+			return nil, err
+		}
+		booked[startTime] = count
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+	return buildDropOffSlots(schedule, booked, now, DROP_OFF_BOOKING_DAYS), nil
+}
+
+// buildDropOffSlots lays the schedule's hours out over the days from now, skipping blackout dates,
+// slots that have started and slots with no room left.
+func buildDropOffSlots(schedule *IntakeSchedule, booked map[int64]int, now time.Time, days int) []*DropOffSlot {
+	slots := make([]*DropOffSlot, 0)
+	if schedule.SlotMinutes <= 0 {
+		return slots
+	}
+
+	blackouts := make(map[string]bool)
+	for _, blackout := range schedule.BlackoutDates {
+		blackouts[blackout.Day] = true
+	}
+
+	location := schedule.Location()
+	today := now.In(location)
+	for offset := 0; offset < days; offset++ {
+		day := time.Date(today.Year(), today.Month(), today.Day()+offset, 0, 0, 0, 0, location)
+		if blackouts[day.Format(BLACKOUT_DATE_FORMAT)] {
+			continue
+		}
+
+		for _, hours := range schedule.Hours {
+			if hours.Weekday != day.Weekday() {
+				continue
+			}
+
+			for minute := hours.OpensAt; minute+schedule.SlotMinutes <= hours.ClosesAt; minute += schedule.SlotMinutes {
+				start := time.Date(day.Year(), day.Month(), day.Day(), 0, minute, 0, 0, location)
+				remaining := hours.Capacity - booked[start.Unix()]
+				if !start.After(now) || remaining <= 0 {
+					continue
+				}
+				slots = append(slots, &DropOffSlot{Start: start, End: start.Add(time.Duration(schedule.SlotMinutes) * time.Minute), Remaining: remaining})
+			}
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Start.Before(slots[j].Start) })
+	return slots
+}
+
+// UpdateItemAndBookDropOff saves a samaritan's changes to an item they're claiming and books it
+// into the slot starting at start, all or nothing. The slot is only booked once the update has
+// passed its version check, so a samaritan who loses a race to claim the item can't replace the
+// winner's booking.
+func (im *IntakeManager) UpdateItemAndBookDropOff(ctx context.Context, item *Item, start time.Time) (*DropOff, error) {
+	var dropOff *DropOff
+	err := im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		if err := (&ItemManager{Datasource: tx}).UpdateItem(ctx, item); err != nil {
+			return err
+		}
+
+		var err error
+		dropOff, err = (&IntakeManager{Datasource: tx}).BookDropOff(ctx, item, start)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dropOff, nil
+}
+
+// BookDropOff books the item into the slot starting at start, replacing any slot it was booked in.
+// The slot has to be one AvailableSlots would offer. The shelter's schedule stays locked until the
+// booking is saved, so two samaritans can't both take the last place in a slot.
+func (im *IntakeManager) BookDropOff(ctx context.Context, item *Item, start time.Time) (*DropOff, error) {
+	var dropOff *DropOff
+	err := im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		var shelterID int64
+		row := tx.ExecuteSingleReadQuery(ctx, lockIntakeScheduleQuery, []interface{}{item.ShelterID})
+		if err := row.Scan(&shelterID); err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		intakeManager := &IntakeManager{Datasource: tx}
+		schedule, err := intakeManager.GetIntakeSchedule(ctx, item.ShelterID)
+		if err != nil {
+			return err
+		}
+
+		slots, err := intakeManager.availableSlots(ctx, schedule, time.Now(), item.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, slot := range slots {
+			if slot.Start.Equal(start) {
+				dropOff = &DropOff{ItemID: item.ID, ShelterID: item.ShelterID, SamaritanID: item.SamaritanID, StartTime: slot.Start.Unix(), EndTime: slot.End.Unix()}
+				break
+			}
+		}
+		if dropOff == nil {
+			return ErrDropOffSlotUnavailable
+		}
+
+		values := []interface{}{dropOff.ItemID, dropOff.ShelterID, dropOff.SamaritanID, dropOff.StartTime, dropOff.EndTime, time.Now().Unix()}
+		if _, err := tx.ExecuteWriteQuery(ctx, upsertDropOffQuery, values); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_ITEM_SCHEDULE_DROP_OFF, AUDIT_ITEM, item.ID, nil, dropOff)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dropOff, nil
+}
+
+// GetDropOff returns the slot the item is booked in, or nil if it isn't booked.
+func (im *IntakeManager) GetDropOff(ctx context.Context, itemID int64) (*DropOff, error) {
+	dropOff := &DropOff{}
+	row := im.Datasource.ExecuteSingleReadQuery(ctx, getDropOffQuery, []interface{}{itemID})
+	if err := row.Scan(&dropOff.ItemID, &dropOff.ShelterID, &dropOff.SamaritanID, &dropOff.StartTime, &dropOff.EndTime); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return dropOff, nil
+}
+
+// CancelDropOff gives up the item's slot.
+func (im *IntakeManager) CancelDropOff(ctx context.Context, itemID int64) error {
+	_, err := im.Datasource.ExecuteWriteQuery(ctx, deleteDropOffQuery, []interface{}{itemID})
+	return err
+}
@@ -0,0 +1,148 @@
+package managers
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func generateIntakeSchedule() *IntakeSchedule {
+	schedule := &IntakeSchedule{ShelterID: testShelterID, TimeZone: "America/Chicago", SlotMinutes: 60, Instructions: "Ring the bell by the side door"}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		schedule.Hours = append(schedule.Hours, &IntakeHours{Weekday: weekday, OpensAt: 0, ClosesAt: 24 * 60, Capacity: 1})
+	}
+	return schedule
+}
+
+func TestSlotsFollowIntakeHoursAndBlackouts(t *testing.T) {
+	schedule := &IntakeSchedule{
+		TimeZone:      "America/Chicago",
+		SlotMinutes:   90,
+		Hours:         []*IntakeHours{{Weekday: time.Tuesday, OpensAt: 9 * 60, ClosesAt: 12 * 60, Capacity: 2}, {Weekday: time.Monday, OpensAt: 13 * 60, ClosesAt: 16 * 60, Capacity: 1}},
+		BlackoutDates: []*BlackoutDate{{Day: "2024-03-12", Reason: "Inventory"}},
+	}
+	location := schedule.Location()
+	// Monday the 4th, after the first of its slots has started.
+	now := time.Date(2024, time.March, 4, 13, 30, 0, 0, location)
+	booked := map[int64]int{time.Date(2024, time.March, 5, 10, 30, 0, 0, location).Unix(): 1}
+
+	slots := buildDropOffSlots(schedule, booked, now, 9)
+	expected := []time.Time{
+		time.Date(2024, time.March, 4, 14, 30, 0, 0, location),
+		time.Date(2024, time.March, 5, 9, 0, 0, 0, location),
+		time.Date(2024, time.March, 5, 10, 30, 0, 0, location),
+		time.Date(2024, time.March, 11, 13, 0, 0, 0, location),
+		time.Date(2024, time.March, 11, 14, 30, 0, 0, location),
+	}
+	if len(slots) != len(expected) {
+		t.Fatalf("Expected %d slots, got %d", len(expected), len(slots))
+	}
+
+	for i, slot := range slots {
+		if !slot.Start.Equal(expected[i]) || slot.End.Sub(slot.Start) != 90*time.Minute {
+			t.Errorf("Expected slot %d to run 90 minutes from %v, got %v to %v", i, expected[i], slot.Start, slot.End)
+		}
+	}
+
+	if slots[1].Remaining != 2 || slots[2].Remaining != 1 {
+		t.Errorf("Expected booked drop-offs to take up capacity, got %d and %d left", slots[1].Remaining, slots[2].Remaining)
+	}
+}
+
+func TestDropOffsAreBookedWithinCapacity(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &IntakeManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+	if err := manager.SaveIntakeSchedule(ctx, generateIntakeSchedule()); err != nil {
+		t.Fatal(err)
+	}
+
+	schedule, err := manager.GetIntakeSchedule(ctx, testShelterID)
+	if err != nil || len(schedule.Hours) != 7 || schedule.Instructions != "Ring the bell by the side door" || schedule.Location().String() != "America/Chicago" {
+		t.Fatalf("Expected the saved schedule back, got %+v %v", schedule, err)
+	}
+
+	slots, err := manager.AvailableSlots(ctx, schedule, time.Now())
+	if err != nil || len(slots) == 0 {
+		t.Fatalf("Expected open slots, got %v %v", slots, err)
+	}
+
+	first := writeClaimedItem(t, itemManager, 42)
+	second := writeClaimedItem(t, itemManager, 43)
+	if _, err := manager.BookDropOff(ctx, first, slots[0].Start); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := manager.BookDropOff(ctx, second, slots[0].Start); err != ErrDropOffSlotUnavailable {
+		t.Errorf("Expected a full slot to be refused, got %v", err)
+	}
+
+	if _, err := manager.BookDropOff(ctx, first, slots[0].Start); err != nil {
+		t.Errorf("Expected an item to be able to keep its own slot, got %v", err)
+	}
+
+	dropOff, err := manager.GetDropOff(ctx, first.ID)
+	if err != nil || dropOff == nil || dropOff.StartTime != slots[0].Start.Unix() || dropOff.SamaritanID != 42 {
+		t.Errorf("Expected the first item to be booked, got %+v %v", dropOff, err)
+	}
+
+	first.Status = CREATED
+	first.SamaritanID = 0
+	if err := itemManager.UpdateItem(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+
+	if dropOff, err := manager.GetDropOff(ctx, first.ID); dropOff != nil || err != nil {
+		t.Errorf("Expected unclaiming to give up the slot, got %+v %v", dropOff, err)
+	}
+
+	if _, err := manager.BookDropOff(ctx, second, slots[0].Start); err != nil {
+		t.Errorf("Expected the slot to be free again, got %v", err)
+	}
+
+	if _, err := manager.BookDropOff(ctx, second, slots[0].Start.Add(-time.Minute)); err != ErrDropOffSlotUnavailable {
+		t.Errorf("Expected times outside the schedule to be refused, got %v", err)
+	}
+}
+
+func TestLosingAClaimRaceLeavesTheWinnersBooking(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &IntakeManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+	if err := manager.SaveIntakeSchedule(ctx, generateIntakeSchedule()); err != nil {
+		t.Fatal(err)
+	}
+
+	schedule, err := manager.GetIntakeSchedule(ctx, testShelterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slots, err := manager.AvailableSlots(ctx, schedule, time.Now())
+	if err != nil || len(slots) < 2 {
+		t.Fatalf("Expected open slots, got %v %v", slots, err)
+	}
+
+	item := generateItem()
+	if item.ID, err = itemManager.WriteItem(ctx, item); err != nil {
+		t.Fatal(err)
+	}
+
+	winner, loser := *item, *item
+	winner.Status, winner.SamaritanID = CLAIMED, 42
+	loser.Status, loser.SamaritanID = CLAIMED, 43
+	if _, err := manager.UpdateItemAndBookDropOff(ctx, &winner, slots[0].Start); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := manager.UpdateItemAndBookDropOff(ctx, &loser, slots[1].Start); err != ErrItemVersionConflict {
+		t.Errorf("Expected the stale claim to conflict, got %v", err)
+	}
+
+	dropOff, err := manager.GetDropOff(ctx, item.ID)
+	if err != nil || dropOff == nil || dropOff.SamaritanID != 42 || dropOff.StartTime != slots[0].Start.Unix() {
+		t.Errorf("Expected the winner's booking to stand, got %+v %v", dropOff, err)
+	}
+}
@@ -4,16 +4,40 @@ import (
 	"context"
 	"database/sql"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/kwhite17/Neighbors/pkg/database"
 )
 
-var createItemQuery = "INSERT INTO items (Category, Gender, Quantity, ShelterID, Size, Status) VALUES ($1, $2, $3, $4, $5, $6)"
-var deleteItemQuery = "DELETE FROM items WHERE id=$1"
-var getSingleItemQuery = "SELECT ID, Category, Gender, Quantity, ShelterID, SamaritanID, Size, Status FROM items WHERE ID=$1"
-var getAllItemsQuery = "SELECT ID, Category, Gender, Quantity, ShelterID, SamaritanID, Size, Status from items"
-var updateItemQuery = "UPDATE items SET Category = $1, Gender = $2, Quantity = $3, ShelterID = $4, SamaritanID = $5, Size = $6, Status = $7 WHERE ID = $8"
-var getItemsForShelterQuery = "SELECT ID, Category, Gender, Quantity, ShelterID, SamaritanID, Size, Status from items WHERE ShelterID = $1"
+var itemColumns = []string{"ID", "Category", "Gender", "Quantity", "Unit", "ShelterID", "SamaritanID", "Size", "Status", "Version", "DeletedTime", "CreatedTime", "ClaimedTime"}
+
+var createItemQuery = database.Insert("items", "Category", "Gender", "Quantity", "Unit", "ShelterID", "Size", "Status", "CreatedTime").Returning("ID")
+var deleteItemQuery = database.Update("items").Set("DeletedTime").SetExpression("Version = Version + 1").Where("ID = ?", "DeletedTime IS NULL")
+var restoreItemQuery = database.Update("items").SetExpression("DeletedTime = NULL", "Version = Version + 1").Where("ID = ?", "DeletedTime IS NOT NULL")
+var purgeItemQuery = database.Delete("items").Where("ID = ?", "DeletedTime IS NOT NULL")
+var getSingleItemQuery = database.Select("items", itemColumns...).Where("ID = ?", "DeletedTime IS NULL")
+var getDeletedItemQuery = database.Select("items", itemColumns...).Where("ID = ?", "DeletedTime IS NOT NULL")
+var getAllItemsQuery = database.Select("items", itemColumns...).Where("DeletedTime IS NULL")
+var updateItemQuery = database.Update("items").Set("Category", "Gender", "Quantity", "Unit", "ShelterID", "SamaritanID", "Size", "Status", "ClaimedTime").SetExpression("Version = Version + 1").Where("ID = ?", "Version = ?", "DeletedTime IS NULL")
+var getItemsForShelterQuery = database.Select("items", itemColumns...).Where("ShelterID = ?", "DeletedTime IS NULL")
+var getItemsForSamaritanQuery = database.Select("items", itemColumns...).Where("SamaritanID = ?", "DeletedTime IS NULL")
+var getOpenItemsQuery = database.Select("items", itemColumns...).Where("Status = ?", "DeletedTime IS NULL").OrderBy("CreatedTime DESC", "ID DESC").Limit()
+var getOpenItemsForShelterQuery = database.Select("items", itemColumns...).Where("ShelterID = ?", "Status = ?", "DeletedTime IS NULL").OrderBy("CreatedTime DESC", "ID DESC").Limit()
+var getItemsForWishlistQuery = database.Select("items", itemColumns...).Join("wishlistItems ON wishlistItems.ItemID = items.ID").Where("wishlistItems.WishlistID = ?", "items.DeletedTime IS NULL").OrderBy("items.Category", "items.ID")
+var getDeletedItemsForShelterQuery = database.Select("items", itemColumns...).Where("ShelterID = ?", "DeletedTime IS NOT NULL").OrderBy("DeletedTime DESC")
+var getItemsToPurgeQuery = database.Select("items", "ID").Where("DeletedTime < ?")
+var countItemsByStatusQuery = database.Select("items", "Status", "COUNT(*)").Where("DeletedTime IS NULL").GroupBy("Status")
+
+// DELETED_RECORD_RETENTION_PERIOD is how long deleted items and accounts can be restored before
+// they're purged for good.
+const DELETED_RECORD_RETENTION_PERIOD = 30 * 24 * time.Hour
+
+// CLAIM_PERIOD is how long samaritans have to drop off an item after claiming it, matching how far
+// ahead drop-offs can be booked.
+const CLAIM_PERIOD = DROP_OFF_BOOKING_DAYS * 24 * time.Hour
+
+var ErrItemVersionConflict error = &ConflictError{Message: "This item was changed by someone else. Review the latest version and try again"}
 
 type ItemManager struct {
 	Datasource database.Datasource
@@ -23,11 +47,19 @@ type Item struct {
 	ID          int64
 	Category    string
 	Gender      string
-	Quantity    int8
+	Quantity    int
+	Unit        Unit
 	ShelterID   int64
 	SamaritanID int64
 	Size        string
 	Status      ItemStatus
+	// Version is incremented on every update, and updates must name the version they were based on.
+	Version int64
+	// DeletedTime is set when the shelter deletes the item, until it is restored or purged.
+	DeletedTime int64
+	CreatedTime int64
+	// ClaimedTime is when the item's samaritan claimed it, or 0 while it's unclaimed.
+	ClaimedTime int64
 }
 
 type ItemStatus int
@@ -39,6 +71,30 @@ const (
 	RECEIVED  ItemStatus = 4
 )
 
+var itemStatusNames = map[ItemStatus]string{
+	CREATED:   "CREATED",
+	CLAIMED:   "CLAIMED",
+	DELIVERED: "DELIVERED",
+	RECEIVED:  "RECEIVED",
+}
+
+func (status ItemStatus) String() string {
+	if name, found := itemStatusNames[status]; found {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// ParseItemStatus reads a status name such as CLAIMED, ignoring case.
+func ParseItemStatus(name string) (ItemStatus, bool) {
+	for status, statusName := range itemStatusNames {
+		if strings.EqualFold(strings.TrimSpace(name), statusName) {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
 func (im *ItemManager) GetItem(ctx context.Context, id interface{}) (*Item, error) {
 	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, getSingleItemQuery, []interface{}{id})
 	if err != nil {
@@ -48,6 +104,27 @@ func (im *ItemManager) GetItem(ctx context.Context, id interface{}) (*Item, erro
 	if err != nil {
 		return nil, err
 	}
+
+	if len(item) < 1 {
+		return nil, &NotFoundError{Entity: "Item", ID: id}
+	}
+	return item[0], nil
+}
+
+// GetDeletedItem returns an item that has been deleted but not yet purged, or nil if there isn't one.
+func (im *ItemManager) GetDeletedItem(ctx context.Context, id interface{}) (*Item, error) {
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, getDeletedItemQuery, []interface{}{id})
+	if err != nil {
+		return nil, err
+	}
+	item, err := im.buildItems(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(item) < 1 {
+		return nil, nil
+	}
 	return item[0], nil
 }
 
@@ -75,27 +152,213 @@ func (im *ItemManager) GetItemsForShelter(ctx context.Context, shelterID int64)
 	return items, nil
 }
 
+// GetDeletedItemsForShelter returns the shelter's items that can still be restored, most recently
+// deleted first.
+func (im *ItemManager) GetDeletedItemsForShelter(ctx context.Context, shelterID int64) ([]*Item, error) {
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, getDeletedItemsForShelterQuery, []interface{}{shelterID})
+	if err != nil {
+		return nil, err
+	}
+	return im.buildItems(result)
+}
+
+func (im *ItemManager) GetItemsForSamaritan(ctx context.Context, samaritanID int64) ([]*Item, error) {
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, getItemsForSamaritanQuery, []interface{}{samaritanID})
+	if err != nil {
+		return nil, err
+	}
+	items, err := im.buildItems(result)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetItemsForWishlist returns the wishlist's items, whatever their status, grouped by category.
+func (im *ItemManager) GetItemsForWishlist(ctx context.Context, wishlistID int64) ([]*Item, error) {
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, getItemsForWishlistQuery, []interface{}{wishlistID})
+	if err != nil {
+		return nil, err
+	}
+	return im.buildItems(result)
+}
+
+// GetOpenItems returns up to limit unclaimed items, newest first. A shelterID of 0 returns every
+// shelter's items.
+func (im *ItemManager) GetOpenItems(ctx context.Context, shelterID int64, limit int) ([]*Item, error) {
+	query, arguments := getOpenItemsQuery, []interface{}{CREATED, limit}
+	if shelterID > 0 {
+		query, arguments = getOpenItemsForShelterQuery, []interface{}{shelterID, CREATED, limit}
+	}
+
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, query, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return im.buildItems(result)
+}
+
 func (im *ItemManager) WriteItem(ctx context.Context, item *Item) (int64, error) {
-	values := []interface{}{item.Category, item.Gender, item.Quantity, item.ShelterID, item.Size, item.Status}
-	result, err := im.Datasource.ExecuteWriteQuery(ctx, createItemQuery, values, true)
+	item.CreatedTime = time.Now().Unix()
+	values := []interface{}{item.Category, item.Gender, item.Quantity, item.Unit, item.ShelterID, item.Size, item.Status, item.CreatedTime}
+	var itemID int64
+	err := im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, createItemQuery, values)
+		if err != nil {
+			return err
+		}
+
+		itemID, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		created := *item
+		created.ID = itemID
+		created.Version = 1
+		return recordAuditEvent(ctx, tx, AUDIT_ITEM_CREATE, AUDIT_ITEM, itemID, nil, &created)
+	})
 	if err != nil {
 		return -1, err
 	}
-	return result.LastInsertId()
+	item.Version = 1
+	return itemID, nil
 }
 
+// UpdateItem only applies the update if the item is still at item.Version, returning
+// ErrItemVersionConflict otherwise. On success item.Version is advanced to match the stored item.
 func (im *ItemManager) UpdateItem(ctx context.Context, item *Item) error {
-	values := []interface{}{item.Category, item.Gender, item.Quantity, item.ShelterID, item.SamaritanID, item.Size, item.Status, item.ID}
-	_, err := im.Datasource.ExecuteWriteQuery(ctx, updateItemQuery, values, true)
-	return err
+	var samaritanID interface{}
+	if item.SamaritanID > 0 {
+		samaritanID = item.SamaritanID
+	}
+	previousItem, err := im.GetItem(ctx, item.ID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case item.SamaritanID <= 0:
+		item.ClaimedTime = 0
+	case item.SamaritanID != previousItem.SamaritanID:
+		item.ClaimedTime = time.Now().Unix()
+	default:
+		item.ClaimedTime = previousItem.ClaimedTime
+	}
+
+	values := []interface{}{item.Category, item.Gender, item.Quantity, item.Unit, item.ShelterID, samaritanID, item.Size, item.Status, item.ClaimedTime, item.ID, item.Version}
+	return im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, updateItemQuery, values)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return ErrItemVersionConflict
+		}
+		updated := *item
+		updated.Version++
+		if err := recordAuditEvent(ctx, tx, AUDIT_ITEM_UPDATE, AUDIT_ITEM, item.ID, previousItem, &updated); err != nil {
+			return err
+		}
+		item.Version = updated.Version
+		return nil
+	})
 }
 
+// DeleteItem hides the item from every other read until it is restored or purged.
 func (im *ItemManager) DeleteItem(ctx context.Context, id interface{}) (int64, error) {
-	result, err := im.Datasource.ExecuteWriteQuery(ctx, deleteItemQuery, []interface{}{id}, true)
+	previousItem, err := im.GetItem(ctx, id)
+	if err != nil {
+		return -1, err
+	}
+
+	var rowsAffected int64
+	err = im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, deleteItemQuery, []interface{}{time.Now().Unix(), id})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 || previousItem == nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_ITEM_DELETE, AUDIT_ITEM, previousItem.ID, previousItem, nil)
+	})
 	if err != nil {
 		return -1, err
 	}
-	return result.RowsAffected()
+	return rowsAffected, nil
+}
+
+// RestoreItem undoes DeleteItem. It reports whether there was a deleted item to restore.
+func (im *ItemManager) RestoreItem(ctx context.Context, id int64) (bool, error) {
+	var rowsAffected int64
+	err := im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, restoreItemQuery, []interface{}{id})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+
+		restoredItem, err := (&ItemManager{Datasource: tx}).GetItem(ctx, id)
+		if err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_ITEM_RESTORE, AUDIT_ITEM, id, nil, restoredItem)
+	})
+	return rowsAffected > 0 && err == nil, err
+}
+
+// PurgeDeletedItems permanently removes items deleted before the given time, and returns how many
+// it removed.
+func (im *ItemManager) PurgeDeletedItems(ctx context.Context, before time.Time) (int, error) {
+	itemIDs, err := readIDs(im.Datasource.ExecuteBatchReadQuery(ctx, getItemsToPurgeQuery, []interface{}{before.Unix()}))
+	if err != nil {
+		return 0, err
+	}
+
+	for i, itemID := range itemIDs {
+		err := im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+			if _, err := tx.ExecuteWriteQuery(ctx, purgeItemQuery, []interface{}{itemID}); err != nil {
+				return err
+			}
+			return recordAuditEvent(ctx, tx, AUDIT_ITEM_PURGE, AUDIT_ITEM, itemID, nil, nil)
+		})
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(itemIDs), nil
+}
+
+// CountItemsByStatus counts the items that haven't been deleted. Statuses without items are left out.
+func (im *ItemManager) CountItemsByStatus(ctx context.Context) (map[ItemStatus]int64, error) {
+	result, err := im.Datasource.ExecuteBatchReadQuery(ctx, countItemsByStatusQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	counts := make(map[ItemStatus]int64)
+	for result.Next() {
+		var status ItemStatus
+		var count int64
+		if err := result.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[status] = count
+	}
+	return counts, result.Err()
 }
 
 func (im *ItemManager) buildItems(result *sql.Rows) ([]*Item, error) {
@@ -104,15 +367,20 @@ func (im *ItemManager) buildItems(result *sql.Rows) ([]*Item, error) {
 		var id int64
 		var category string
 		var gender string
-		var quantity int8
+		var quantity int
+		var unit Unit
 		var shelterID int64
 		var samaritan interface{}
 		var size string
 		var status ItemStatus
-		if err := result.Scan(&id, &category, &gender, &quantity, &shelterID, &samaritan, &size, &status); err != nil {
+		var version int64
+		var deletedTime sql.NullInt64
+		var createdTime int64
+		var claimedTime int64
+		if err := result.Scan(&id, &category, &gender, &quantity, &unit, &shelterID, &samaritan, &size, &status, &version, &deletedTime, &createdTime, &claimedTime); err != nil {
 			return nil, err
 		}
-		item := Item{ID: id, Category: category, Gender: gender, Quantity: quantity, ShelterID: shelterID, Size: size, Status: status}
+		item := Item{ID: id, Category: category, Gender: gender, Quantity: quantity, Unit: unit, ShelterID: shelterID, Size: size, Status: status, Version: version, DeletedTime: deletedTime.Int64, CreatedTime: createdTime, ClaimedTime: claimedTime}
 		if samaritan != nil {
 			item.SamaritanID = reflect.ValueOf(samaritan).Int()
 		}
@@ -120,3 +388,22 @@ func (im *ItemManager) buildItems(result *sql.Rows) ([]*Item, error) {
 	}
 	return response, nil
 }
+
+// readIDs collects a single column of IDs, closing the rows before returning so the IDs can be
+// written to straight away.
+func readIDs(result *sql.Rows, err error) ([]int64, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	ids := make([]int64, 0)
+	for result.Next() {
+		var id int64
+		if err := result.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, result.Err()
+}
@@ -6,13 +6,14 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/kwhite17/Neighbors/pkg/database"
 )
 
 var testCategory = "testCategory"
 var testGender = "testGender"
-var testQuantity = int8(rand.Int() % 127)
+var testQuantity = rand.Int() % 127
 var testShelterID = rand.Int63()
 var testSize = "testSize"
 var testStatus = CREATED
@@ -67,6 +68,76 @@ func TestItCanDeleteItem(t *testing.T) {
 	}
 }
 
+func TestItCanRestoreDeletedItem(t *testing.T) {
+	manager := initItemManager()
+	defer cleanDatabase()
+
+	id, err := manager.WriteItem(context.Background(), generateItem())
+	if err != nil {
+		t.Error(err)
+	}
+
+	manager.DeleteItem(context.Background(), id)
+	deletedItem, err := manager.GetItem(context.Background(), id)
+	if _, notFound := err.(*NotFoundError); !notFound || deletedItem != nil {
+		t.Errorf("Expected deleted item to be hidden, got %v: %v", deletedItem, err)
+	}
+
+	shelterItems, _ := manager.GetItemsForShelter(context.Background(), testShelterID)
+	deletedItems, _ := manager.GetDeletedItemsForShelter(context.Background(), testShelterID)
+	if len(shelterItems) != 0 || len(deletedItems) != 1 || deletedItems[0].DeletedTime == 0 {
+		t.Errorf("Expected item to be listed only as deleted, got %v and %v", shelterItems, deletedItems)
+	}
+
+	restored, err := manager.RestoreItem(context.Background(), id)
+	if err != nil || !restored {
+		t.Errorf("Expected item to be restored: %v", err)
+	}
+
+	restoredItem, _ := manager.GetItem(context.Background(), id)
+	if restoredItem == nil || restoredItem.DeletedTime != 0 {
+		t.Errorf("Expected restored item to be visible, got %v", restoredItem)
+	}
+
+	restored, _ = manager.RestoreItem(context.Background(), id)
+	if restored {
+		t.Error("Expected an item that isn't deleted not to be restored")
+	}
+}
+
+func TestItPurgesItemsDeletedBeforeRetention(t *testing.T) {
+	manager := initItemManager()
+	defer cleanDatabase()
+
+	oldID, _ := manager.WriteItem(context.Background(), generateItem())
+	recentID, _ := manager.WriteItem(context.Background(), generateItem())
+	keptID, _ := manager.WriteItem(context.Background(), generateItem())
+	manager.DeleteItem(context.Background(), oldID)
+	manager.DeleteItem(context.Background(), recentID)
+	manager.Datasource.ExecuteWriteQuery(context.Background(), database.Update("items").Set("DeletedTime").Where("ID = ?"), []interface{}{time.Now().Add(-2 * DELETED_RECORD_RETENTION_PERIOD).Unix(), oldID})
+
+	purged, err := manager.PurgeDeletedItems(context.Background(), time.Now().Add(-DELETED_RECORD_RETENTION_PERIOD))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if purged != 1 {
+		t.Errorf("Expected 1 item to be purged, got %v", purged)
+	}
+
+	if oldItem, _ := manager.GetDeletedItem(context.Background(), oldID); oldItem != nil {
+		t.Error("Expected item deleted before the retention period to be purged")
+	}
+
+	if recentItem, _ := manager.GetDeletedItem(context.Background(), recentID); recentItem == nil {
+		t.Error("Expected recently deleted item to be kept")
+	}
+
+	if keptItem, _ := manager.GetItem(context.Background(), keptID); keptItem == nil {
+		t.Error("Expected item that wasn't deleted to be kept")
+	}
+}
+
 func TestItCanGetAllItems(t *testing.T) {
 	manager := initItemManager()
 	defer cleanDatabase()
@@ -167,11 +238,43 @@ func TestCanReadSamaritanClaim(t *testing.T) {
 	}
 }
 
+func TestItRejectsUpdatesToStaleItems(t *testing.T) {
+	manager := initItemManager()
+	defer cleanDatabase()
+
+	id, err := manager.WriteItem(context.Background(), generateItem())
+	if err != nil {
+		t.Error(err)
+	}
+
+	shelterCopy, _ := manager.GetItem(context.Background(), id)
+	samaritanCopy, _ := manager.GetItem(context.Background(), id)
+
+	samaritanCopy.SamaritanID = rand.Int63()
+	samaritanCopy.Status = CLAIMED
+	err = manager.UpdateItem(context.Background(), samaritanCopy)
+	if err != nil {
+		t.Error(err)
+	}
+
+	shelterCopy.Quantity = testQuantity + 1
+	err = manager.UpdateItem(context.Background(), shelterCopy)
+	if err != ErrItemVersionConflict {
+		t.Errorf("Expected %v to equal %v", err, ErrItemVersionConflict)
+	}
+
+	finalItem, _ := manager.GetItem(context.Background(), id)
+	if !reflect.DeepEqual(finalItem, samaritanCopy) {
+		t.Errorf("Expected %v to equal %v", finalItem, samaritanCopy)
+	}
+}
+
 func generateItem() *Item {
 	return &Item{
 		Category:  testCategory,
 		Gender:    testGender,
 		Quantity:  testQuantity,
+		Unit:      PAIR,
 		ShelterID: testShelterID,
 		Size:      testSize,
 		Status:    testStatus,
@@ -186,3 +289,87 @@ func contains(candidateItem *Item, expectedItems []*Item) bool {
 	}
 	return false
 }
+
+func TestItCountsItemsByStatus(t *testing.T) {
+	manager := initItemManager()
+	defer cleanDatabase()
+
+	manager.WriteItem(context.Background(), generateItem())
+	manager.WriteItem(context.Background(), generateItem())
+	deletedID, _ := manager.WriteItem(context.Background(), generateItem())
+	manager.DeleteItem(context.Background(), deletedID)
+
+	counts, err := manager.CountItemsByStatus(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(counts) != 1 || counts[CREATED] != 2 {
+		t.Errorf("Expected 2 open items, got %v", counts)
+	}
+}
+
+func TestItGetsOpenItemsNewestFirst(t *testing.T) {
+	manager := initItemManager()
+	defer cleanDatabase()
+	ctx := context.Background()
+
+	ids := make([]int64, 3)
+	for i := range ids {
+		item := generateItem()
+		if i == 2 {
+			item.ShelterID = testShelterID + 1
+		}
+		id, err := manager.WriteItem(ctx, item)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids[i] = id
+	}
+	writeClaimedItem(t, manager, 42)
+
+	items, err := manager.GetOpenItems(ctx, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 || items[0].ID != ids[2] || items[1].ID != ids[1] || items[0].CreatedTime == 0 {
+		t.Errorf("Expected the two newest open items, got %v", items)
+	}
+
+	items, err = manager.GetOpenItems(ctx, testShelterID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 || items[0].ID != ids[1] || items[1].ID != ids[0] {
+		t.Errorf("Expected the shelter's open items, got %v", items)
+	}
+}
+
+func TestItRecordsWhenItemsAreClaimed(t *testing.T) {
+	manager := initItemManager()
+	defer cleanDatabase()
+	ctx := context.Background()
+	item := writeClaimedItem(t, manager, 42)
+
+	claimedItem, _ := manager.GetItem(ctx, item.ID)
+	if claimedItem.ClaimedTime == 0 || !claimedItem.ClaimDeadline().Equal(time.Unix(claimedItem.ClaimedTime, 0).Add(CLAIM_PERIOD)) {
+		t.Fatalf("Expected the claim time to be recorded, got %v", claimedItem)
+	}
+
+	claimedItem.Status = DELIVERED
+	manager.UpdateItem(ctx, claimedItem)
+	deliveredItem, _ := manager.GetItem(ctx, item.ID)
+	if deliveredItem.ClaimedTime != claimedItem.ClaimedTime {
+		t.Errorf("Expected the claim time to be kept until the item is unclaimed, got %v", deliveredItem)
+	}
+
+	deliveredItem.Status = CREATED
+	deliveredItem.SamaritanID = 0
+	manager.UpdateItem(ctx, deliveredItem)
+	unclaimedItem, _ := manager.GetItem(ctx, item.ID)
+	if unclaimedItem.ClaimedTime != 0 || !unclaimedItem.ClaimDeadline().IsZero() {
+		t.Errorf("Expected unclaimed items to have no deadline, got %v", unclaimedItem)
+	}
+}
@@ -0,0 +1,203 @@
+package managers
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+// ItemCSVColumns are the columns items are exported with. Imports find their columns by name, so
+// an export can be edited and imported again.
+var ItemCSVColumns = []string{"ID", "Category", "Gender", "Size", "Quantity", "Unit", "Status", "ShelterID", "SamaritanID"}
+
+var requiredItemImportColumns = []string{"Category", "Gender", "Size", "Quantity"}
+
+// ItemImportError points at the line of an import that couldn't be read.
+type ItemImportError struct {
+	Line    int
+	Message string
+}
+
+func (err *ItemImportError) Error() string {
+	return fmt.Sprintf("line %d: %s", err.Line, err.Message)
+}
+
+func WriteItemsCSV(w io.Writer, items []*Item) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(ItemCSVColumns); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		samaritanID := ""
+		if item.SamaritanID > 0 {
+			samaritanID = strconv.FormatInt(item.SamaritanID, 10)
+		}
+
+		record := []string{
+			strconv.FormatInt(item.ID, 10),
+			item.Category,
+			item.Gender,
+			item.Size,
+			strconv.Itoa(item.Quantity),
+			string(item.Unit),
+			item.Status.String(),
+			strconv.FormatInt(item.ShelterID, 10),
+			samaritanID,
+		}
+		if err := writer.Write(spreadsheetSafe(record)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ItemImportRow is a line of an import, with the problems that keep it from being posted.
+type ItemImportRow struct {
+	Line   int
+	Item   *Item
+	Errors ValidationErrors
+}
+
+// ReadItemsCSV reads items to import from CSV with a header row. Only the category, gender, size,
+// quantity and unit are read, and the unit defaults to the category's: imported items are always
+// new, unclaimed items, so the ID, status and owner columns of an export are ignored.
+func ReadItemsCSV(r io.Reader) ([]*ItemImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	line := 0
+	return readItemTable(func() (int, []string, error) {
+		line++
+		record, err := reader.Read()
+		if err != nil && err != io.EOF {
+			return line, nil, &ItemImportError{Line: line, Message: err.Error()}
+		}
+		return line, record, err
+	})
+}
+
+// ReadItemsXLSX reads items to import from the first sheet of an XLSX workbook, laid out like
+// the CSV that ReadItemsCSV reads.
+func ReadItemsXLSX(r io.ReaderAt, size int64) ([]*ItemImportRow, error) {
+	records, err := readXLSXRows(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	line := 0
+	return readItemTable(func() (int, []string, error) {
+		for line < len(records) {
+			line++
+			if !isBlankRecord(records[line-1]) {
+				return line, records[line-1], nil
+			}
+		}
+		return line + 1, nil, io.EOF
+	})
+}
+
+// ImportableItems returns the items of the rows that can be posted.
+func ImportableItems(rows []*ItemImportRow) []*Item {
+	items := make([]*Item, 0, len(rows))
+	for _, row := range rows {
+		if len(row.Errors) == 0 {
+			items = append(items, row.Item)
+		}
+	}
+	return items
+}
+
+// readItemTable reads the header row and then every item row that next returns, until io.EOF.
+func readItemTable(next func() (int, []string, error)) ([]*ItemImportRow, error) {
+	line, header, err := next()
+	if err == io.EOF {
+		return nil, &ItemImportError{Line: line, Message: "missing header row"}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range requiredItemImportColumns {
+		if _, found := columns[strings.ToLower(name)]; !found {
+			return nil, &ItemImportError{Line: line, Message: "missing " + name + " column"}
+		}
+	}
+
+	rows := make([]*ItemImportRow, 0)
+	for {
+		line, record, err := next()
+		if err == io.EOF {
+			return rows, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, readItemRecord(line, record, columns))
+	}
+}
+
+func readItemRecord(line int, record []string, columns map[string]int) *ItemImportRow {
+	field := func(name string) string {
+		index, found := columns[strings.ToLower(name)]
+		if !found || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(fromSpreadsheetSafe(record[index]))
+	}
+
+	// An unreadable quantity is left at 0, which validation reports as out of range.
+	quantity, _ := strconv.Atoi(field("Quantity"))
+	item := &Item{Category: field("Category"), Gender: field("Gender"), Size: field("Size"), Quantity: quantity, Unit: DefaultUnit(field("Category")), Status: CREATED}
+	if field("Unit") != "" {
+		item.Unit = Unit(strings.ToLower(field("Unit")))
+	}
+	return &ItemImportRow{Line: line, Item: item, Errors: validateNewItem(item)}
+}
+
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ImportItems posts each item for the shelter in one transaction, and returns the new item IDs.
+// If any item can't be posted, none are.
+func (im *ItemManager) ImportItems(ctx context.Context, shelterID int64, items []*Item) ([]int64, error) {
+	itemIDs := make([]int64, 0, len(items))
+	err := im.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		txManager := &ItemManager{Datasource: tx}
+		for _, item := range items {
+			item.ShelterID = shelterID
+			item.SamaritanID = 0
+			item.Status = CREATED
+			itemID, err := txManager.WriteItem(ctx, item)
+			if err != nil {
+				return err
+			}
+			item.ID = itemID
+			itemIDs = append(itemIDs, itemID)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return itemIDs, nil
+}
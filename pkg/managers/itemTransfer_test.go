@@ -0,0 +1,157 @@
+package managers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestExportedItemsCanBeImportedAgain(t *testing.T) {
+	manager := initItemManager()
+	defer cleanDatabase()
+
+	claimed := &Item{ID: 3, Category: "SOCKS", Gender: "UNISEX", Size: "L", Quantity: 12, Unit: PACK, Status: CLAIMED, ShelterID: 1, SamaritanID: 2}
+	exported := &bytes.Buffer{}
+	if err := WriteItemsCSV(exported, []*Item{claimed}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(exported.String(), "ID,Category,Gender,Size,Quantity,Unit,Status,ShelterID,SamaritanID\n3,SOCKS,UNISEX,L,12,pack,CLAIMED,1,2\n") {
+		t.Errorf("Unexpected export %q", exported.String())
+	}
+
+	rows, err := ReadItemsCSV(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemIDs, err := manager.ImportItems(context.Background(), testShelterID, ImportableItems(rows))
+	if err != nil || len(itemIDs) != 1 {
+		t.Fatalf("Expected 1 item to be imported, got %v: %v", itemIDs, err)
+	}
+
+	imported, _ := manager.GetItem(context.Background(), itemIDs[0])
+	if imported.Category != "SOCKS" || imported.Quantity != 12 || imported.Unit != PACK || imported.Status != CREATED || imported.ShelterID != testShelterID || imported.SamaritanID != 0 {
+		t.Errorf("Expected a new unclaimed item for the importing shelter, got %+v", imported)
+	}
+}
+
+func TestItemImportReportsTheBadLines(t *testing.T) {
+	rows, err := ReadItemsCSV(strings.NewReader("category,gender,size,quantity,unit\nSOCKS,MALE,M,200,\nBLANKETS,UNISEX,Twin,lots,crate\n"))
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %v: %v", rows, err)
+	}
+
+	if rows[0].Item.Quantity != 200 || rows[0].Item.Unit != PAIR || len(rows[0].Errors) != 0 {
+		t.Errorf("Expected 200 pairs of socks, got %+v: %v", rows[0].Item, rows[0].Errors)
+	}
+
+	if rows[1].Line != 3 || rows[1].Errors.Error() != "Quantity must be from 1 to 10000; Unit must be each or pair or pack or box or lb" {
+		t.Errorf("Expected the quantity and unit on line 3 to be reported, got line %d: %v", rows[1].Line, rows[1].Errors)
+	}
+
+	if items := ImportableItems(rows); len(items) != 1 || items[0] != rows[0].Item {
+		t.Errorf("Expected only the socks to be importable, got %v", items)
+	}
+
+	_, err = ReadItemsCSV(strings.NewReader("category,gender,size\nSOCKS,MALE,M\n"))
+	if err == nil || !strings.Contains(err.Error(), "Quantity") {
+		t.Errorf("Expected a missing column to be reported, got %v", err)
+	}
+}
+
+func TestItemsCanBeImportedFromXLSX(t *testing.T) {
+	workbook := buildXLSX(t, map[string]string{
+		"xl/workbook.xml":            `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Needs" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships><Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml":       `<sst><si><t>Category</t></si><si><t>Quantity</t></si><si><r><t>BLANK</t></r><r><t>ETS</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>
+			<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="inlineStr"><is><t>Gender</t></is></c><c r="C1" t="inlineStr"><is><t>Size</t></is></c><c r="D1" t="s"><v>1</v></c></row>
+			<row r="3"><c r="A3" t="s"><v>2</v></c><c r="B3" t="inlineStr"><is><t>UNISEX</t></is></c><c r="C3" t="inlineStr"><is><t>Twin</t></is></c><c r="D3"><v>30</v></c></row>
+		</sheetData></worksheet>`,
+	})
+
+	rows, err := ReadItemsXLSX(bytes.NewReader(workbook), int64(len(workbook)))
+	if err != nil || len(rows) != 1 {
+		t.Fatalf("Expected 1 row, got %v: %v", rows, err)
+	}
+
+	item := rows[0].Item
+	if rows[0].Line != 3 || item.Category != "BLANKETS" || item.Size != "Twin" || item.Quantity != 30 || item.Unit != EACH || len(rows[0].Errors) != 0 {
+		t.Errorf("Expected 30 twin blankets on line 3, got line %d: %+v %v", rows[0].Line, item, rows[0].Errors)
+	}
+
+	if _, err := ReadItemsXLSX(strings.NewReader("Category"), 8); err != errNotXLSX {
+		t.Errorf("Expected a CSV to be refused, got %v", err)
+	}
+}
+
+func TestXLSXImportsAreBounded(t *testing.T) {
+	parts := map[string]string{
+		"xl/workbook.xml":            `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Needs" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships><Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/worksheets/sheet1.xml":   `<worksheet><sheetData><row r="1"><c r="A1"><v>Category</v></c><c r="ZZZZZZZZZZZZZZZZ1"><v>far away</v></c></row></sheetData></worksheet>`,
+	}
+
+	workbook := buildXLSX(t, parts)
+	rows, err := readXLSXRows(bytes.NewReader(workbook), int64(len(workbook)))
+	if err != nil || len(rows) != 1 || len(rows[0]) != 1 {
+		t.Errorf("Expected cells past the last column to be ignored, got %d cells: %v", len(rows[0]), err)
+	}
+
+	parts["xl/worksheets/sheet1.xml"] = `<worksheet><sheetData><row r="2000000000"><c r="A2000000000"><v>1</v></c></row></sheetData></worksheet>`
+	workbook = buildXLSX(t, parts)
+	if _, err := readXLSXRows(bytes.NewReader(workbook), int64(len(workbook))); err != errXLSXTooLarge {
+		t.Errorf("Expected a row past the last one to be refused, got %v", err)
+	}
+
+	parts["xl/worksheets/sheet1.xml"] = "<worksheet>" + strings.Repeat(" ", MAX_XLSX_PART_SIZE) + "</worksheet>"
+	workbook = buildXLSX(t, parts)
+	if len(workbook) > 1<<20 {
+		t.Fatalf("Expected the oversized part to compress, got %d bytes", len(workbook))
+	}
+
+	if _, err := readXLSXRows(bytes.NewReader(workbook), int64(len(workbook))); err != errXLSXTooLarge {
+		t.Errorf("Expected a part that unzips past the limit to be refused, got %v", err)
+	}
+}
+
+func buildXLSX(t *testing.T, parts map[string]string) []byte {
+	buffer := &bytes.Buffer{}
+	archive := zip.NewWriter(buffer)
+	for name, content := range parts {
+		part, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write([]byte(content))
+	}
+
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func TestExportedCellsAreNotRunAsFormulas(t *testing.T) {
+	item := &Item{ID: 3, Category: "=HYPERLINK(\"http://attacker.example\")", Gender: "+1", Size: "@SUM(A1)", Quantity: 1, Unit: EACH, Status: CREATED, ShelterID: 1}
+	exported := &bytes.Buffer{}
+	if err := WriteItemsCSV(exported, []*Item{item}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(exported.String(), `"'=HYPERLINK(""http://attacker.example"")",'+1,'@SUM(A1),1,`) {
+		t.Errorf("Expected formulas to be quoted, got %q", exported.String())
+	}
+
+	rows, err := ReadItemsCSV(exported)
+	if err != nil || len(rows) != 1 {
+		t.Fatalf("Expected the export to be read back, got %v: %v", rows, err)
+	}
+
+	if imported := rows[0].Item; imported.Category != item.Category || imported.Gender != "+1" || imported.Size != "@SUM(A1)" {
+		t.Errorf("Expected the quotes to be dropped on import, got %+v", imported)
+	}
+}
@@ -0,0 +1,58 @@
+package managers
+
+import "strconv"
+
+// MAX_ITEM_QUANTITY bounds a single request, so a typo doesn't ask samaritans for a million socks.
+const MAX_ITEM_QUANTITY = 10000
+
+// Unit is the unit of measure an item's quantity is counted in.
+type Unit string
+
+const (
+	EACH  Unit = "each"
+	PAIR  Unit = "pair"
+	PACK  Unit = "pack"
+	BOX   Unit = "box"
+	POUND Unit = "lb"
+)
+
+// CATEGORY_UNITS is the unit each category is usually counted in. Other categories are counted
+// individually.
+var CATEGORY_UNITS = map[string]Unit{
+	"SOCKS":     PAIR,
+	"UNDERWEAR": EACH,
+	"BLANKETS":  EACH,
+}
+
+var unitPlurals = map[Unit]string{
+	PAIR: "pairs",
+	PACK: "packs",
+	BOX:  "boxes",
+}
+
+// DefaultUnit is the unit items in the category are counted in unless the shelter says otherwise.
+func DefaultUnit(category string) Unit {
+	if unit, found := CATEGORY_UNITS[category]; found {
+		return unit
+	}
+	return EACH
+}
+
+// FormatQuantity renders a quantity with its unit, such as "3 pairs" or "5 lb". Items counted
+// individually are just a number.
+func FormatQuantity(quantity int, unit Unit) string {
+	formatted := strconv.Itoa(quantity)
+	if unit == EACH || unit == "" {
+		return formatted
+	}
+
+	if plural, found := unitPlurals[unit]; found && quantity != 1 {
+		return formatted + " " + plural
+	}
+	return formatted + " " + string(unit)
+}
+
+// FormattedQuantity renders the item's quantity with its unit.
+func (item *Item) FormattedQuantity() string {
+	return FormatQuantity(item.Quantity, item.Unit)
+}
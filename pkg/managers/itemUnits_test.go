@@ -0,0 +1,23 @@
+package managers
+
+import "testing"
+
+func TestQuantitiesAreFormattedWithTheirUnit(t *testing.T) {
+	expected := map[string]*Item{
+		"200":     {Quantity: 200, Unit: EACH},
+		"1 pair":  {Quantity: 1, Unit: PAIR},
+		"3 boxes": {Quantity: 3, Unit: BOX},
+		"5 lb":    {Quantity: 5, Unit: POUND},
+	}
+	for formatted, item := range expected {
+		if item.FormattedQuantity() != formatted {
+			t.Errorf("Expected %q, got %q", formatted, item.FormattedQuantity())
+		}
+	}
+}
+
+func TestCategoriesHaveDefaultUnits(t *testing.T) {
+	if DefaultUnit("SOCKS") != PAIR || DefaultUnit("HATS") != EACH {
+		t.Errorf("Expected socks to come in pairs and other items individually, got %v and %v", DefaultUnit("SOCKS"), DefaultUnit("HATS"))
+	}
+}
@@ -0,0 +1,201 @@
+package managers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const LOGIN_ATTEMPTS_PER_IP = 30
+const LOGIN_ATTEMPTS_PER_ACCOUNT = 10
+const LOGIN_ATTEMPT_WINDOW = 5 * time.Minute
+const LOGIN_FAILURES_BEFORE_LOCKOUT = 5
+const LOGIN_LOCKOUT_DURATION = 15 * time.Minute
+const RESET_ATTEMPTS_PER_IP = 10
+const RESET_ATTEMPTS_PER_ACCOUNT = 3
+const RESET_ATTEMPT_WINDOW = time.Hour
+
+// LoginLimiter throttles login and password reset requests per client IP and per account,
+// and locks an account out for LockoutDuration after MaxFailures consecutive failed logins.
+type LoginLimiter struct {
+	Store                   RateLimitStore
+	LoginAttemptsPerIP      int
+	LoginAttemptsPerAccount int
+	LoginAttemptWindow      time.Duration
+	MaxFailures             int
+	LockoutDuration         time.Duration
+	ResetAttemptsPerIP      int
+	ResetAttemptsPerAccount int
+	ResetAttemptWindow      time.Duration
+}
+
+// BuildLoginLimiter returns a LoginLimiter using the default limits.
+func BuildLoginLimiter(store RateLimitStore) *LoginLimiter {
+	return &LoginLimiter{
+		Store:                   store,
+		LoginAttemptsPerIP:      LOGIN_ATTEMPTS_PER_IP,
+		LoginAttemptsPerAccount: LOGIN_ATTEMPTS_PER_ACCOUNT,
+		LoginAttemptWindow:      LOGIN_ATTEMPT_WINDOW,
+		MaxFailures:             LOGIN_FAILURES_BEFORE_LOCKOUT,
+		LockoutDuration:         LOGIN_LOCKOUT_DURATION,
+		ResetAttemptsPerIP:      RESET_ATTEMPTS_PER_IP,
+		ResetAttemptsPerAccount: RESET_ATTEMPTS_PER_ACCOUNT,
+		ResetAttemptWindow:      RESET_ATTEMPT_WINDOW,
+	}
+}
+
+// AllowLogin records a login attempt and returns how long the caller must wait before
+// trying again. A zero duration means the attempt may proceed. userID is the account the
+// identifier belongs to, or zero when it matches none.
+func (ll *LoginLimiter) AllowLogin(ctx context.Context, ipAddress string, userID int64, identifier string) (time.Duration, error) {
+	now := time.Now()
+	account := accountKey(userID, identifier)
+	lockout, err := ll.lockoutRemaining(ctx, "failure:"+account, now)
+	if err != nil || lockout > 0 {
+		return lockout, err
+	}
+
+	return ll.allow(ctx, now, ll.LoginAttemptWindow, map[string]int{
+		"login:ip:" + ipAddress: ll.LoginAttemptsPerIP,
+		"login:" + account:      ll.LoginAttemptsPerAccount,
+	})
+}
+
+// AllowTwoFactor records an attempt at a login's second factor, like AllowLogin. Codes are
+// counted against the account rather than the challenge, since anyone with the password can
+// start a new challenge.
+func (ll *LoginLimiter) AllowTwoFactor(ctx context.Context, ipAddress string, userID int64) (time.Duration, error) {
+	now := time.Now()
+	lockout, err := ll.lockoutRemaining(ctx, twoFactorFailureKey(userID), now)
+	if err != nil || lockout > 0 {
+		return lockout, err
+	}
+
+	return ll.allow(ctx, now, ll.LoginAttemptWindow, map[string]int{
+		"login:ip:" + ipAddress:                  ll.LoginAttemptsPerIP,
+		fmt.Sprintf("twofactor:user:%d", userID): ll.LoginAttemptsPerAccount,
+	})
+}
+
+// AllowReset records a password reset request and returns how long the caller must wait
+// before requesting another. A zero duration means the request may proceed.
+func (ll *LoginLimiter) AllowReset(ctx context.Context, ipAddress string, email string) (time.Duration, error) {
+	return ll.allow(ctx, time.Now(), ll.ResetAttemptWindow, map[string]int{
+		"reset:ip:" + ipAddress:                    ll.ResetAttemptsPerIP,
+		"reset:account:" + normalizeAccount(email): ll.ResetAttemptsPerAccount,
+	})
+}
+
+// RecordFailure counts a failed login against the account, or against the identifier when it
+// matches no account. It returns the time the account is locked until when this failure is the
+// one that triggers a lockout, and the zero time otherwise.
+func (ll *LoginLimiter) RecordFailure(ctx context.Context, userID int64, identifier string) (time.Time, error) {
+	return ll.recordFailure(ctx, "failure:"+accountKey(userID, identifier))
+}
+
+// RecordTwoFactorFailure counts a wrong second factor code against the account, like RecordFailure.
+func (ll *LoginLimiter) RecordTwoFactorFailure(ctx context.Context, userID int64) (time.Time, error) {
+	return ll.recordFailure(ctx, twoFactorFailureKey(userID))
+}
+
+func (ll *LoginLimiter) recordFailure(ctx context.Context, key string) (time.Time, error) {
+	now := time.Now()
+	if err := ll.Store.AddHit(ctx, key, now.Unix()); err != nil {
+		return time.Time{}, err
+	}
+
+	failures, err := ll.Store.GetHits(ctx, key, now.Add(-ll.LockoutDuration).Unix())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(failures) != ll.MaxFailures {
+		return time.Time{}, nil
+	}
+	return now.Add(ll.LockoutDuration), nil
+}
+
+// RecordSuccess resets the consecutive failure count for the account. It should only be called
+// once the login is complete, including any second factor.
+func (ll *LoginLimiter) RecordSuccess(ctx context.Context, userID int64) error {
+	return ll.Store.ClearHits(ctx, "failure:"+accountKey(userID, ""))
+}
+
+// RecordTwoFactorSuccess resets the consecutive wrong code count for the account.
+func (ll *LoginLimiter) RecordTwoFactorSuccess(ctx context.Context, userID int64) error {
+	return ll.Store.ClearHits(ctx, twoFactorFailureKey(userID))
+}
+
+func (ll *LoginLimiter) lockoutRemaining(ctx context.Context, key string, now time.Time) (time.Duration, error) {
+	failures, err := ll.Store.GetHits(ctx, key, now.Add(-ll.LockoutDuration).Unix())
+	if err != nil || len(failures) < ll.MaxFailures {
+		return 0, err
+	}
+
+	return time.Unix(latestHit(failures), 0).Add(ll.LockoutDuration).Sub(now), nil
+}
+
+func (ll *LoginLimiter) allow(ctx context.Context, now time.Time, window time.Duration, limits map[string]int) (time.Duration, error) {
+	return allowHits(ctx, ll.Store, now, window, limits)
+}
+
+// allowHits records a hit against each key if none of them has reached its limit within the
+// window, and otherwise returns how long until they all have room again.
+func allowHits(ctx context.Context, store RateLimitStore, now time.Time, window time.Duration, limits map[string]int) (time.Duration, error) {
+	exceeded, err := store.RecordHits(ctx, limits, now.Add(-window).Unix(), now.Unix())
+	if err != nil {
+		return 0, err
+	}
+
+	var retryAfter time.Duration
+	for _, hits := range exceeded {
+		wait := time.Unix(earliestHit(hits), 0).Add(window).Sub(now)
+		if wait < time.Second {
+			wait = time.Second
+		}
+		if wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+	return retryAfter, nil
+}
+
+// accountKey counts attempts for an account under its ID, so the same counters apply whether
+// users type their username or their email. Identifiers matching no account are counted as typed.
+func accountKey(userID int64, identifier string) string {
+	if userID > 0 {
+		return fmt.Sprintf("user:%d", userID)
+	}
+	return "identifier:" + normalizeAccount(identifier)
+}
+
+// twoFactorFailureKey is apart from the account's password failure key, so failed passwords
+// can't lock out its second factor, or the other way around.
+func twoFactorFailureKey(userID int64) string {
+	return fmt.Sprintf("failure:twofactor:user:%d", userID)
+}
+
+func normalizeAccount(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
+
+func earliestHit(hits []int64) int64 {
+	earliest := hits[0]
+	for _, hit := range hits {
+		if hit < earliest {
+			earliest = hit
+		}
+	}
+	return earliest
+}
+
+func latestHit(hits []int64) int64 {
+	latest := hits[0]
+	for _, hit := range hits {
+		if hit > latest {
+			latest = hit
+		}
+	}
+	return latest
+}
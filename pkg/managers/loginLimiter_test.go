@@ -0,0 +1,228 @@
+package managers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var testIPAddress = "127.0.0.1"
+
+func initLoginLimiter() *LoginLimiter {
+	return BuildLoginLimiter(&MemoryRateLimitStore{})
+}
+
+func TestItLimitsLoginAttemptsPerAccount(t *testing.T) {
+	limiter := initLoginLimiter()
+
+	for i := 0; i < limiter.LoginAttemptsPerAccount; i++ {
+		retryAfter, err := limiter.AllowLogin(context.Background(), testIPAddress, 0, testUsername)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if retryAfter > 0 {
+			t.Errorf("Expected attempt %v to be allowed", i)
+		}
+	}
+
+	retryAfter, err := limiter.AllowLogin(context.Background(), "127.0.0.2", 0, testUsername)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if retryAfter <= 0 || retryAfter > limiter.LoginAttemptWindow {
+		t.Errorf("Expected attempt over the account limit to wait at most %v, got %v", limiter.LoginAttemptWindow, retryAfter)
+	}
+}
+
+func TestItLimitsLoginAttemptsPerIP(t *testing.T) {
+	limiter := initLoginLimiter()
+	limiter.LoginAttemptsPerIP = 2
+
+	for _, account := range []string{"first", "second"} {
+		retryAfter, err := limiter.AllowLogin(context.Background(), testIPAddress, 0, account)
+		if err != nil || retryAfter > 0 {
+			t.Errorf("Expected login for %v to be allowed", account)
+		}
+	}
+
+	retryAfter, err := limiter.AllowLogin(context.Background(), testIPAddress, 0, "third")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if retryAfter <= 0 {
+		t.Error("Expected attempt over the IP limit to be throttled")
+	}
+}
+
+func TestItLocksOutAccountAfterRepeatedFailures(t *testing.T) {
+	limiter := initLoginLimiter()
+
+	for i := 1; i <= limiter.MaxFailures; i++ {
+		lockedUntil, err := limiter.RecordFailure(context.Background(), 42, testUsername)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if i < limiter.MaxFailures && !lockedUntil.IsZero() {
+			t.Errorf("Expected failure %v not to lock the account", i)
+		}
+
+		if i == limiter.MaxFailures && lockedUntil.Before(time.Now()) {
+			t.Errorf("Expected failure %v to lock the account", i)
+		}
+	}
+
+	retryAfter, err := limiter.AllowLogin(context.Background(), testIPAddress, 42, "testname@example.com")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if retryAfter <= 0 || retryAfter > limiter.LockoutDuration {
+		t.Errorf("Expected locked account to wait at most %v, got %v", limiter.LockoutDuration, retryAfter)
+	}
+}
+
+func TestUnknownIdentifiersAreCountedApartFromAccounts(t *testing.T) {
+	limiter := initLoginLimiter()
+	ctx := context.Background()
+
+	for i := 1; i <= limiter.MaxFailures; i++ {
+		limiter.RecordFailure(ctx, 0, testUsername)
+	}
+
+	if retryAfter, _ := limiter.AllowLogin(ctx, testIPAddress, 0, "  TESTNAME "); retryAfter <= 0 {
+		t.Error("Expected the unknown identifier to be locked out however it is typed")
+	}
+
+	if retryAfter, _ := limiter.AllowLogin(ctx, testIPAddress, 42, testUsername); retryAfter > 0 {
+		t.Errorf("Expected failures for an unknown identifier not to lock out the account, got %v", retryAfter)
+	}
+}
+
+func TestSuccessfulLoginClearsFailures(t *testing.T) {
+	limiter := initLoginLimiter()
+
+	for i := 1; i < limiter.MaxFailures; i++ {
+		limiter.RecordFailure(context.Background(), 42, testUsername)
+	}
+
+	err := limiter.RecordSuccess(context.Background(), 42)
+	if err != nil {
+		t.Error(err)
+	}
+
+	lockedUntil, err := limiter.RecordFailure(context.Background(), 42, "testname@example.com")
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !lockedUntil.IsZero() {
+		t.Error("Expected failures before a successful login to be forgotten")
+	}
+}
+
+func TestWrongTwoFactorCodesLockOutTheAccount(t *testing.T) {
+	limiter := initLoginLimiter()
+	ctx := context.Background()
+
+	for i := 1; i <= limiter.MaxFailures; i++ {
+		if retryAfter, err := limiter.AllowTwoFactor(ctx, testIPAddress, 42); err != nil || retryAfter > 0 {
+			t.Fatalf("Expected code %v to be allowed, got %v: %v", i, retryAfter, err)
+		}
+		limiter.RecordTwoFactorFailure(ctx, 42)
+	}
+
+	if retryAfter, _ := limiter.AllowTwoFactor(ctx, "127.0.0.2", 42); retryAfter <= 0 || retryAfter > limiter.LockoutDuration {
+		t.Errorf("Expected the account to be locked out for any new challenge, got %v", retryAfter)
+	}
+
+	if retryAfter, _ := limiter.AllowLogin(ctx, testIPAddress, 42, testUsername); retryAfter > 0 {
+		t.Errorf("Expected wrong codes not to lock out passwords for other accounts, got %v", retryAfter)
+	}
+
+	limiter.RecordTwoFactorSuccess(ctx, 42)
+	if retryAfter, _ := limiter.AllowTwoFactor(ctx, "127.0.0.3", 42); retryAfter > 0 {
+		t.Errorf("Expected a successful code to clear the lockout, got %v", retryAfter)
+	}
+}
+
+func TestItLimitsPasswordResetsWithDatabaseStore(t *testing.T) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	defer cleanDatabase()
+	limiter := BuildLoginLimiter(&DatabaseRateLimitStore{Datasource: database.StandardDatasource{Database: dbToClose}})
+
+	for i := 0; i < limiter.ResetAttemptsPerAccount; i++ {
+		retryAfter, err := limiter.AllowReset(context.Background(), testIPAddress, testEmail)
+		if err != nil || retryAfter > 0 {
+			t.Errorf("Expected reset %v to be allowed: %v", i, err)
+		}
+	}
+
+	retryAfter, err := limiter.AllowReset(context.Background(), testIPAddress, testEmail)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if retryAfter <= 0 {
+		t.Error("Expected reset over the account limit to be throttled")
+	}
+}
+
+func TestPurgingHitsForgetsKeysThatAreNeverCheckedAgain(t *testing.T) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	defer cleanDatabase()
+	ctx := context.Background()
+	now := time.Now().Unix()
+
+	for _, store := range []RateLimitStore{&MemoryRateLimitStore{}, &DatabaseRateLimitStore{Datasource: database.StandardDatasource{Database: dbToClose}}} {
+		store.AddHit(ctx, "login:ip:10.0.0.1", now-7200)
+		store.AddHit(ctx, "login:ip:10.0.0.2", now-7200)
+		store.AddHit(ctx, "login:ip:10.0.0.2", now)
+		if purged, err := store.PurgeHits(ctx, now-3600); err != nil || purged != 2 {
+			t.Errorf("Expected two stale hits to be purged from %T, got %d: %v", store, purged, err)
+		}
+
+		if hits, err := store.GetHits(ctx, "login:ip:10.0.0.2", 0); err != nil || len(hits) != 1 {
+			t.Errorf("Expected recent hits to be kept in %T, got %v: %v", store, hits, err)
+		}
+	}
+}
+
+func TestConcurrentAttemptsCantExceedTheLimit(t *testing.T) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	defer cleanDatabase()
+
+	for _, store := range []RateLimitStore{&MemoryRateLimitStore{}, &DatabaseRateLimitStore{Datasource: database.StandardDatasource{Database: dbToClose}}} {
+		limiter := BuildLoginLimiter(store)
+		var wait sync.WaitGroup
+		var mutex sync.Mutex
+		allowed := 0
+		for i := 0; i < 3*limiter.LoginAttemptsPerAccount; i++ {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				retryAfter, err := limiter.AllowLogin(context.Background(), testIPAddress, 42, testUsername)
+				if err != nil {
+					t.Error(err)
+				}
+
+				if retryAfter == 0 {
+					mutex.Lock()
+					allowed++
+					mutex.Unlock()
+				}
+			}()
+		}
+		wait.Wait()
+
+		if allowed != limiter.LoginAttemptsPerAccount {
+			t.Errorf("Expected %d concurrent attempts to be allowed by %T, got %d", limiter.LoginAttemptsPerAccount, store, allowed)
+		}
+	}
+}
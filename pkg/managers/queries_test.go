@@ -0,0 +1,68 @@
+package managers
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var postgresPlaceholderPattern = regexp.MustCompile(`\$(\d+)`)
+
+func TestManagerQueriesPrepareAgainstSQLite(t *testing.T) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	defer cleanDatabase()
+
+	for _, query := range database.RegisteredQueries() {
+		statement, err := dbToClose.Prepare(query.Render(database.SQLITE_DIALECT))
+		if err != nil {
+			t.Errorf("Expected %q to be valid SQLite: %v", query, err)
+			continue
+		}
+		statement.Close()
+	}
+}
+
+func TestManagerQueriesNumberPostgresPlaceholders(t *testing.T) {
+	for _, query := range database.RegisteredQueries() {
+		sqlite := query.Render(database.SQLITE_DIALECT)
+		postgres := query.Render(database.POSTGRES_DIALECT)
+		placeholders := postgresPlaceholderPattern.FindAllStringSubmatch(postgres, -1)
+		if len(placeholders) != strings.Count(sqlite, "?") {
+			t.Errorf("Expected %q to have %v placeholders, got %q", sqlite, strings.Count(sqlite, "?"), postgres)
+		}
+
+		for i, placeholder := range placeholders {
+			if placeholder[1] != strconv.Itoa(i+1) {
+				t.Errorf("Expected placeholders in %q to be numbered in order", postgres)
+				break
+			}
+		}
+
+		if query.ReturnsID() && !strings.HasSuffix(postgres, "RETURNING ID") {
+			t.Errorf("Expected %q to return the inserted ID", postgres)
+		}
+	}
+}
+
+func TestManagerQueriesPrepareAgainstPostgres(t *testing.T) {
+	host := os.Getenv("NEIGHBORS_TEST_POSTGRES_URL")
+	if host == "" {
+		t.Skip("NEIGHBORS_TEST_POSTGRES_URL is not set")
+	}
+
+	datasource := database.BuildDatasource("postgres", host, true).(database.PostgresDatasource)
+	defer datasource.Database.Close()
+
+	for _, query := range database.RegisteredQueries() {
+		statement, err := datasource.Database.Prepare(query.Render(database.POSTGRES_DIALECT))
+		if err != nil {
+			t.Errorf("Expected %q to be valid Postgres: %v", query.Render(database.POSTGRES_DIALECT), err)
+			continue
+		}
+		statement.Close()
+	}
+}
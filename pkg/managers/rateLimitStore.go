@@ -0,0 +1,228 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var createRateLimitHitQuery = database.Insert("rateLimitHits", "HitKey", "HitTime")
+var getRateLimitHitsQuery = database.Select("rateLimitHits", "HitTime").Where("HitKey = ?", "HitTime >= ?")
+var deleteRateLimitHitsQuery = database.Delete("rateLimitHits").Where("HitKey = ?")
+var deleteExpiredRateLimitHitsQuery = database.Delete("rateLimitHits").Where("HitKey = ?", "HitTime < ?")
+var purgeRateLimitHitsQuery = database.Delete("rateLimitHits").Where("HitTime < ?")
+var lockRateLimitKeyQuery = database.Insert("rateLimitKeys", "HitKey", "LockedTime").OnConflict("HitKey").DoUpdate("LockedTime")
+var purgeRateLimitKeysQuery = database.Delete("rateLimitKeys").Where("LockedTime < ?")
+
+// RATE_LIMIT_HIT_RETENTION is how long hits are kept, the longest window any limit counts them over.
+const RATE_LIMIT_HIT_RETENTION = RESET_ATTEMPT_WINDOW
+
+// RateLimitStore keeps the timestamps (unix seconds) of recent hits against a key.
+type RateLimitStore interface {
+	AddHit(ctx context.Context, key string, hitTime int64) error
+	GetHits(ctx context.Context, key string, since int64) ([]int64, error)
+	// RecordHits adds a hit at hitTime against every key, unless any of them already has its
+	// limit of hits since the given time. Counting and adding is one step, so concurrent requests
+	// can't all pass the same check. It returns the recent hits of the keys at their limit, and
+	// adds nothing when there are any.
+	RecordHits(ctx context.Context, limits map[string]int, since int64, hitTime int64) (map[string][]int64, error)
+	ClearHits(ctx context.Context, key string) error
+	// PurgeHits forgets every hit from before the given time, whatever its key, so keys that
+	// aren't checked again don't pile up. It returns how many hits were purged.
+	PurgeHits(ctx context.Context, before int64) (int64, error)
+}
+
+// MemoryRateLimitStore is the default store. Its counters are lost on restart and
+// are not shared between processes.
+type MemoryRateLimitStore struct {
+	mutex sync.Mutex
+	hits  map[string][]int64
+}
+
+// DatabaseRateLimitStore shares counters between processes through the rateLimitHits table.
+type DatabaseRateLimitStore struct {
+	Datasource database.Datasource
+}
+
+func (ms *MemoryRateLimitStore) AddHit(ctx context.Context, key string, hitTime int64) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	if ms.hits == nil {
+		ms.hits = make(map[string][]int64)
+	}
+	ms.hits[key] = append(ms.hits[key], hitTime)
+	return nil
+}
+
+func (ms *MemoryRateLimitStore) GetHits(ctx context.Context, key string, since int64) ([]int64, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	return ms.recentHits(key, since), nil
+}
+
+func (ms *MemoryRateLimitStore) RecordHits(ctx context.Context, limits map[string]int, since int64, hitTime int64) (map[string][]int64, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	exceeded := make(map[string][]int64)
+	for key, limit := range limits {
+		if hits := ms.recentHits(key, since); len(hits) >= limit {
+			exceeded[key] = hits
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return exceeded, nil
+	}
+
+	if ms.hits == nil {
+		ms.hits = make(map[string][]int64)
+	}
+	for key := range limits {
+		ms.hits[key] = append(ms.hits[key], hitTime)
+	}
+	return exceeded, nil
+}
+
+// recentHits forgets the key's hits from before since and returns a copy of the rest. The caller
+// must hold the mutex.
+func (ms *MemoryRateLimitStore) recentHits(key string, since int64) []int64 {
+	recentHits := make([]int64, 0)
+	for _, hitTime := range ms.hits[key] {
+		if hitTime >= since {
+			recentHits = append(recentHits, hitTime)
+		}
+	}
+
+	if len(recentHits) == 0 {
+		delete(ms.hits, key)
+	} else {
+		ms.hits[key] = recentHits
+	}
+	return append([]int64(nil), recentHits...)
+}
+
+func (ms *MemoryRateLimitStore) ClearHits(ctx context.Context, key string) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	delete(ms.hits, key)
+	return nil
+}
+
+func (ms *MemoryRateLimitStore) PurgeHits(ctx context.Context, before int64) (int64, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	var purged int64
+	for key, hits := range ms.hits {
+		recentHits := make([]int64, 0)
+		for _, hitTime := range hits {
+			if hitTime >= before {
+				recentHits = append(recentHits, hitTime)
+			}
+		}
+
+		purged += int64(len(hits) - len(recentHits))
+		if len(recentHits) == 0 {
+			delete(ms.hits, key)
+		} else {
+			ms.hits[key] = recentHits
+		}
+	}
+	return purged, nil
+}
+
+func (ds *DatabaseRateLimitStore) AddHit(ctx context.Context, key string, hitTime int64) error {
+	_, err := ds.Datasource.ExecuteWriteQuery(ctx, createRateLimitHitQuery, []interface{}{key, hitTime})
+	return err
+}
+
+func (ds *DatabaseRateLimitStore) GetHits(ctx context.Context, key string, since int64) ([]int64, error) {
+	_, err := ds.Datasource.ExecuteWriteQuery(ctx, deleteExpiredRateLimitHitsQuery, []interface{}{key, since})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ds.Datasource.ExecuteBatchReadQuery(ctx, getRateLimitHitsQuery, []interface{}{key, since})
+	if err != nil {
+		return nil, err
+	}
+	return ds.buildHits(result)
+}
+
+// RecordHits upserts a row per key in rateLimitKeys before counting its hits. The upsert locks the
+// row until the transaction ends, so concurrent requests for the same key wait their turn. Keys
+// are locked in sorted order so two requests can't each hold a key the other is waiting on.
+func (ds *DatabaseRateLimitStore) RecordHits(ctx context.Context, limits map[string]int, since int64, hitTime int64) (map[string][]int64, error) {
+	keys := make([]string, 0, len(limits))
+	for key := range limits {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var exceeded map[string][]int64
+	err := ds.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		store := &DatabaseRateLimitStore{Datasource: tx}
+		exceeded = make(map[string][]int64)
+		for _, key := range keys {
+			if _, err := tx.ExecuteWriteQuery(ctx, lockRateLimitKeyQuery, []interface{}{key, hitTime}); err != nil {
+				return err
+			}
+
+			hits, err := store.GetHits(ctx, key, since)
+			if err != nil {
+				return err
+			}
+
+			if len(hits) >= limits[key] {
+				exceeded[key] = hits
+			}
+		}
+
+		if len(exceeded) > 0 {
+			return nil
+		}
+
+		for _, key := range keys {
+			if err := store.AddHit(ctx, key, hitTime); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return exceeded, nil
+}
+
+func (ds *DatabaseRateLimitStore) ClearHits(ctx context.Context, key string) error {
+	_, err := ds.Datasource.ExecuteWriteQuery(ctx, deleteRateLimitHitsQuery, []interface{}{key})
+	return err
+}
+
+func (ds *DatabaseRateLimitStore) PurgeHits(ctx context.Context, before int64) (int64, error) {
+	if _, err := ds.Datasource.ExecuteWriteQuery(ctx, purgeRateLimitKeysQuery, []interface{}{before}); err != nil {
+		return -1, err
+	}
+
+	result, err := ds.Datasource.ExecuteWriteQuery(ctx, purgeRateLimitHitsQuery, []interface{}{before})
+	if err != nil {
+		return -1, err
+	}
+	return result.RowsAffected()
+}
+
+func (ds *DatabaseRateLimitStore) buildHits(result *sql.Rows) ([]int64, error) {
+	defer result.Close()
+	response := make([]int64, 0)
+	for result.Next() {
+		var hitTime int64
+		if err := result.Scan(&hitTime); err != nil {
+			return nil, err
+		}
+		response = append(response, hitTime)
+	}
+	return response, nil
+}
@@ -0,0 +1,59 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var getSiteSettingsQuery = database.Select("siteSettings", "RequireShelterTwoFactor").Where("ID = ?")
+var upsertSiteSettingsQuery = database.Insert("siteSettings", "ID", "RequireShelterTwoFactor").OnConflict("ID").DoUpdate("RequireShelterTwoFactor")
+
+// siteSettingsID is the ID of the one row in siteSettings.
+const siteSettingsID = 1
+
+// SettingsManager keeps the site-wide policies administrators can change without restarting.
+type SettingsManager struct {
+	Datasource database.Datasource
+}
+
+type SiteSettings struct {
+	// RequireShelterTwoFactor makes shelter accounts set up two-factor authentication before they
+	// can make changes, and keeps them from turning it off.
+	RequireShelterTwoFactor bool
+}
+
+// GetSiteSettings returns the site settings, or the defaults if an administrator hasn't saved any.
+func (sm *SettingsManager) GetSiteSettings(ctx context.Context) (*SiteSettings, error) {
+	var requireShelterTwoFactor int
+	row := sm.Datasource.ExecuteSingleReadQuery(ctx, getSiteSettingsQuery, []interface{}{siteSettingsID})
+	if err := row.Scan(&requireShelterTwoFactor); err != nil {
+		if err == sql.ErrNoRows {
+			return &SiteSettings{}, nil
+		}
+		return nil, err
+	}
+	return &SiteSettings{RequireShelterTwoFactor: requireShelterTwoFactor == 1}, nil
+}
+
+// SaveSiteSettings replaces the site settings.
+func (sm *SettingsManager) SaveSiteSettings(ctx context.Context, settings *SiteSettings) error {
+	return sm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		previous, err := (&SettingsManager{Datasource: tx}).GetSiteSettings(ctx)
+		if err != nil {
+			return err
+		}
+
+		requireShelterTwoFactor := 0
+		if settings.RequireShelterTwoFactor {
+			requireShelterTwoFactor = 1
+		}
+
+		if _, err := tx.ExecuteWriteQuery(ctx, upsertSiteSettingsQuery, []interface{}{siteSettingsID, requireShelterTwoFactor}); err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, AUDIT_SETTINGS_UPDATE, AUDIT_SETTINGS, 0, previous, settings)
+	})
+}
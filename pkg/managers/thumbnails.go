@@ -0,0 +1,81 @@
+package managers
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+
+	// Registered so image.Decode reads every type attachments can be.
+	_ "image/gif"
+	_ "image/png"
+)
+
+// THUMBNAIL_SIZE bounds the width and height of thumbnails, in pixels.
+const THUMBNAIL_SIZE = 240
+
+const THUMBNAIL_QUALITY = 80
+
+// buildThumbnail scales an image down to fit within THUMBNAIL_SIZE and encodes it as a JPEG.
+// Smaller images keep their size. Transparent areas become white, since JPEG has no alpha.
+func buildThumbnail(content []byte) ([]byte, error) {
+	source, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := source.Bounds()
+	width, height := fitWithin(bounds.Dx(), bounds.Dy(), THUMBNAIL_SIZE)
+	thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		top := bounds.Min.Y + y*bounds.Dy()/height
+		bottom := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		for x := 0; x < width; x++ {
+			left := bounds.Min.X + x*bounds.Dx()/width
+			right := bounds.Min.X + (x+1)*bounds.Dx()/width
+			thumbnail.Set(x, y, averageOver(source, image.Rect(left, top, right, bottom)))
+		}
+	}
+
+	encoded := &bytes.Buffer{}
+	if err := jpeg.Encode(encoded, thumbnail, &jpeg.Options{Quality: THUMBNAIL_QUALITY}); err != nil {
+		return nil, err
+	}
+	return encoded.Bytes(), nil
+}
+
+// fitWithin scales width and height down, keeping their ratio, until neither is over limit.
+func fitWithin(width int, height int, limit int) (int, int) {
+	if width <= limit && height <= limit {
+		return width, height
+	}
+
+	if width >= height {
+		return limit, maxInt(1, height*limit/width)
+	}
+	return maxInt(1, width*limit/height), limit
+}
+
+// averageOver blends the pixels of area onto white, which is how a box filter shrinks an image
+// without the aliasing of picking one pixel from each area.
+func averageOver(source image.Image, area image.Rectangle) color.Color {
+	var red, green, blue, pixels uint64
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			r, g, b, a := source.At(x, y).RGBA()
+			background := 0xffff - uint64(a)
+			red += uint64(r) + background
+			green += uint64(g) + background
+			blue += uint64(b) + background
+			pixels++
+		}
+	}
+	return color.RGBA64{R: uint16(red / pixels), G: uint16(green / pixels), B: uint16(blue / pixels), A: 0xffff}
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,83 @@
+package managers
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const TOTP_DIGITS = 6
+const TOTP_PERIOD = 30
+const TOTP_SECRET_BYTES = 20
+
+// TOTP_SKEW_STEPS is how many periods either side of the current one are accepted, to allow
+// for clock drift between the server and the authenticator app.
+const TOTP_SKEW_STEPS = 1
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random RFC 6238 secret, base32 encoded for authenticator apps.
+func GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, TOTP_SECRET_BYTES)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// BuildTOTPProvisioningURI returns the otpauth:// URI authenticator apps read from enrollment QR codes.
+func BuildTOTPProvisioningURI(secret string, issuer string, account string) string {
+	parameters := url.Values{}
+	parameters.Set("secret", secret)
+	parameters.Set("issuer", issuer)
+	parameters.Set("digits", fmt.Sprint(TOTP_DIGITS))
+	parameters.Set("period", fmt.Sprint(TOTP_PERIOD))
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + parameters.Encode()
+}
+
+// ComputeTOTP returns the code for the given time step.
+func ComputeTOTP(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	truncated := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	modulus := uint32(1)
+	for i := 0; i < TOTP_DIGITS; i++ {
+		modulus *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTP_DIGITS, truncated%modulus), nil
+}
+
+// MatchTOTP returns the time step the code is valid for, or -1 if it doesn't match any step
+// within the allowed skew of the given time.
+func MatchTOTP(secret string, code string, at time.Time) (int64, error) {
+	code = strings.Replace(strings.TrimSpace(code), " ", "", -1)
+	currentStep := at.Unix() / TOTP_PERIOD
+	for step := currentStep - TOTP_SKEW_STEPS; step <= currentStep+TOTP_SKEW_STEPS; step++ {
+		expected, err := ComputeTOTP(secret, step)
+		if err != nil {
+			return -1, err
+		}
+
+		if hmac.Equal([]byte(expected), []byte(code)) {
+			return step, nil
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,68 @@
+package managers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B test secret ("12345678901234567890"), base32 encoded.
+var rfcTestSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestComputeTOTPMatchesRFCVectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unixTime, expectedCode := range vectors {
+		code, err := ComputeTOTP(rfcTestSecret, unixTime/TOTP_PERIOD)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if code != expectedCode {
+			t.Errorf("Expected code at %v to be %v, got %v", unixTime, expectedCode, code)
+		}
+	}
+}
+
+func TestMatchTOTPAllowsClockSkew(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	previousCode, _ := ComputeTOTP(rfcTestSecret, now.Unix()/TOTP_PERIOD-1)
+	staleCode, _ := ComputeTOTP(rfcTestSecret, now.Unix()/TOTP_PERIOD-2)
+
+	step, err := MatchTOTP(rfcTestSecret, previousCode, now)
+	if err != nil || step != now.Unix()/TOTP_PERIOD-1 {
+		t.Errorf("Expected code from the previous period to match, got step %v: %v", step, err)
+	}
+
+	step, err = MatchTOTP(rfcTestSecret, staleCode, now)
+	if err != nil || step != -1 {
+		t.Errorf("Expected code from two periods ago not to match, got step %v: %v", step, err)
+	}
+}
+
+func TestGeneratedSecretsRoundTrip(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := ComputeTOTP(secret, time.Now().Unix()/TOTP_PERIOD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if step, _ := MatchTOTP(secret, code, time.Now()); step < 0 {
+		t.Errorf("Expected %v to match secret %v", code, secret)
+	}
+
+	uri := BuildTOTPProvisioningURI(secret, TWO_FACTOR_ISSUER, "test@test.com")
+	if !strings.HasPrefix(uri, "otpauth://totp/Neighbors:test@test.com?") || !strings.Contains(uri, "secret="+secret) {
+		t.Errorf("Unexpected provisioning URI %v", uri)
+	}
+}
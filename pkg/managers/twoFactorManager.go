@@ -0,0 +1,361 @@
+package managers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var upsertTwoFactorSecretQuery = database.Insert("twoFactorSecrets", "UserID", "Secret", "EnabledTime", "LastUsedStep").OnConflict("UserID").DoUpdate("Secret", "EnabledTime", "LastUsedStep")
+var deleteTwoFactorSecretQuery = database.Delete("twoFactorSecrets").Where("UserID = ?")
+var getTwoFactorSecretQuery = database.Select("twoFactorSecrets", "UserID", "Secret", "EnabledTime", "LastUsedStep").Where("UserID = ?")
+var enableTwoFactorSecretQuery = database.Update("twoFactorSecrets").Set("EnabledTime", "LastUsedStep").Where("UserID = ?", "EnabledTime IS NULL")
+var updateTwoFactorLastUsedStepQuery = database.Update("twoFactorSecrets").Set("LastUsedStep").Where("UserID = ?", "LastUsedStep < ?")
+var createRecoveryCodeQuery = database.Insert("recoveryCodes", "UserID", "CodeHash")
+var deleteRecoveryCodesQuery = database.Delete("recoveryCodes").Where("UserID = ?")
+var useRecoveryCodeQuery = database.Update("recoveryCodes").Set("UsedTime").Where("UserID = ?", "CodeHash = ?", "UsedTime IS NULL")
+var createTwoFactorChallengeQuery = database.Insert("twoFactorChallenges", "ChallengeKey", "UserID", "UserType", "CreatedTime")
+var getTwoFactorChallengeQuery = database.Select("twoFactorChallenges", "ChallengeKey", "UserID", "UserType", "CreatedTime").Where("ChallengeKey = ?")
+var deleteTwoFactorChallengeQuery = database.Delete("twoFactorChallenges").Where("ChallengeKey = ?")
+
+const TWO_FACTOR_ISSUER = "Neighbors"
+const TWO_FACTOR_CHALLENGE_LIFETIME = 5 * time.Minute
+const RECOVERY_CODE_COUNT = 10
+const RECOVERY_CODE_LENGTH = 10
+
+// readableCodeRunes leave out characters that are easily confused, such as l, 1, o and 0, so
+// codes can be read out and typed in.
+var readableCodeRunes = []rune("abcdefghjkmnpqrstuvwxyz23456789")
+
+var ErrTwoFactorAlreadyEnabled error = &ConflictError{Message: "Two-factor authentication is already enabled"}
+var ErrTwoFactorNotEnrolled error = &ConflictError{Message: "Two-factor authentication has not been set up"}
+var ErrInvalidTwoFactorCode error = &ValidationError{Field: "Code", Message: "Invalid two-factor authentication code"}
+
+// TwoFactorManager stores TOTP secrets, hashed recovery codes, and the short-lived challenges
+// that sit between a correct password and a session. When the site settings require it, shelter
+// accounts must enroll before they can make changes.
+type TwoFactorManager struct {
+	Datasource database.Datasource
+}
+
+type TwoFactorSecret struct {
+	UserID       int64
+	Secret       string
+	EnabledTime  int64
+	LastUsedStep int64
+}
+
+type TwoFactorChallenge struct {
+	ChallengeKey string
+	UserID       int64
+	UserType     UserType
+	CreatedTime  int64
+}
+
+type TwoFactorEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+func (secret *TwoFactorSecret) IsEnabled() bool {
+	return secret != nil && secret.EnabledTime > 0
+}
+
+func (tm *TwoFactorManager) GetTwoFactorSecret(ctx context.Context, userID int64) (*TwoFactorSecret, error) {
+	row := tm.Datasource.ExecuteSingleReadQuery(ctx, getTwoFactorSecretQuery, []interface{}{userID})
+	var id int64
+	var secret string
+	var enabledTime sql.NullInt64
+	var lastUsedStep int64
+	if err := row.Scan(&id, &secret, &enabledTime, &lastUsedStep); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &TwoFactorSecret{UserID: id, Secret: secret, EnabledTime: enabledTime.Int64, LastUsedStep: lastUsedStep}, nil
+}
+
+func (tm *TwoFactorManager) IsEnabled(ctx context.Context, userID int64) (bool, error) {
+	secret, err := tm.GetTwoFactorSecret(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return secret.IsEnabled(), nil
+}
+
+// IsEnrollmentRequired reports whether policy requires this user to set up two-factor
+// authentication before making changes.
+func (tm *TwoFactorManager) IsEnrollmentRequired(ctx context.Context, userID int64, userType UserType) (bool, error) {
+	required, err := tm.IsRequired(ctx, userType)
+	if err != nil || !required {
+		return false, err
+	}
+
+	enabled, err := tm.IsEnabled(ctx, userID)
+	return !enabled, err
+}
+
+// IsRequired reports whether policy requires users of this type to have two-factor
+// authentication, so they can't turn it off.
+func (tm *TwoFactorManager) IsRequired(ctx context.Context, userType UserType) (bool, error) {
+	if userType != SHELTER {
+		return false, nil
+	}
+
+	settings, err := (&SettingsManager{Datasource: tm.Datasource}).GetSiteSettings(ctx)
+	if err != nil {
+		return false, err
+	}
+	return settings.RequireShelterTwoFactor, nil
+}
+
+// BeginEnrollment replaces any unconfirmed secret for the user with a new one.
+func (tm *TwoFactorManager) BeginEnrollment(ctx context.Context, userID int64, accountName string) (*TwoFactorEnrollment, error) {
+	existingSecret, err := tm.GetTwoFactorSecret(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingSecret.IsEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	// Starting over replaces any enrollment the user abandoned before confirming it.
+	_, err = tm.Datasource.ExecuteWriteQuery(ctx, upsertTwoFactorSecretQuery, []interface{}{userID, secret, nil, 0})
+	if err != nil {
+		return nil, err
+	}
+	return &TwoFactorEnrollment{Secret: secret, ProvisioningURI: BuildTOTPProvisioningURI(secret, TWO_FACTOR_ISSUER, accountName)}, nil
+}
+
+// ConfirmEnrollment enables two-factor authentication once the user proves their app generates
+// valid codes, and returns a fresh set of recovery codes. Only hashes of the codes are stored.
+func (tm *TwoFactorManager) ConfirmEnrollment(ctx context.Context, userID int64, code string) ([]string, error) {
+	secret, err := tm.GetTwoFactorSecret(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+
+	if secret.IsEnabled() {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	step, err := MatchTOTP(secret.Secret, code, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if step < 0 {
+		return nil, ErrInvalidTwoFactorCode
+	}
+
+	values := []interface{}{time.Now().Unix(), step, userID}
+	var recoveryCodes []string
+	err = tm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		if _, err := tx.ExecuteWriteQuery(ctx, enableTwoFactorSecretQuery, values); err != nil {
+			return err
+		}
+
+		if err := recordAuditEvent(ctx, tx, AUDIT_TWO_FACTOR_ENABLE, AUDIT_USER, userID, nil, nil); err != nil {
+			return err
+		}
+		recoveryCodes, err = (&TwoFactorManager{Datasource: tx}).replaceRecoveryCodes(ctx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+func (tm *TwoFactorManager) RegenerateRecoveryCodes(ctx context.Context, userID int64) ([]string, error) {
+	var recoveryCodes []string
+	err := tm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		var err error
+		recoveryCodes, err = (&TwoFactorManager{Datasource: tx}).replaceRecoveryCodes(ctx, userID)
+		if err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_RECOVERY_CODES_REGENERATE, AUDIT_USER, userID, nil, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+func (tm *TwoFactorManager) replaceRecoveryCodes(ctx context.Context, userID int64) ([]string, error) {
+	_, err := tm.Datasource.ExecuteWriteQuery(ctx, deleteRecoveryCodesQuery, []interface{}{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes := make([]string, RECOVERY_CODE_COUNT)
+	for i := range recoveryCodes {
+		recoveryCode, err := generateReadableCode(RECOVERY_CODE_LENGTH)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tm.Datasource.ExecuteWriteQuery(ctx, createRecoveryCodeQuery, []interface{}{userID, HashSecret(normalizeReadableCode(recoveryCode))})
+		if err != nil {
+			return nil, err
+		}
+		recoveryCodes[i] = recoveryCode
+	}
+	return recoveryCodes, nil
+}
+
+func (tm *TwoFactorManager) DisableTwoFactor(ctx context.Context, userID int64) error {
+	return tm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		_, err := tx.ExecuteWriteQuery(ctx, deleteRecoveryCodesQuery, []interface{}{userID})
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecuteWriteQuery(ctx, deleteTwoFactorSecretQuery, []interface{}{userID})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected, err := result.RowsAffected(); err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_TWO_FACTOR_DISABLE, AUDIT_USER, userID, nil, nil)
+	})
+}
+
+// VerifyCode accepts either a current TOTP code or an unused recovery code. Each TOTP code and
+// recovery code can only be used once.
+func (tm *TwoFactorManager) VerifyCode(ctx context.Context, userID int64, code string) (bool, error) {
+	secret, err := tm.GetTwoFactorSecret(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if !secret.IsEnabled() {
+		return false, ErrTwoFactorNotEnrolled
+	}
+
+	step, err := MatchTOTP(secret.Secret, code, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	if step > secret.LastUsedStep {
+		result, err := tm.Datasource.ExecuteWriteQuery(ctx, updateTwoFactorLastUsedStepQuery, []interface{}{step, userID, step})
+		if err != nil {
+			return false, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		return rowsAffected > 0, err
+	}
+
+	if step >= 0 {
+		return false, nil
+	}
+
+	values := []interface{}{time.Now().Unix(), userID, HashSecret(normalizeReadableCode(code))}
+	var rowsAffected int64
+	err = tm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, useRecoveryCodeQuery, values)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_RECOVERY_CODE_USE, AUDIT_USER, userID, nil, nil)
+	})
+	return rowsAffected > 0 && err == nil, err
+}
+
+func (tm *TwoFactorManager) WriteChallenge(ctx context.Context, userID int64, userType UserType) (string, error) {
+	challengeKey := strconv.FormatInt(userID, 10) + "-" + uuid.New().String()
+	values := []interface{}{challengeKey, userID, userType, time.Now().Unix()}
+	_, err := tm.Datasource.ExecuteWriteQuery(ctx, createTwoFactorChallengeQuery, values)
+	if err != nil {
+		return "", err
+	}
+	return challengeKey, nil
+}
+
+// GetChallenge returns nil once the challenge has expired.
+func (tm *TwoFactorManager) GetChallenge(ctx context.Context, challengeKey string) (*TwoFactorChallenge, error) {
+	row := tm.Datasource.ExecuteSingleReadQuery(ctx, getTwoFactorChallengeQuery, []interface{}{challengeKey})
+	var key string
+	var userID int64
+	var userType UserType
+	var createdTime int64
+	if err := row.Scan(&key, &userID, &userType, &createdTime); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if time.Now().After(time.Unix(createdTime, 0).Add(TWO_FACTOR_CHALLENGE_LIFETIME)) {
+		_, err := tm.DeleteChallenge(ctx, challengeKey)
+		return nil, err
+	}
+	return &TwoFactorChallenge{ChallengeKey: key, UserID: userID, UserType: userType, CreatedTime: createdTime}, nil
+}
+
+func (tm *TwoFactorManager) DeleteChallenge(ctx context.Context, challengeKey string) (int64, error) {
+	result, err := tm.Datasource.ExecuteWriteQuery(ctx, deleteTwoFactorChallengeQuery, []interface{}{challengeKey})
+	if err != nil {
+		return -1, err
+	}
+	return result.RowsAffected()
+}
+
+// HashSecret hashes high-entropy secrets such as recovery codes for storage. Unlike passwords
+// they don't need a slow hash, and a deterministic one lets them be looked up directly.
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateReadableCode returns length random readableCodeRunes, split in two by a hyphen.
+func generateReadableCode(length int) (string, error) {
+	// Bytes at or above the largest multiple of len(readableCodeRunes) are discarded so every
+	// character is equally likely.
+	maxByte := 256 - 256%len(readableCodeRunes)
+	code := make([]rune, 0, length)
+	randomByte := make([]byte, 1)
+	for len(code) < length {
+		if _, err := rand.Read(randomByte); err != nil {
+			return "", err
+		}
+
+		if int(randomByte[0]) < maxByte {
+			code = append(code, readableCodeRunes[int(randomByte[0])%len(readableCodeRunes)])
+		}
+	}
+	half := length / 2
+	return string(code[:half]) + "-" + string(code[half:]), nil
+}
+
+func normalizeReadableCode(code string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+}
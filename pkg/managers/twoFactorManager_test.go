@@ -0,0 +1,171 @@
+package managers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+func initTwoFactorManager() (*TwoFactorManager, int64) {
+	dbToClose = database.InitDatabase(database.SQLITE3)
+	datasource := database.StandardDatasource{Database: dbToClose}
+	userManager := &UserManager{Datasource: datasource}
+	userID, _ := userManager.WriteUser(context.Background(), generateUser(0), "password")
+	return &TwoFactorManager{Datasource: datasource}, userID
+}
+
+func enrollTestUser(t *testing.T, manager *TwoFactorManager, userID int64) (string, []string) {
+	enrollment, err := manager.BeginEnrollment(context.Background(), userID, "test@test.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, _ := ComputeTOTP(enrollment.Secret, time.Now().Unix()/TOTP_PERIOD-1)
+	recoveryCodes, err := manager.ConfirmEnrollment(context.Background(), userID, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enrollment.Secret, recoveryCodes
+}
+
+func TestRestartingEnrollmentReplacesTheSecret(t *testing.T) {
+	manager, userID := initTwoFactorManager()
+	defer cleanDatabase()
+
+	first, err := manager.BeginEnrollment(context.Background(), userID, "test@test.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := manager.BeginEnrollment(context.Background(), userID, "test@test.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := manager.GetTwoFactorSecret(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if secret.Secret == first.Secret || secret.Secret != second.Secret {
+		t.Error("Expected the latest enrollment to replace the earlier one")
+	}
+}
+
+func TestItEnablesTwoFactorAfterConfirmation(t *testing.T) {
+	manager, userID := initTwoFactorManager()
+	defer cleanDatabase()
+
+	enrollment, err := manager.BeginEnrollment(context.Background(), userID, "test@test.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if enabled, _ := manager.IsEnabled(context.Background(), userID); enabled {
+		t.Error("Expected two-factor to stay disabled until confirmed")
+	}
+
+	_, err = manager.ConfirmEnrollment(context.Background(), userID, "000000")
+	if err != ErrInvalidTwoFactorCode {
+		t.Errorf("Expected %v to equal %v", err, ErrInvalidTwoFactorCode)
+	}
+
+	code, _ := ComputeTOTP(enrollment.Secret, time.Now().Unix()/TOTP_PERIOD)
+	recoveryCodes, err := manager.ConfirmEnrollment(context.Background(), userID, code)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(recoveryCodes) != RECOVERY_CODE_COUNT {
+		t.Errorf("Expected %v recovery codes, got %v", RECOVERY_CODE_COUNT, len(recoveryCodes))
+	}
+
+	if enabled, _ := manager.IsEnabled(context.Background(), userID); !enabled {
+		t.Error("Expected two-factor to be enabled after confirmation")
+	}
+}
+
+func TestItRejectsReusedTOTPCode(t *testing.T) {
+	manager, userID := initTwoFactorManager()
+	defer cleanDatabase()
+	secret, _ := enrollTestUser(t, manager, userID)
+
+	code, _ := ComputeTOTP(secret, time.Now().Unix()/TOTP_PERIOD)
+	verified, err := manager.VerifyCode(context.Background(), userID, code)
+	if err != nil || !verified {
+		t.Errorf("Expected current code to verify: %v", err)
+	}
+
+	verified, err = manager.VerifyCode(context.Background(), userID, code)
+	if err != nil || verified {
+		t.Errorf("Expected reused code to be rejected: %v", err)
+	}
+}
+
+func TestRecoveryCodesCanOnlyBeUsedOnce(t *testing.T) {
+	manager, userID := initTwoFactorManager()
+	defer cleanDatabase()
+	_, recoveryCodes := enrollTestUser(t, manager, userID)
+
+	verified, err := manager.VerifyCode(context.Background(), userID, recoveryCodes[0])
+	if err != nil || !verified {
+		t.Errorf("Expected recovery code to verify: %v", err)
+	}
+
+	verified, err = manager.VerifyCode(context.Background(), userID, recoveryCodes[0])
+	if err != nil || verified {
+		t.Errorf("Expected used recovery code to be rejected: %v", err)
+	}
+}
+
+func TestEnrollmentRequiredOnlyForUnenrolledShelters(t *testing.T) {
+	manager, userID := initTwoFactorManager()
+	defer cleanDatabase()
+	if required, _ := manager.IsEnrollmentRequired(context.Background(), userID, SHELTER); required {
+		t.Error("Expected shelters not to require two-factor until the settings say so")
+	}
+
+	if err := (&SettingsManager{Datasource: manager.Datasource}).SaveSiteSettings(context.Background(), &SiteSettings{RequireShelterTwoFactor: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if required, _ := manager.IsEnrollmentRequired(context.Background(), userID, SAMARITAN); required {
+		t.Error("Expected samaritans not to require two-factor")
+	}
+
+	if required, _ := manager.IsEnrollmentRequired(context.Background(), userID, SHELTER); !required {
+		t.Error("Expected unenrolled shelter to require two-factor")
+	}
+
+	enrollTestUser(t, manager, userID)
+	if required, _ := manager.IsEnrollmentRequired(context.Background(), userID, SHELTER); required {
+		t.Error("Expected enrolled shelter not to require two-factor")
+	}
+}
+
+func TestCanReadItsOwnChallengeWrite(t *testing.T) {
+	manager, userID := initTwoFactorManager()
+	defer cleanDatabase()
+
+	challengeKey, err := manager.WriteChallenge(context.Background(), userID, SHELTER)
+	if err != nil {
+		t.Error(err)
+	}
+
+	challenge, err := manager.GetChallenge(context.Background(), challengeKey)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if challenge == nil || challenge.UserID != userID || challenge.UserType != SHELTER {
+		t.Errorf("Expected challenge for %v, got %v", userID, challenge)
+	}
+
+	manager.DeleteChallenge(context.Background(), challengeKey)
+	challenge, err = manager.GetChallenge(context.Background(), challengeKey)
+	if err != nil || challenge != nil {
+		t.Errorf("Expected deleted challenge to be gone, got %v: %v", challenge, err)
+	}
+}
@@ -3,19 +3,38 @@ package managers
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/kwhite17/Neighbors/pkg/database"
 	"golang.org/x/crypto/bcrypt"
 )
 
-var createUserQuery = "INSERT INTO users (City, Email, Name, Password, PostalCode, State, Street, UserType) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
-var deleteUserQuery = "DELETE FROM users WHERE ID=$1"
-var getSingleUserQuery = "SELECT ID, City, Email, Name, PostalCode, State, Street, UserType FROM users where ID=$1"
-var getSingleUserByEmailQuery = "SELECT ID, City, Email, Name, PostalCode, State, Street, UserType FROM users where Email=$1"
-var getAllSheltersQuery = "SELECT ID, City, Email, Name, PostalCode, State, Street, UserType FROM users WHERE UserType=1"
-var updateUserQuery = "UPDATE users SET City = $1, Email = $2, Name = $3, PostalCode = $4, State = $5, Street = $6 WHERE ID = $7"
-var updatePasswordByEmailQuery = "UPDATE users SET Password = $1 WHERE Email = $2"
-var getPasswordForUsernameQuery = "SELECT ID, Password, UserType FROM users WHERE Name = $1"
+var userColumns = []string{"ID", "City", "Email", "Name", "PostalCode", "State", "Street", "UserType", "Username", "ClosedTime", "Version", "DeletedTime"}
+
+var createUserQuery = database.Insert("users", "City", "Email", "Name", "Password", "PostalCode", "State", "Street", "UserType", "Username").Returning("ID")
+var deleteUserQuery = database.Update("users").Set("DeletedTime").SetExpression("Version = Version + 1").Where("ID = ?", "DeletedTime IS NULL")
+var restoreUserQuery = database.Update("users").SetExpression("DeletedTime = NULL", "Version = Version + 1").Where("ID = ?", "DeletedTime IS NOT NULL")
+var purgeUserQuery = database.Delete("users").Where("ID = ?")
+var detachClaimedItemsQuery = database.Update("items").SetExpression("SamaritanID = NULL").Where("SamaritanID = ?")
+
+// A deleted shelter's items are deleted along with it, at the same time, so restoring the shelter
+// brings back only those and not the ones it had deleted itself.
+var deleteItemsForShelterQuery = database.Update("items").Set("DeletedTime").SetExpression("Version = Version + 1").Where("ShelterID = ?", "DeletedTime IS NULL")
+var restoreItemsForShelterQuery = database.Update("items").SetExpression("DeletedTime = NULL", "Version = Version + 1").Where("ShelterID = ?", "DeletedTime = (SELECT users.DeletedTime FROM users WHERE users.ID = items.ShelterID)")
+var getSingleUserQuery = database.Select("users", userColumns...).Where("ID = ?", "DeletedTime IS NULL")
+var getSingleUserByEmailQuery = database.Select("users", userColumns...).Where("LOWER(Email) = ?", "DeletedTime IS NULL")
+var getAllSheltersQuery = database.Select("users", userColumns...).Where("UserType = 1", "ClosedTime IS NULL", "DeletedTime IS NULL")
+var getDeletedUsersQuery = database.Select("users", userColumns...).Where("DeletedTime IS NOT NULL").OrderBy("DeletedTime DESC")
+var getUsersToPurgeQuery = database.Select("users", "ID").Where("DeletedTime < ?")
+var updateUserQuery = database.Update("users").Set("City", "Email", "Name", "PostalCode", "State", "Street", "Username").SetExpression("Version = Version + 1").Where("ID = ?", "Version = ?", "DeletedTime IS NULL")
+var updatePasswordByEmailQuery = database.Update("users").Set("Password").Where("LOWER(Email) = ?", "DeletedTime IS NULL")
+var getPasswordForEmailQuery = database.Select("users", "ID", "Password", "UserType", "ClosedTime").Where("LOWER(Email) = ?", "AnonymizedTime IS NULL", "DeletedTime IS NULL")
+var getPasswordForUsernameQuery = database.Select("users", "ID", "Password", "UserType", "ClosedTime").Where("LOWER(Username) = ?", "AnonymizedTime IS NULL", "DeletedTime IS NULL")
+
+var ErrDuplicateEmail error = &ConflictError{Message: "An account with that email already exists"}
+var ErrDuplicateUsername error = &ConflictError{Message: "That username is already taken"}
+var ErrUserVersionConflict error = &ConflictError{Message: "This profile was changed by someone else. Review the latest version and try again"}
 
 type UserManager struct {
 	Datasource database.Datasource
@@ -26,6 +45,8 @@ type UserType int
 const (
 	SHELTER   UserType = 1
 	SAMARITAN UserType = 2
+	// ADMIN users can review the audit log. They can't be created by registering.
+	ADMIN UserType = 3
 )
 
 type ContactInformation struct {
@@ -41,39 +62,16 @@ type User struct {
 	ID       int64
 	Password string
 	UserType UserType
+	Username string
+	// ClosedTime is set when the user closes their account, until it is reopened or anonymized.
+	ClosedTime int64
+	// Version is incremented whenever the profile changes, and updates must name the version they were based on.
+	Version int64
+	// DeletedTime is set when an administrator deletes the account, until it is restored or purged.
+	DeletedTime int64
 	*ContactInformation
 }
 
-func (um *UserManager) ValidateForUserCreate(ctx context.Context, user *User) bool {
-	hasEmail := user.Email != ""
-	hasName := user.Name != ""
-	if !hasEmail || !hasName {
-		return false
-	}
-
-	if user.UserType == SAMARITAN {
-		return true
-	}
-
-	if user.City == "" {
-		return false
-	}
-
-	if user.Name == "" {
-		return false
-	}
-
-	if user.PostalCode == "" {
-		return false
-	}
-
-	if user.State == "" {
-		return false
-	}
-
-	return user.Street != ""
-}
-
 func (um *UserManager) GetUser(ctx context.Context, id interface{}) (*User, error) {
 	result, err := um.Datasource.ExecuteBatchReadQuery(ctx, getSingleUserQuery, []interface{}{id})
 
@@ -88,13 +86,15 @@ func (um *UserManager) GetUser(ctx context.Context, id interface{}) (*User, erro
 	}
 
 	if len(user) < 1 {
-		return nil, nil
+		return nil, &NotFoundError{Entity: "User", ID: id}
 	}
 
 	return user[0], nil
 }
+
+// GetUserByEmail returns nil if no account has the email address.
 func (um *UserManager) GetUserByEmail(ctx context.Context, email string) (*User, error) {
-	result, err := um.Datasource.ExecuteBatchReadQuery(ctx, getSingleUserByEmailQuery, []interface{}{email})
+	result, err := um.Datasource.ExecuteBatchReadQuery(ctx, getSingleUserByEmailQuery, []interface{}{NormalizeEmail(email)})
 
 	if err != nil {
 		return nil, err
@@ -113,16 +113,29 @@ func (um *UserManager) GetUserByEmail(ctx context.Context, email string) (*User,
 	return user[0], nil
 }
 
-func (um *UserManager) GetPasswordForUsername(ctx context.Context, username string) (*User, error) {
-	row := um.Datasource.ExecuteSingleReadQuery(ctx, getPasswordForUsernameQuery, []interface{}{username})
+// RecordFailedLogin adds a failed login to the audit log. The event isn't attached to the account
+// the identifier matches, since whoever typed it may not own it.
+func (um *UserManager) RecordFailedLogin(ctx context.Context, identifier string) error {
+	return recordAuditEvent(ctx, um.Datasource, AUDIT_LOGIN_FAILED, AUDIT_USER, 0, nil, map[string]string{"Identifier": identifier})
+}
+
+// GetPasswordForLogin looks up the credentials for an account by email address or username,
+// ignoring case. Identifiers containing an @ are treated as email addresses.
+func (um *UserManager) GetPasswordForLogin(ctx context.Context, identifier string) (*User, error) {
+	query := getPasswordForUsernameQuery
+	if strings.Contains(identifier, "@") {
+		query = getPasswordForEmailQuery
+	}
+	row := um.Datasource.ExecuteSingleReadQuery(ctx, query, []interface{}{strings.ToLower(strings.TrimSpace(identifier))})
 
 	var ID int64
 	var password string
 	var userType UserType
-	if err := row.Scan(&ID, &password, &userType); err != nil {
+	var closedTime sql.NullInt64
+	if err := row.Scan(&ID, &password, &userType, &closedTime); err != nil {
 		return nil, err
 	}
-	user := User{ID: ID, Password: password, UserType: userType}
+	user := User{ID: ID, Password: password, UserType: userType, ClosedTime: closedTime.Int64}
 	return &user, nil
 }
 
@@ -144,18 +157,68 @@ func (um *UserManager) WriteUser(ctx context.Context, user *User, unencryptedPas
 		return -1, err
 	}
 
-	values := []interface{}{user.City, user.Email, user.Name, encryptedPassword, user.PostalCode, user.State, user.Street, user.UserType}
-	result, err := um.Datasource.ExecuteWriteQuery(ctx, createUserQuery, values, true)
+	user.Email = NormalizeEmail(user.Email)
+	user.Username = NormalizeUsername(user.Username)
+	values := []interface{}{user.City, user.Email, user.Name, encryptedPassword, user.PostalCode, user.State, user.Street, user.UserType, user.Username}
+	var userID int64
+	err = um.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, createUserQuery, values)
+		if err != nil {
+			return um.translateWriteError(err)
+		}
+
+		userID, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		created := *user
+		created.ID = userID
+		created.Version = 1
+		return recordAuditEvent(ctx, tx, AUDIT_USER_CREATE, AUDIT_USER, userID, nil, &created)
+	})
 	if err != nil {
 		return -1, err
 	}
-	return result.LastInsertId()
+	user.Version = 1
+	return userID, nil
 }
 
+// UpdateUser only applies the update if the profile is still at user.Version, returning
+// ErrUserVersionConflict otherwise. On success user.Version is advanced to match the stored profile.
 func (um *UserManager) UpdateUser(ctx context.Context, user *User) error {
-	values := []interface{}{user.City, user.Email, user.Name, user.PostalCode, user.State, user.Street, user.ID}
-	_, err := um.Datasource.ExecuteWriteQuery(ctx, updateUserQuery, values, true)
-	return err
+	user.Email = NormalizeEmail(user.Email)
+	user.Username = NormalizeUsername(user.Username)
+	previousUser, err := um.GetUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	values := []interface{}{user.City, user.Email, user.Name, user.PostalCode, user.State, user.Street, user.Username, user.ID, user.Version}
+	err = um.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, updateUserQuery, values)
+		if err != nil {
+			return um.translateWriteError(err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return ErrUserVersionConflict
+		}
+
+		updated := *user
+		updated.Version++
+		return recordAuditEvent(ctx, tx, AUDIT_USER_UPDATE, AUDIT_USER, user.ID, previousUser, &updated)
+	})
+	if err != nil {
+		return err
+	}
+	user.Version++
+	return nil
 }
 
 func (um *UserManager) UpdatePasswordForUser(ctx context.Context, email string, unencryptedPassword string) error {
@@ -164,17 +227,135 @@ func (um *UserManager) UpdatePasswordForUser(ctx context.Context, email string,
 		return err
 	}
 
-	values := []interface{}{encryptedPassword, email}
-	_, err = um.Datasource.ExecuteWriteQuery(ctx, updatePasswordByEmailQuery, values, true)
-	return err
+	values := []interface{}{encryptedPassword, NormalizeEmail(email)}
+	return um.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, updatePasswordByEmailQuery, values)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected, err := result.RowsAffected(); err != nil || rowsAffected == 0 {
+			return err
+		}
+
+		user, err := (&UserManager{Datasource: tx}).GetUserByEmail(ctx, email)
+		if err != nil || user == nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_PASSWORD_RESET, AUDIT_USER, user.ID, nil, nil)
+	})
 }
 
+// DeleteUser hides the account and signs it out everywhere. A shelter's items are hidden with it,
+// so they can't be claimed. Its data is kept, so it can be restored, until it is purged.
 func (um *UserManager) DeleteUser(ctx context.Context, id interface{}) (int64, error) {
-	result, err := um.Datasource.ExecuteWriteQuery(ctx, deleteUserQuery, []interface{}{id}, true)
+	previousUser, err := um.GetUser(ctx, id)
 	if err != nil {
 		return -1, err
 	}
-	return result.RowsAffected()
+
+	var rowsAffected int64
+	err = um.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		deletedTime := time.Now().Unix()
+		result, err := tx.ExecuteWriteQuery(ctx, deleteUserQuery, []interface{}{deletedTime, id})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 || previousUser == nil {
+			return err
+		}
+
+		if _, err = tx.ExecuteWriteQuery(ctx, deleteItemsForShelterQuery, []interface{}{deletedTime, previousUser.ID}); err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecuteWriteQuery(ctx, deleteUserSessionsForUserQuery, []interface{}{previousUser.ID}); err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecuteWriteQuery(ctx, revokeApiTokensForUserQuery, []interface{}{deletedTime, previousUser.ID}); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_USER_DELETE, AUDIT_USER, previousUser.ID, previousUser, nil)
+	})
+	if err != nil {
+		return -1, err
+	}
+	return rowsAffected, nil
+}
+
+// RestoreUser undoes DeleteUser. The user has to log in again, and create new API tokens.
+// It reports whether there was a deleted account to restore.
+func (um *UserManager) RestoreUser(ctx context.Context, id int64) (bool, error) {
+	var rowsAffected int64
+	err := um.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		if _, err := tx.ExecuteWriteQuery(ctx, restoreItemsForShelterQuery, []interface{}{id}); err != nil {
+			return err
+		}
+
+		result, err := tx.ExecuteWriteQuery(ctx, restoreUserQuery, []interface{}{id})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_USER_RESTORE, AUDIT_USER, id, nil, nil)
+	})
+	return rowsAffected > 0 && err == nil, err
+}
+
+// GetDeletedUsers returns the accounts that can still be restored, most recently deleted first.
+func (um *UserManager) GetDeletedUsers(ctx context.Context) ([]*User, error) {
+	result, err := um.Datasource.ExecuteBatchReadQuery(ctx, getDeletedUsersQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	return um.buildUsers(result)
+}
+
+// PurgeUser permanently removes an account along with everything that belongs to it, and scrubs
+// its profile from the audit log. Items it claimed from shelters are kept: claims that weren't delivered go back up for claiming, and the
+// rest lose their samaritan.
+func (um *UserManager) PurgeUser(ctx context.Context, id int64) error {
+	return um.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		if _, err := tx.ExecuteWriteQuery(ctx, releaseClaimedItemsQuery, []interface{}{CREATED, id, CLAIMED}); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecuteWriteQuery(ctx, detachClaimedItemsQuery, []interface{}{id}); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecuteWriteQuery(ctx, purgeUserQuery, []interface{}{id}); err != nil {
+			return err
+		}
+
+		if err := redactAuditStates(ctx, tx, AUDIT_USER, id); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_USER_PURGE, AUDIT_USER, id, nil, nil)
+	})
+}
+
+// PurgeDeletedUsers purges every account deleted before the given time, and returns how many it
+// purged.
+func (um *UserManager) PurgeDeletedUsers(ctx context.Context, before time.Time) (int, error) {
+	userIDs, err := readIDs(um.Datasource.ExecuteBatchReadQuery(ctx, getUsersToPurgeQuery, []interface{}{before.Unix()}))
+	if err != nil {
+		return 0, err
+	}
+
+	for i, userID := range userIDs {
+		if err := um.PurgeUser(ctx, userID); err != nil {
+			return i, err
+		}
+	}
+	return len(userIDs), nil
 }
 
 func (um *UserManager) buildUsers(result *sql.Rows) ([]*User, error) {
@@ -188,11 +369,15 @@ func (um *UserManager) buildUsers(result *sql.Rows) ([]*User, error) {
 		var state string
 		var street string
 		var userType int
-		if err := result.Scan(&id, &city, &email, &name, &postalCode, &state, &street, &userType); err != nil {
+		var username string
+		var closedTime sql.NullInt64
+		var version int64
+		var deletedTime sql.NullInt64
+		if err := result.Scan(&id, &city, &email, &name, &postalCode, &state, &street, &userType, &username, &closedTime, &version, &deletedTime); err != nil {
 			return nil, err
 		}
 		contactInfo := &ContactInformation{City: city, Email: email, Name: name, PostalCode: postalCode, State: state, Street: street}
-		user := User{ID: id, ContactInformation: contactInfo, UserType: UserType(userType)}
+		user := User{ID: id, ContactInformation: contactInfo, UserType: UserType(userType), Username: username, ClosedTime: closedTime.Int64, Version: version, DeletedTime: deletedTime.Int64}
 		response = append(response, &user)
 	}
 	return response, nil
@@ -205,3 +390,25 @@ func (um *UserManager) encryptPassword(password string) (string, error) {
 	}
 	return string(hash), nil
 }
+
+// translateWriteError turns violations of the case-insensitive email and username indexes
+// into ErrDuplicateEmail and ErrDuplicateUsername.
+func (um *UserManager) translateWriteError(err error) error {
+	for _, key := range database.UniqueViolationKeys(err) {
+		switch key {
+		case "idx_users_username_lower", "users.Username":
+			return ErrDuplicateUsername
+		case "idx_users_email", "idx_users_email_lower", "users.Email":
+			return ErrDuplicateEmail
+		}
+	}
+	return err
+}
+
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
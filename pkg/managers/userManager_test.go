@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/lib/pq"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -18,6 +21,7 @@ var testName = "testName"
 var testPostalCode = "testPostalCode"
 var testState = "testState"
 var testStreet = "testStreet"
+var testUsernameFormat = "testUser%v"
 
 var dbToClose *sql.DB
 
@@ -111,6 +115,79 @@ func TestItCanDeleteUser(t *testing.T) {
 	}
 }
 
+func TestItCanRestoreDeletedUser(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+	testUser := generateUser(0)
+
+	id, err := manager.WriteUser(context.Background(), testUser, "password")
+	if err != nil {
+		t.Error(err)
+	}
+
+	manager.DeleteUser(context.Background(), id)
+	if deletedUser, _ := manager.GetUser(context.Background(), id); deletedUser != nil {
+		t.Errorf("Expected deleted user to be hidden, got %v", deletedUser)
+	}
+
+	if _, err := manager.GetPasswordForLogin(context.Background(), testUser.Username); err != sql.ErrNoRows {
+		t.Errorf("Expected deleted user not to be able to log in, got %v", err)
+	}
+
+	deletedUsers, _ := manager.GetDeletedUsers(context.Background())
+	if len(deletedUsers) != 1 || deletedUsers[0].ID != id {
+		t.Errorf("Expected user to be listed as deleted, got %v", deletedUsers)
+	}
+
+	restored, err := manager.RestoreUser(context.Background(), id)
+	if err != nil || !restored {
+		t.Errorf("Expected user to be restored: %v", err)
+	}
+
+	if restoredUser, _ := manager.GetUser(context.Background(), id); restoredUser == nil {
+		t.Error("Expected restored user to be visible")
+	}
+}
+
+func TestPurgingUserKeepsItemsTheyClaimed(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+	itemManager := &ItemManager{Datasource: manager.Datasource}
+
+	shelterID, _ := manager.WriteUser(context.Background(), generateUser(0), "password")
+	samaritan := generateUser(1)
+	samaritan.UserType = SAMARITAN
+	samaritanID, _ := manager.WriteUser(context.Background(), samaritan, "password")
+
+	item := generateItem()
+	item.ShelterID = shelterID
+	itemID, _ := itemManager.WriteItem(context.Background(), item)
+	item.ID = itemID
+	item.SamaritanID = samaritanID
+	item.Status = CLAIMED
+	itemManager.UpdateItem(context.Background(), item)
+
+	manager.DeleteUser(context.Background(), samaritanID)
+	purged, err := manager.PurgeDeletedUsers(context.Background(), time.Now().Add(time.Minute))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if purged != 1 {
+		t.Errorf("Expected 1 user to be purged, got %v", purged)
+	}
+
+	deletedUsers, _ := manager.GetDeletedUsers(context.Background())
+	if len(deletedUsers) != 0 {
+		t.Errorf("Expected purged user to be gone, got %v", deletedUsers)
+	}
+
+	releasedItem, _ := itemManager.GetItem(context.Background(), itemID)
+	if releasedItem == nil || releasedItem.SamaritanID != 0 || releasedItem.Status != CREATED {
+		t.Errorf("Expected claimed item to go back up for claiming, got %v", releasedItem)
+	}
+}
+
 func TestItCanGetAllUsers(t *testing.T) {
 	manager := initUserManager()
 	defer cleanDatabase()
@@ -174,6 +251,36 @@ func TestCanReadItsOwnUserUpdate(t *testing.T) {
 	}
 }
 
+func TestItRejectsUpdatesToStaleUsers(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+
+	id, err := manager.WriteUser(context.Background(), generateUser(0), "password")
+	if err != nil {
+		t.Error(err)
+	}
+
+	firstCopy, _ := manager.GetUser(context.Background(), id)
+	secondCopy, _ := manager.GetUser(context.Background(), id)
+
+	firstCopy.Street = "1 Main St"
+	err = manager.UpdateUser(context.Background(), firstCopy)
+	if err != nil {
+		t.Error(err)
+	}
+
+	secondCopy.City = "Somerville"
+	err = manager.UpdateUser(context.Background(), secondCopy)
+	if err != ErrUserVersionConflict {
+		t.Errorf("Expected %v to equal %v", err, ErrUserVersionConflict)
+	}
+
+	finalUser, _ := manager.GetUser(context.Background(), id)
+	if finalUser.City != testCity || finalUser.Version != firstCopy.Version {
+		t.Errorf("Expected %v to equal %v", finalUser, firstCopy)
+	}
+}
+
 func TestCanReadItsOwnUserPasswordUpdate(t *testing.T) {
 	manager := initUserManager()
 	defer cleanDatabase()
@@ -200,7 +307,7 @@ func TestCanReadItsOwnUserPasswordUpdate(t *testing.T) {
 		t.Error(err)
 	}
 
-	updatedUser, err := manager.GetPasswordForUsername(context.Background(), createdUser.Name)
+	updatedUser, err := manager.GetPasswordForLogin(context.Background(), createdUser.Username)
 	if err != nil {
 		t.Error(err)
 	}
@@ -223,16 +330,131 @@ func TestItGetsPasswordForUsername(t *testing.T) {
 	}
 	testUser.ID = id
 
-	createdUser, err := manager.GetPasswordForUsername(context.Background(), testUser.Name)
+	createdUser, err := manager.GetPasswordForLogin(context.Background(), strings.ToUpper(testUser.Username))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(createdUser.Password), []byte(unhashedPassword)) != nil {
+		t.Errorf("Expected %v to equal %v", []byte(createdUser.Password), []byte(unhashedPassword))
+	}
+}
+
+func TestItGetsPasswordForEmail(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+	testUser := generateUser(0)
+	unhashedPassword := "password"
+
+	id, err := manager.WriteUser(context.Background(), testUser, unhashedPassword)
 	if err != nil {
 		t.Error(err)
 	}
 
+	createdUser, err := manager.GetPasswordForLogin(context.Background(), " "+strings.ToUpper(testUser.Email))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if createdUser.ID != id {
+		t.Errorf("Expected %v to equal %v", createdUser.ID, id)
+	}
+
 	if bcrypt.CompareHashAndPassword([]byte(createdUser.Password), []byte(unhashedPassword)) != nil {
 		t.Errorf("Expected %v to equal %v", []byte(createdUser.Password), []byte(unhashedPassword))
 	}
 }
 
+func TestItRejectsDuplicateEmailIgnoringCase(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+
+	_, err := manager.WriteUser(context.Background(), generateUser(0), "password")
+	if err != nil {
+		t.Error(err)
+	}
+
+	duplicateUser := generateUser(1)
+	duplicateUser.Email = strings.ToUpper(fmt.Sprintf(testEmail, 0))
+	_, err = manager.WriteUser(context.Background(), duplicateUser, "password")
+	if err != ErrDuplicateEmail {
+		t.Errorf("Expected %v to equal %v", err, ErrDuplicateEmail)
+	}
+}
+
+func TestItRejectsDuplicateUsernameIgnoringCase(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+
+	_, err := manager.WriteUser(context.Background(), generateUser(0), "password")
+	if err != nil {
+		t.Error(err)
+	}
+
+	duplicateUser := generateUser(1)
+	duplicateUser.Username = strings.ToUpper(fmt.Sprintf(testUsernameFormat, 0))
+	_, err = manager.WriteUser(context.Background(), duplicateUser, "password")
+	if err != ErrDuplicateUsername {
+		t.Errorf("Expected %v to equal %v", err, ErrDuplicateUsername)
+	}
+}
+
+func TestItRejectsUpdateToTakenUsername(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+
+	_, err := manager.WriteUser(context.Background(), generateUser(0), "password")
+	if err != nil {
+		t.Error(err)
+	}
+
+	secondUser := generateUser(1)
+	secondUser.ID, err = manager.WriteUser(context.Background(), secondUser, "password")
+	if err != nil {
+		t.Error(err)
+	}
+
+	secondUser.Username = fmt.Sprintf(testUsernameFormat, 0)
+	err = manager.UpdateUser(context.Background(), secondUser)
+	if err != ErrDuplicateUsername {
+		t.Errorf("Expected %v to equal %v", err, ErrDuplicateUsername)
+	}
+}
+
+func TestDeletingAShelterHidesItsItemsUntilItIsRestored(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+	itemManager := &ItemManager{Datasource: manager.Datasource}
+	ctx := context.Background()
+
+	shelterID, err := manager.WriteUser(ctx, generateUser(0), "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := generateItem()
+	item.ShelterID = shelterID
+	openID, _ := itemManager.WriteItem(ctx, item)
+	deletedID, _ := itemManager.WriteItem(ctx, item)
+	itemManager.DeleteItem(ctx, deletedID)
+	dbToClose.Exec("UPDATE items SET DeletedTime = 1 WHERE ID = ?", deletedID)
+
+	manager.DeleteUser(ctx, shelterID)
+	if openItems, _ := itemManager.GetOpenItems(ctx, 0, 10); len(openItems) != 0 {
+		t.Errorf("Expected a deleted shelter's items to be hidden, got %v", openItems)
+	}
+
+	if _, err := itemManager.GetItem(ctx, openID); err == nil {
+		t.Error("Expected a deleted shelter's item not to be readable, so it can't be claimed")
+	}
+
+	manager.RestoreUser(ctx, shelterID)
+	openItems, _ := itemManager.GetOpenItems(ctx, 0, 10)
+	if len(openItems) != 1 || openItems[0].ID != openID {
+		t.Errorf("Expected only the items deleted with the shelter to be restored, got %v", openItems)
+	}
+}
+
 func generateUser(id int) *User {
 	contactInfo := &ContactInformation{
 		City:       testCity,
@@ -243,7 +465,7 @@ func generateUser(id int) *User {
 		Street:     testStreet,
 	}
 
-	return &User{ContactInformation: contactInfo, UserType: SHELTER}
+	return &User{ContactInformation: contactInfo, UserType: SHELTER, Username: fmt.Sprintf(testUsernameFormat, id)}
 }
 
 func containsUser(candidateUser *User, expectedUsers []*User) bool {
@@ -254,3 +476,21 @@ func containsUser(candidateUser *User, expectedUsers []*User) bool {
 	}
 	return false
 }
+
+func TestPostgresDuplicatesAreToldApartByConstraint(t *testing.T) {
+	manager := &UserManager{}
+	emailErr := &pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "idx_users_email_lower"`, Detail: "Key (lower(email))=(username@example.org) already exists.", Constraint: "idx_users_email_lower"}
+	if err := manager.translateWriteError(emailErr); err != ErrDuplicateEmail {
+		t.Errorf("Expected %v to equal %v", err, ErrDuplicateEmail)
+	}
+
+	usernameErr := &pq.Error{Code: "23505", Constraint: "idx_users_username_lower"}
+	if err := manager.translateWriteError(usernameErr); err != ErrDuplicateUsername {
+		t.Errorf("Expected %v to equal %v", err, ErrDuplicateUsername)
+	}
+
+	otherErr := &pq.Error{Code: "23503", Constraint: "idx_users_username_lower"}
+	if err := manager.translateWriteError(otherErr); err != otherErr {
+		t.Errorf("Expected other errors to be returned as they are, got %v", err)
+	}
+}
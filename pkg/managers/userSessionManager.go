@@ -2,7 +2,9 @@ package managers
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,10 +12,16 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-var createUserSessionQuery = "INSERT INTO userSessions (SessionKey, UserID, UserType, LoginTime, LastSeenTime) VALUES ($1, $2, $3, $4, $5)"
-var deleteUserSessionQuery = "DELETE FROM userSessions WHERE SessionKey=$1"
-var getUserSessionQuery = "SELECT SessionKey, UserID, UserType, LoginTime, LastSeenTime FROM userSessions WHERE SessionKey=$1"
-var updateUserSessionQuery = "UPDATE userSessions SET LoginTime = $1, LastSeenTime = $2 WHERE UserID = $3"
+var createUserSessionQuery = database.Insert("userSessions", "SessionKey", "UserID", "UserType", "LoginTime", "LastSeenTime")
+var deleteUserSessionQuery = database.Delete("userSessions").Where("SessionKey = ?")
+var getUserSessionQuery = database.Select("userSessions", "SessionKey", "UserID", "UserType", "LoginTime", "LastSeenTime").Where("SessionKey = ?")
+var updateUserSessionQuery = database.Update("userSessions").Set("LoginTime", "LastSeenTime").Where("UserID = ?")
+var deleteExpiredUserSessionsQuery = database.Delete("userSessions").Where("LoginTime < ?")
+var deleteAllUserSessionsQuery = database.Delete("userSessions")
+var countActiveUserSessionsQuery = database.Select("userSessions", "COUNT(*)").Where("LoginTime >= ?")
+
+// SESSION_LIFETIME is how long a browser session lasts after logging in.
+const SESSION_LIFETIME = 7 * 24 * time.Hour
 
 type SessionManger interface {
 	GetUserSession(ctx context.Context, sessionKey interface{}) (*UserSession, error)
@@ -32,6 +40,27 @@ type UserSession struct {
 	UserType     UserType
 	LoginTime    int64
 	LastSeenTime int64
+	// Scopes is only set for sessions authenticated by an API token. Browser sessions have every scope.
+	Scopes []string
+	// ApiTokenID is the token a session was authenticated by, if any.
+	ApiTokenID int64
+}
+
+func (us *UserSession) IsExpired(now time.Time) bool {
+	return now.After(time.Unix(us.LoginTime, 0).Add(SESSION_LIFETIME))
+}
+
+func (us *UserSession) HasScope(scope string) bool {
+	if us.Scopes == nil {
+		return true
+	}
+
+	for _, granted := range us.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
 }
 
 func (sm *UserSessionManager) GetUserSession(ctx context.Context, sessionKey interface{}) (*UserSession, error) {
@@ -51,7 +80,17 @@ func (sm *UserSessionManager) WriteUserSession(ctx context.Context, userID int64
 	cookieID := strconv.FormatInt(userID, 10) + "-" + uuid.New().String()
 	currentTime := time.Now().Unix()
 	values := []interface{}{cookieID, userID, userType, currentTime, currentTime}
-	_, err := sm.Datasource.ExecuteWriteQuery(ctx, createUserSessionQuery, values, false)
+	err := sm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		if _, err := tx.ExecuteWriteQuery(ctx, createUserSessionQuery, values); err != nil {
+			return err
+		}
+
+		// The rest of the request is made on behalf of the user who just logged in.
+		if actor := AuditActorFromContext(ctx); actor != nil {
+			actor.SetSession(&UserSession{SessionKey: cookieID, UserID: userID})
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_LOGIN, AUDIT_USER, userID, nil, nil)
+	})
 	if err != nil {
 		return "", err
 	}
@@ -60,18 +99,84 @@ func (sm *UserSessionManager) WriteUserSession(ctx context.Context, userID int64
 
 func (sm *UserSessionManager) UpdateUserSession(ctx context.Context, userID int64, loginTime int64, lastSeenTime int64) error {
 	values := []interface{}{loginTime, lastSeenTime, userID}
-	_, err := sm.Datasource.ExecuteWriteQuery(ctx, updateUserSessionQuery, values, false)
+	_, err := sm.Datasource.ExecuteWriteQuery(ctx, updateUserSessionQuery, values)
 	return err
 }
 
 func (sm *UserSessionManager) DeleteUserSession(ctx context.Context, sessionKey interface{}) (int64, error) {
-	result, err := sm.Datasource.ExecuteWriteQuery(ctx, deleteUserSessionQuery, []interface{}{sessionKey}, false)
+	var rowsAffected int64
+	err := sm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, deleteUserSessionQuery, []interface{}{sessionKey})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_LOGOUT, AUDIT_USER, sessionUserID(sessionKey), nil, nil)
+	})
 	if err != nil {
 		return -1, err
 	}
+	return rowsAffected, nil
+}
+
+// PurgeExpiredSessions deletes sessions that are past their lifetime at the given time, and
+// returns how many it deleted.
+func (sm *UserSessionManager) PurgeExpiredSessions(ctx context.Context, now time.Time) (int64, error) {
+	result, err := sm.Datasource.ExecuteWriteQuery(ctx, deleteExpiredUserSessionsQuery, []interface{}{now.Add(-SESSION_LIFETIME).Unix()})
+	if err != nil {
+		return 0, err
+	}
 	return result.RowsAffected()
 }
 
+// DeleteUserSessionsForUser signs the user out everywhere, and returns how many sessions it deleted.
+func (sm *UserSessionManager) DeleteUserSessionsForUser(ctx context.Context, userID int64) (int64, error) {
+	var rowsAffected int64
+	err := sm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, deleteUserSessionsForUserQuery, []interface{}{userID})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_LOGOUT, AUDIT_USER, userID, nil, map[string]int64{"Sessions": rowsAffected})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rowsAffected, nil
+}
+
+// DeleteAllUserSessions signs every user out, and returns how many sessions it deleted.
+func (sm *UserSessionManager) DeleteAllUserSessions(ctx context.Context) (int64, error) {
+	result, err := sm.Datasource.ExecuteWriteQuery(ctx, deleteAllUserSessionsQuery, nil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// CountActiveSessions counts the sessions that haven't expired at the given time.
+func (sm *UserSessionManager) CountActiveSessions(ctx context.Context, now time.Time) (int64, error) {
+	var count int64
+	err := sm.Datasource.ExecuteSingleReadQuery(ctx, countActiveUserSessionsQuery, []interface{}{now.Add(-SESSION_LIFETIME).Unix()}).Scan(&count)
+	return count, err
+}
+
+// sessionUserID reads the user ID WriteUserSession puts at the start of each session key.
+func sessionUserID(sessionKey interface{}) int64 {
+	key := fmt.Sprint(sessionKey)
+	userID, _ := strconv.ParseInt(key[:strings.Index(key+"-", "-")], 10, 64)
+	return userID
+}
+
 func (sm *UserSessionManager) encryptPassword(password string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -0,0 +1,355 @@
+package managers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// MIN_PASSWORD_LENGTH and MAX_PASSWORD_LENGTH bound new passwords. bcrypt ignores everything after
+// the 72nd byte, so longer passwords would only seem stronger.
+const MIN_PASSWORD_LENGTH = 8
+const MAX_PASSWORD_LENGTH = 72
+
+// fieldRule declares the checks a field has to pass. Fields are found by name, so a record's rules
+// read like its schema.
+type fieldRule struct {
+	field  string
+	checks []check
+}
+
+// check describes why a value is invalid, or returns "" when it's valid.
+type check func(value reflect.Value) string
+
+var itemRules = []*fieldRule{
+	{"Category", []check{required, maxLength(100)}},
+	{"Gender", []check{required, maxLength(100)}},
+	{"Size", []check{required, maxLength(100)}},
+	{"Quantity", []check{between(1, MAX_ITEM_QUANTITY)}},
+	{"Unit", []check{oneOf(EACH, PAIR, PACK, BOX, POUND)}},
+	{"Status", []check{oneOf(CREATED, CLAIMED, DELIVERED, RECEIVED)}},
+}
+
+// newItemRules apply on top of itemRules when an item is posted: it starts out unclaimed.
+var newItemRules = []*fieldRule{
+	{"Status", []check{oneOf(CREATED)}},
+	{"SamaritanID", []check{between(0, 0)}},
+}
+
+var userRules = []*fieldRule{
+	{"Username", []check{required, maxLength(100), excludes("@")}},
+	{"ContactInformation", []check{required}},
+}
+
+// newUserRules apply on top of userRules when an account is created: its type is set for good.
+var newUserRules = []*fieldRule{
+	{"UserType", []check{oneOf(SHELTER, SAMARITAN, ADMIN)}},
+}
+
+var contactInformationRules = []*fieldRule{
+	{"Name", []check{required, maxLength(100)}},
+	{"Email", []check{required, maxLength(100), emailAddress}},
+	{"City", []check{maxLength(100)}},
+	{"PostalCode", []check{maxLength(100)}},
+	{"State", []check{maxLength(100)}},
+	{"Street", []check{maxLength(100)}},
+}
+
+// shelterAddressRules apply on top of contactInformationRules for shelters, since samaritans
+// deliver items to their address.
+var shelterAddressRules = []*fieldRule{
+	{"City", []check{required}},
+	{"PostalCode", []check{required}},
+	{"State", []check{required}},
+	{"Street", []check{required}},
+}
+
+var intakeScheduleRules = []*fieldRule{
+	{"TimeZone", []check{required, maxLength(50), timeZone}},
+	{"SlotMinutes", []check{between(MIN_SLOT_MINUTES, MAX_SLOT_MINUTES)}},
+	{"Instructions", []check{maxLength(1000)}},
+}
+
+var intakeHoursRules = []*fieldRule{
+	{"Weekday", []check{between(int64(time.Sunday), int64(time.Saturday))}},
+	{"OpensAt", []check{between(0, 24*60-1)}},
+	{"ClosesAt", []check{between(1, 24*60)}},
+	{"Capacity", []check{between(1, MAX_SLOT_CAPACITY)}},
+}
+
+var blackoutDateRules = []*fieldRule{
+	{"Day", []check{required, dateFormat(BLACKOUT_DATE_FORMAT)}},
+	{"Reason", []check{maxLength(200)}},
+}
+
+var widgetSettingsRules = []*fieldRule{
+	{"RequestsPerMinute", []check{between(1, MAX_WIDGET_REQUESTS_PER_MINUTE)}},
+}
+
+var wishlistRules = []*fieldRule{
+	{"Name", []check{required, maxLength(100)}},
+	{"Description", []check{maxLength(2000)}},
+	{"GoalDate", []check{required, dateFormat(GOAL_DATE_FORMAT)}},
+}
+
+var passwordRules = []*fieldRule{
+	{"Password", []check{required, minLength(MIN_PASSWORD_LENGTH), maxLength(MAX_PASSWORD_LENGTH)}},
+}
+
+// ValidationErrors lists every invalid field of a record, so they can all be fixed at once.
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// orNil keeps an empty list from becoming a non-nil error.
+func (errs ValidationErrors) orNil() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// ValidateForItemCreate checks an item being posted.
+func (im *ItemManager) ValidateForItemCreate(ctx context.Context, item *Item) error {
+	return validateNewItem(item).orNil()
+}
+
+// ValidateForItemUpdate checks changes to an item.
+func (im *ItemManager) ValidateForItemUpdate(ctx context.Context, item *Item) error {
+	return validate(item, itemRules).orNil()
+}
+
+func validateNewItem(item *Item) ValidationErrors {
+	return append(validate(item, itemRules), validate(item, newItemRules)...)
+}
+
+// ValidateForUserCreate checks a new account and its password. Shelters also need an address.
+func (um *UserManager) ValidateForUserCreate(ctx context.Context, user *User, password string) error {
+	errs := append(validate(user, newUserRules), validateUser(user)...)
+	errs = append(errs, validate(&struct{ Password string }{password}, passwordRules)...)
+	return errs.orNil()
+}
+
+// ValidateForUserUpdate checks changes to an account's profile. Profiles don't say what type of
+// account they belong to, and it can't change, so it's taken from the stored account.
+func (um *UserManager) ValidateForUserUpdate(ctx context.Context, user *User) error {
+	previousUser, err := um.GetUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	user.UserType = previousUser.UserType
+	return validateUser(user).orNil()
+}
+
+// ValidateForIntakeScheduleUpdate checks a shelter's new intake schedule. Each day's hours have to
+// fit at least one slot, and can't overlap.
+func (im *IntakeManager) ValidateForIntakeScheduleUpdate(ctx context.Context, schedule *IntakeSchedule) error {
+	errs := validate(schedule, intakeScheduleRules)
+	for i, hours := range schedule.Hours {
+		field := fmt.Sprintf("Hours[%d]", i)
+		hoursErrs := validate(hours, intakeHoursRules)
+		for _, err := range hoursErrs {
+			err.Field = field + "." + err.Field
+		}
+		errs = append(errs, hoursErrs...)
+		if len(hoursErrs) > 0 {
+			continue
+		}
+
+		if hours.OpensAt+schedule.SlotMinutes > hours.ClosesAt {
+			errs = append(errs, &ValidationError{Field: field, Message: fmt.Sprintf("%s hours are too short for a %d minute slot", hours.Weekday, schedule.SlotMinutes)})
+		}
+
+		for _, other := range schedule.Hours[:i] {
+			if other.Weekday == hours.Weekday && other.OpensAt < hours.ClosesAt && hours.OpensAt < other.ClosesAt {
+				errs = append(errs, &ValidationError{Field: field, Message: fmt.Sprintf("%s hours overlap", hours.Weekday)})
+				break
+			}
+		}
+	}
+
+	for i, blackout := range schedule.BlackoutDates {
+		for _, err := range validate(blackout, blackoutDateRules) {
+			err.Field = fmt.Sprintf("BlackoutDates[%d].%s", i, err.Field)
+			errs = append(errs, err)
+		}
+	}
+	return errs.orNil()
+}
+
+// ValidateForWidgetSettingsUpdate checks a shelter's new widget settings. Allowed origins are
+// sites, like https://example.org, without a path.
+func (wm *WidgetManager) ValidateForWidgetSettingsUpdate(ctx context.Context, settings *WidgetSettings) error {
+	errs := validate(settings, widgetSettingsRules)
+	if len(settings.AllowedOrigins) > MAX_WIDGET_ORIGINS {
+		errs = append(errs, &ValidationError{Field: "AllowedOrigins", Message: fmt.Sprintf("AllowedOrigins can list up to %d sites", MAX_WIDGET_ORIGINS)})
+	}
+
+	for i, origin := range settings.AllowedOrigins {
+		parsed, err := url.Parse(normalizeOrigin(origin))
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || parsed.User != nil || parsed.Path != "" || parsed.RawQuery != "" || parsed.Fragment != "" {
+			errs = append(errs, &ValidationError{Field: fmt.Sprintf("AllowedOrigins[%d]", i), Message: origin + " must be a site like https://example.org"})
+		}
+	}
+	return errs.orNil()
+}
+
+// ValidateForWishlistUpdate checks a wishlist being created or changed. It has to list at least
+// one item, and only items its own shelter asked for.
+func (wm *WishlistManager) ValidateForWishlistUpdate(ctx context.Context, wishlist *Wishlist) error {
+	errs := validate(wishlist, wishlistRules)
+	if len(wishlist.ItemIDs) == 0 || len(wishlist.ItemIDs) > MAX_WISHLIST_ITEMS {
+		errs = append(errs, &ValidationError{Field: "ItemIDs", Message: fmt.Sprintf("ItemIDs must list between 1 and %d items", MAX_WISHLIST_ITEMS)})
+	}
+
+	itemManager := &ItemManager{Datasource: wm.Datasource}
+	listed := make(map[int64]bool)
+	for i, itemID := range wishlist.ItemIDs {
+		field := fmt.Sprintf("ItemIDs[%d]", i)
+		if listed[itemID] {
+			errs = append(errs, &ValidationError{Field: field, Message: fmt.Sprintf("Item %d is listed more than once", itemID)})
+			continue
+		}
+		listed[itemID] = true
+
+		item, err := itemManager.GetItem(ctx, itemID)
+		if _, notFound := err.(*NotFoundError); notFound || (err == nil && item.ShelterID != wishlist.ShelterID) {
+			errs = append(errs, &ValidationError{Field: field, Message: fmt.Sprintf("Item %d isn't one of this shelter's items", itemID)})
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return errs.orNil()
+}
+
+// validateUser checks the rules new accounts and profile changes share. Shelters also need an address.
+func validateUser(user *User) ValidationErrors {
+	errs := validate(user, userRules)
+	if user.ContactInformation != nil {
+		errs = append(errs, validate(user.ContactInformation, contactInformationRules)...)
+		if user.UserType == SHELTER {
+			errs = append(errs, validate(user.ContactInformation, shelterAddressRules)...)
+		}
+	}
+	return errs
+}
+
+// validate runs the rules against a pointer to a struct, reporting the first failed check of each field.
+func validate(record interface{}, rules []*fieldRule) ValidationErrors {
+	errs := make(ValidationErrors, 0)
+	value := reflect.ValueOf(record).Elem()
+	for _, rule := range rules {
+		field := value.FieldByName(rule.field)
+		for _, check := range rule.checks {
+			if problem := check(field); problem != "" {
+				errs = append(errs, &ValidationError{Field: rule.field, Message: rule.field + " " + problem})
+				break
+			}
+		}
+	}
+	return errs
+}
+
+func required(value reflect.Value) string {
+	if value.Kind() == reflect.String && strings.TrimSpace(value.String()) == "" {
+		return "is required"
+	}
+
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return "is required"
+	}
+	return ""
+}
+
+func minLength(length int) check {
+	return func(value reflect.Value) string {
+		if len([]rune(value.String())) < length {
+			return fmt.Sprintf("must be at least %d characters", length)
+		}
+		return ""
+	}
+}
+
+func maxLength(length int) check {
+	return func(value reflect.Value) string {
+		if len([]rune(value.String())) > length {
+			return fmt.Sprintf("must be at most %d characters", length)
+		}
+		return ""
+	}
+}
+
+func between(min int64, max int64) check {
+	return func(value reflect.Value) string {
+		if value.Int() < min || value.Int() > max {
+			if min == max {
+				return fmt.Sprintf("must be %d", min)
+			}
+			return fmt.Sprintf("must be from %d to %d", min, max)
+		}
+		return ""
+	}
+}
+
+func oneOf(allowed ...interface{}) check {
+	return func(value reflect.Value) string {
+		names := make([]string, len(allowed))
+		for i, candidate := range allowed {
+			if value.Interface() == candidate {
+				return ""
+			}
+			names[i] = fmt.Sprint(candidate)
+		}
+		return "must be " + strings.Join(names, " or ")
+	}
+}
+
+func excludes(text string) check {
+	return func(value reflect.Value) string {
+		if strings.Contains(value.String(), text) {
+			return "can't contain " + text
+		}
+		return ""
+	}
+}
+
+func timeZone(value reflect.Value) string {
+	if value.String() == "Local" {
+		return "must name a time zone"
+	}
+
+	if _, err := time.LoadLocation(value.String()); err != nil {
+		return "must name a time zone, like " + DEFAULT_INTAKE_TIME_ZONE
+	}
+	return ""
+}
+
+func dateFormat(layout string) check {
+	return func(value reflect.Value) string {
+		if _, err := time.Parse(layout, value.String()); err != nil {
+			return "must be a date like " + layout
+		}
+		return ""
+	}
+}
+
+func emailAddress(value reflect.Value) string {
+	at := strings.LastIndex(value.String(), "@")
+	if at < 1 || at == len(value.String())-1 || strings.ContainsAny(value.String(), " \t\n") {
+		return "must be an email address"
+	}
+	return ""
+}
@@ -0,0 +1,134 @@
+package managers
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestItemValidationReportsEachInvalidField(t *testing.T) {
+	item := &Item{Category: " ", Gender: "Women", Size: "M", Quantity: -3, Unit: PAIR, Status: DELIVERED}
+	err := (&ItemManager{}).ValidateForItemCreate(context.Background(), item)
+
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Expected validation errors, got %v", err)
+	}
+
+	fields := make(map[string]bool, 0)
+	for _, fieldErr := range errs {
+		fields[fieldErr.Field] = true
+	}
+	if len(errs) != 3 || !fields["Category"] || !fields["Quantity"] || !fields["Status"] {
+		t.Errorf("Expected Category, Quantity and Status to be invalid, got %v", err)
+	}
+}
+
+func TestItemUpdatesCanChangeStatus(t *testing.T) {
+	item := generateItem()
+	item.Quantity = 1
+	item.Status = CLAIMED
+	item.SamaritanID = 2
+	if err := (&ItemManager{}).ValidateForItemUpdate(context.Background(), item); err != nil {
+		t.Errorf("Expected a claimed item to be valid, got %v", err)
+	}
+}
+
+func TestSheltersNeedAnAddress(t *testing.T) {
+	user := generateUser(0)
+	user.Street = ""
+	err := (&UserManager{}).ValidateForUserCreate(context.Background(), user, "correct horse")
+
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Field != "Street" {
+		t.Errorf("Expected only Street to be invalid, got %v", err)
+	}
+
+	user.UserType = SAMARITAN
+	if err := (&UserManager{}).ValidateForUserCreate(context.Background(), user, "correct horse"); err != nil {
+		t.Errorf("Expected a samaritan without an address to be valid, got %v", err)
+	}
+}
+
+func TestUserValidationChecksContactInformationAndPassword(t *testing.T) {
+	user := &User{UserType: SAMARITAN, Username: "someone@example.com", ContactInformation: &ContactInformation{Name: "Someone", Email: "someone"}}
+	err := (&UserManager{}).ValidateForUserCreate(context.Background(), user, "short")
+
+	if err == nil || err.Error() != "Username can't contain @; Email must be an email address; Password must be at least 8 characters" {
+		t.Errorf("Expected the username, email and password to be invalid, got %v", err)
+	}
+}
+
+func TestProfileUpdatesUseTheStoredUserType(t *testing.T) {
+	manager := initUserManager()
+	defer cleanDatabase()
+	ctx := context.Background()
+
+	id, err := manager.WriteUser(ctx, generateUser(0), "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update := generateUser(0)
+	update.ID = id
+	update.UserType = 0
+	if err := manager.ValidateForUserUpdate(ctx, update); err != nil || update.UserType != SHELTER {
+		t.Errorf("Expected a profile without a user type to be valid for the stored shelter, got %v", err)
+	}
+
+	update.UserType = SAMARITAN
+	update.Street = ""
+	err = manager.ValidateForUserUpdate(ctx, update)
+	if errs, ok := err.(ValidationErrors); !ok || len(errs) != 1 || errs[0].Field != "Street" {
+		t.Errorf("Expected shelters to keep needing an address, got %v", err)
+	}
+
+	update.ContactInformation = nil
+	if err := manager.ValidateForUserUpdate(ctx, update); err == nil {
+		t.Error("Expected a user without contact information to be invalid")
+	}
+}
+
+func TestIntakeHoursHaveToFitASlotWithoutOverlapping(t *testing.T) {
+	schedule := generateIntakeSchedule()
+	schedule.TimeZone = "Mars/Olympus_Mons"
+	schedule.Hours = []*IntakeHours{
+		{Weekday: time.Monday, OpensAt: 9 * 60, ClosesAt: 12 * 60, Capacity: 2},
+		{Weekday: time.Monday, OpensAt: 11 * 60, ClosesAt: 13 * 60, Capacity: 2},
+		{Weekday: time.Tuesday, OpensAt: 9 * 60, ClosesAt: 9*60 + 30, Capacity: 2},
+		{Weekday: time.Wednesday, OpensAt: 9 * 60, ClosesAt: 12 * 60, Capacity: 0},
+	}
+	schedule.BlackoutDates = []*BlackoutDate{{Day: "12/25/2024"}}
+	err := (&IntakeManager{}).ValidateForIntakeScheduleUpdate(context.Background(), schedule)
+
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Expected validation errors, got %v", err)
+	}
+
+	fields := make(map[string]bool, 0)
+	for _, fieldErr := range errs {
+		fields[fieldErr.Field] = true
+	}
+	if len(errs) != 5 || !fields["TimeZone"] || !fields["Hours[1]"] || !fields["Hours[2]"] || !fields["Hours[3].Capacity"] || !fields["BlackoutDates[0].Day"] {
+		t.Errorf("Expected the time zone, overlapping and short hours, capacity and date to be invalid, got %v", err)
+	}
+}
+
+func TestWidgetOriginsHaveToBeSites(t *testing.T) {
+	settings := &WidgetSettings{AllowedOrigins: []string{"https://shelter.example/", "shelter.example", "https://shelter.example/donate", "ftp://shelter.example"}, RequestsPerMinute: 0}
+	err := (&WidgetManager{}).ValidateForWidgetSettingsUpdate(context.Background(), settings)
+
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Expected validation errors, got %v", err)
+	}
+
+	fields := make(map[string]bool, 0)
+	for _, fieldErr := range errs {
+		fields[fieldErr.Field] = true
+	}
+	if len(errs) != 4 || !fields["RequestsPerMinute"] || fields["AllowedOrigins[0]"] || !fields["AllowedOrigins[1]"] || !fields["AllowedOrigins[2]"] || !fields["AllowedOrigins[3]"] {
+		t.Errorf("Expected the rate and every origin but the first to be invalid, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var getWidgetSettingsQuery = database.Select("widgetSettings", "AllowedOrigins", "RequestsPerMinute").Where("ShelterID = ?")
+var upsertWidgetSettingsQuery = database.Insert("widgetSettings", "ShelterID", "AllowedOrigins", "RequestsPerMinute").OnConflict("ShelterID").DoUpdate("AllowedOrigins", "RequestsPerMinute")
+
+const DEFAULT_WIDGET_REQUESTS_PER_MINUTE = 60
+const MAX_WIDGET_REQUESTS_PER_MINUTE = 600
+const MAX_WIDGET_ORIGINS = 20
+const WIDGET_RATE_WINDOW = time.Minute
+
+// WidgetManager keeps the settings for the widget shelters embed in their own sites to show the
+// items they need, and rate limits the widget with the same store as logins.
+type WidgetManager struct {
+	Datasource database.Datasource
+	Store      RateLimitStore
+}
+
+type WidgetSettings struct {
+	ShelterID int64
+	// AllowedOrigins are the sites, like https://example.org, that can embed the widget. When it's
+	// empty every site can.
+	AllowedOrigins []string
+	// RequestsPerMinute is how often each visitor can load the widget.
+	RequestsPerMinute int
+}
+
+// AllowsOrigin reports whether pages from origin can embed the widget.
+func (settings *WidgetSettings) AllowsOrigin(origin string) bool {
+	if len(settings.AllowedOrigins) == 0 {
+		return true
+	}
+
+	origin = normalizeOrigin(origin)
+	for _, allowed := range settings.AllowedOrigins {
+		if allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWidgetSettings returns the shelter's widget settings, or the defaults if it hasn't set any.
+func (wm *WidgetManager) GetWidgetSettings(ctx context.Context, shelterID int64) (*WidgetSettings, error) {
+	settings := &WidgetSettings{ShelterID: shelterID, AllowedOrigins: make([]string, 0), RequestsPerMinute: DEFAULT_WIDGET_REQUESTS_PER_MINUTE}
+	var allowedOrigins string
+	row := wm.Datasource.ExecuteSingleReadQuery(ctx, getWidgetSettingsQuery, []interface{}{shelterID})
+	if err := row.Scan(&allowedOrigins, &settings.RequestsPerMinute); err != nil {
+		if err == sql.ErrNoRows {
+			return settings, nil
+		}
+		return nil, err
+	}
+
+	settings.AllowedOrigins = append(settings.AllowedOrigins, strings.Fields(allowedOrigins)...)
+	return settings, nil
+}
+
+// SaveWidgetSettings replaces the shelter's widget settings.
+func (wm *WidgetManager) SaveWidgetSettings(ctx context.Context, settings *WidgetSettings) error {
+	return wm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		previous, err := (&WidgetManager{Datasource: tx}).GetWidgetSettings(ctx, settings.ShelterID)
+		if err != nil {
+			return err
+		}
+
+		for i, origin := range settings.AllowedOrigins {
+			settings.AllowedOrigins[i] = normalizeOrigin(origin)
+		}
+
+		values := []interface{}{settings.ShelterID, strings.Join(settings.AllowedOrigins, " "), settings.RequestsPerMinute}
+		if _, err := tx.ExecuteWriteQuery(ctx, upsertWidgetSettingsQuery, values); err != nil {
+			return err
+		}
+
+		return recordAuditEvent(ctx, tx, AUDIT_WIDGET_SETTINGS_UPDATE, AUDIT_USER, settings.ShelterID, previous, settings)
+	})
+}
+
+// AllowWidgetRequest records a visitor loading the shelter's widget and returns how long they must
+// wait before loading it again. A zero duration means the request may proceed.
+func (wm *WidgetManager) AllowWidgetRequest(ctx context.Context, settings *WidgetSettings, ipAddress string) (time.Duration, error) {
+	return allowHits(ctx, wm.Store, time.Now(), WIDGET_RATE_WINDOW, map[string]int{
+		fmt.Sprintf("widget:%d:ip:%s", settings.ShelterID, ipAddress): settings.RequestsPerMinute,
+	})
+}
+
+// normalizeOrigin writes origins the way browsers send them, without a trailing slash.
+func normalizeOrigin(origin string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(origin)), "/")
+}
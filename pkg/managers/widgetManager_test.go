@@ -0,0 +1,66 @@
+package managers
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWidgetSettingsDefaultToAnySite(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &WidgetManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+
+	settings, err := manager.GetWidgetSettings(ctx, testShelterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if settings.RequestsPerMinute != DEFAULT_WIDGET_REQUESTS_PER_MINUTE || !settings.AllowsOrigin("https://anywhere.example") {
+		t.Errorf("Expected the default settings to allow any site, got %v", settings)
+	}
+
+	settings.AllowedOrigins = []string{" HTTPS://Shelter.example/ ", "http://localhost:8080"}
+	settings.RequestsPerMinute = 5
+	if err := manager.SaveWidgetSettings(ctx, settings); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := manager.GetWidgetSettings(ctx, testShelterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(saved.AllowedOrigins) != 2 || saved.AllowedOrigins[0] != "https://shelter.example" || saved.RequestsPerMinute != 5 {
+		t.Errorf("Expected the origins to be saved the way browsers send them, got %v", saved)
+	}
+
+	if !saved.AllowsOrigin("https://shelter.example") || saved.AllowsOrigin("https://shelter.example.evil") || saved.AllowsOrigin("") {
+		t.Errorf("Expected only the listed sites to be allowed, got %v", saved.AllowedOrigins)
+	}
+}
+
+func TestWidgetRequestsAreLimitedPerVisitor(t *testing.T) {
+	manager := &WidgetManager{Store: &MemoryRateLimitStore{}}
+	settings := &WidgetSettings{ShelterID: 1, RequestsPerMinute: 2}
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if retryAfter, err := manager.AllowWidgetRequest(ctx, settings, "10.0.0.1"); err != nil || retryAfter != 0 {
+			t.Fatalf("Expected request %d to be allowed, got %v: %v", i, retryAfter, err)
+		}
+	}
+
+	if retryAfter, _ := manager.AllowWidgetRequest(ctx, settings, "10.0.0.1"); retryAfter <= 0 || retryAfter > WIDGET_RATE_WINDOW {
+		t.Errorf("Expected the third request within a minute to wait, got %v", retryAfter)
+	}
+
+	if retryAfter, _ := manager.AllowWidgetRequest(ctx, settings, "10.0.0.2"); retryAfter != 0 {
+		t.Errorf("Expected other visitors not to be limited, got %v", retryAfter)
+	}
+
+	otherShelter := &WidgetSettings{ShelterID: 2, RequestsPerMinute: 2}
+	if retryAfter, _ := manager.AllowWidgetRequest(ctx, otherShelter, "10.0.0.1"); retryAfter != 0 {
+		t.Errorf("Expected each shelter's widget to be limited separately, got %v", retryAfter)
+	}
+}
@@ -0,0 +1,230 @@
+package managers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+)
+
+var wishlistColumns = []string{"ID", "ShelterID", "Name", "Description", "GoalDate", "CreatedTime"}
+
+var createWishlistQuery = database.Insert("wishlists", "ShelterID", "Name", "Description", "GoalDate", "CreatedTime").Returning("ID")
+var updateWishlistQuery = database.Update("wishlists").Set("Name", "Description", "GoalDate").Where("ID = ?", "ShelterID = ?")
+var deleteWishlistQuery = database.Delete("wishlists").Where("ID = ?", "ShelterID = ?")
+var getWishlistQuery = database.Select("wishlists", wishlistColumns...).Where("ID = ?")
+var getWishlistsForShelterQuery = database.Select("wishlists", wishlistColumns...).Where("ShelterID = ?").OrderBy("GoalDate", "ID")
+var createWishlistItemQuery = database.Insert("wishlistItems", "WishlistID", "ItemID")
+var deleteWishlistItemsQuery = database.Delete("wishlistItems").Where("WishlistID = ?")
+
+// GOAL_DATE_FORMAT is how wishlists' goal dates are written.
+const GOAL_DATE_FORMAT = "2006-01-02"
+const MAX_WISHLIST_ITEMS = 100
+
+// WishlistManager keeps the wishlists shelters share with donor groups for a drive. A wishlist
+// groups some of the shelter's items, and its progress is counted from their statuses, so it
+// fills up as the items are claimed and received.
+type WishlistManager struct {
+	Datasource database.Datasource
+}
+
+type Wishlist struct {
+	ID          int64
+	ShelterID   int64
+	Name        string
+	Description string
+	// GoalDate is the day the drive hopes to have everything by, like 2024-12-20.
+	GoalDate    string
+	ItemIDs     []int64
+	CreatedTime int64
+}
+
+// WishlistGoal totals a wishlist's items of one category and unit, such as 30 of 50 blankets
+// received. Pledged items have been claimed but not yet received.
+type WishlistGoal struct {
+	Category string
+	Unit     Unit
+	Needed   int
+	Pledged  int
+	Received int
+}
+
+// Progress reads like "30/50 Blankets received", or "3/10 pairs Socks received".
+func (goal *WishlistGoal) Progress() string {
+	return fmt.Sprintf("%d/%s %s received", goal.Received, FormatQuantity(goal.Needed, goal.Unit), goal.Category)
+}
+
+// PercentReceived and PercentPledged are how much of the goal is in, and on its way, out of 100.
+func (goal *WishlistGoal) PercentReceived() int {
+	return percentOf(goal.Received, goal.Needed)
+}
+
+func (goal *WishlistGoal) PercentPledged() int {
+	return percentOf(goal.Pledged, goal.Needed)
+}
+
+func percentOf(part int, whole int) int {
+	if whole <= 0 {
+		return 0
+	}
+	return part * 100 / whole
+}
+
+// BuildWishlistGoals totals the items by category and unit, in category order.
+func BuildWishlistGoals(items []*Item) []*WishlistGoal {
+	goals := make([]*WishlistGoal, 0)
+	byKind := make(map[string]*WishlistGoal)
+	for _, item := range items {
+		key := item.Category + "\x00" + string(item.Unit)
+		goal, found := byKind[key]
+		if !found {
+			goal = &WishlistGoal{Category: item.Category, Unit: item.Unit}
+			byKind[key] = goal
+			goals = append(goals, goal)
+		}
+
+		goal.Needed += item.Quantity
+		switch item.Status {
+		case CLAIMED, DELIVERED:
+			goal.Pledged += item.Quantity
+		case RECEIVED:
+			goal.Received += item.Quantity
+		}
+	}
+
+	sort.SliceStable(goals, func(i, j int) bool {
+		if goals[i].Category != goals[j].Category {
+			return goals[i].Category < goals[j].Category
+		}
+		return goals[i].Unit < goals[j].Unit
+	})
+	return goals
+}
+
+func (wm *WishlistManager) GetWishlist(ctx context.Context, id int64) (*Wishlist, error) {
+	wishlist := &Wishlist{}
+	row := wm.Datasource.ExecuteSingleReadQuery(ctx, getWishlistQuery, []interface{}{id})
+	if err := row.Scan(&wishlist.ID, &wishlist.ShelterID, &wishlist.Name, &wishlist.Description, &wishlist.GoalDate, &wishlist.CreatedTime); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NotFoundError{Entity: "Wishlist", ID: id}
+		}
+		return nil, err
+	}
+
+	items, err := (&ItemManager{Datasource: wm.Datasource}).GetItemsForWishlist(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlist.ItemIDs = make([]int64, len(items))
+	for i, item := range items {
+		wishlist.ItemIDs[i] = item.ID
+	}
+	return wishlist, nil
+}
+
+// GetWishlistsForShelter returns the shelter's wishlists, soonest goal first, without their items.
+func (wm *WishlistManager) GetWishlistsForShelter(ctx context.Context, shelterID int64) ([]*Wishlist, error) {
+	result, err := wm.Datasource.ExecuteBatchReadQuery(ctx, getWishlistsForShelterQuery, []interface{}{shelterID})
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	wishlists := make([]*Wishlist, 0)
+	for result.Next() {
+		wishlist := &Wishlist{}
+		if err := result.Scan(&wishlist.ID, &wishlist.ShelterID, &wishlist.Name, &wishlist.Description, &wishlist.GoalDate, &wishlist.CreatedTime); err != nil {
+			return nil, err
+		}
+		wishlists = append(wishlists, wishlist)
+	}
+	return wishlists, result.Err()
+}
+
+func (wm *WishlistManager) WriteWishlist(ctx context.Context, wishlist *Wishlist) (int64, error) {
+	err := wm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		wishlist.CreatedTime = time.Now().Unix()
+		values := []interface{}{wishlist.ShelterID, wishlist.Name, wishlist.Description, wishlist.GoalDate, wishlist.CreatedTime}
+		result, err := tx.ExecuteWriteQuery(ctx, createWishlistQuery, values)
+		if err != nil {
+			return err
+		}
+
+		wishlist.ID, err = result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		if err := writeWishlistItems(ctx, tx, wishlist); err != nil {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_WISHLIST_CREATE, AUDIT_WISHLIST, wishlist.ID, nil, wishlist)
+	})
+	if err != nil {
+		return -1, err
+	}
+	return wishlist.ID, nil
+}
+
+// UpdateWishlist replaces the wishlist's details and items. Only its shelter can change it.
+func (wm *WishlistManager) UpdateWishlist(ctx context.Context, wishlist *Wishlist) error {
+	return wm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		previous, err := (&WishlistManager{Datasource: tx}).GetWishlist(ctx, wishlist.ID)
+		if err != nil {
+			return err
+		}
+
+		if previous.ShelterID != wishlist.ShelterID {
+			return &NotFoundError{Entity: "Wishlist", ID: wishlist.ID}
+		}
+
+		values := []interface{}{wishlist.Name, wishlist.Description, wishlist.GoalDate, wishlist.ID, wishlist.ShelterID}
+		if _, err := tx.ExecuteWriteQuery(ctx, updateWishlistQuery, values); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecuteWriteQuery(ctx, deleteWishlistItemsQuery, []interface{}{wishlist.ID}); err != nil {
+			return err
+		}
+
+		if err := writeWishlistItems(ctx, tx, wishlist); err != nil {
+			return err
+		}
+		wishlist.CreatedTime = previous.CreatedTime
+		return recordAuditEvent(ctx, tx, AUDIT_WISHLIST_UPDATE, AUDIT_WISHLIST, wishlist.ID, previous, wishlist)
+	})
+}
+
+// DeleteWishlist deletes one of the shelter's wishlists. Its items are left as they are.
+func (wm *WishlistManager) DeleteWishlist(ctx context.Context, shelterID int64, id int64) (int64, error) {
+	var rowsAffected int64
+	err := wm.Datasource.Transaction(ctx, func(tx database.Datasource) error {
+		result, err := tx.ExecuteWriteQuery(ctx, deleteWishlistQuery, []interface{}{id, shelterID})
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+		return recordAuditEvent(ctx, tx, AUDIT_WISHLIST_DELETE, AUDIT_WISHLIST, id, nil, nil)
+	})
+	if err != nil {
+		return -1, err
+	}
+	return rowsAffected, nil
+}
+
+func writeWishlistItems(ctx context.Context, tx database.Datasource, wishlist *Wishlist) error {
+	for _, itemID := range wishlist.ItemIDs {
+		if _, err := tx.ExecuteWriteQuery(ctx, createWishlistItemQuery, []interface{}{wishlist.ID, itemID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package managers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestItTotalsWishlistGoalsByCategory(t *testing.T) {
+	items := []*Item{
+		{Category: "Blankets", Unit: EACH, Quantity: 20, Status: RECEIVED},
+		{Category: "Blankets", Unit: EACH, Quantity: 10, Status: RECEIVED},
+		{Category: "Blankets", Unit: EACH, Quantity: 5, Status: CLAIMED},
+		{Category: "Blankets", Unit: EACH, Quantity: 15, Status: CREATED},
+		{Category: "Socks", Unit: PAIR, Quantity: 4, Status: DELIVERED},
+	}
+
+	goals := BuildWishlistGoals(items)
+	if len(goals) != 2 {
+		t.Fatalf("Expected a goal for each category, got %v", goals)
+	}
+
+	blankets := goals[0]
+	if blankets.Category != "Blankets" || blankets.Needed != 50 || blankets.Received != 30 || blankets.Pledged != 5 {
+		t.Errorf("Expected 30 of 50 blankets received and 5 pledged, got %v", blankets)
+	}
+
+	if blankets.Progress() != "30/50 Blankets received" || blankets.PercentReceived() != 60 || blankets.PercentPledged() != 10 {
+		t.Errorf("Expected 30/50 Blankets received, got %s at %d%%", blankets.Progress(), blankets.PercentReceived())
+	}
+
+	if goals[1].Progress() != "0/4 pairs Socks received" || goals[1].Pledged != 4 {
+		t.Errorf("Expected delivered socks to be pledged, got %v", goals[1])
+	}
+}
+
+func TestCanReadItsOwnWishlistWrite(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &WishlistManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+
+	itemIDs := make([]int64, 2)
+	for i := range itemIDs {
+		id, err := itemManager.WriteItem(ctx, generateItem())
+		if err != nil {
+			t.Fatal(err)
+		}
+		itemIDs[i] = id
+	}
+
+	wishlist := &Wishlist{ShelterID: testShelterID, Name: "Winter Drive", Description: "Keep everyone warm", GoalDate: "2024-12-20", ItemIDs: itemIDs}
+	id, err := manager.WriteWishlist(ctx, wishlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := manager.GetWishlist(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if saved.Name != wishlist.Name || saved.GoalDate != wishlist.GoalDate || !reflect.DeepEqual(saved.ItemIDs, itemIDs) || saved.CreatedTime == 0 {
+		t.Errorf("Expected %v, got %v", wishlist, saved)
+	}
+
+	saved.Name = "Holiday Drive"
+	saved.ItemIDs = itemIDs[1:]
+	if err := manager.UpdateWishlist(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+
+	wishlists, err := manager.GetWishlistsForShelter(ctx, testShelterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(wishlists) != 1 || wishlists[0].Name != "Holiday Drive" {
+		t.Errorf("Expected the renamed wishlist, got %v", wishlists)
+	}
+
+	items, err := itemManager.GetItemsForWishlist(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 || items[0].ID != itemIDs[1] {
+		t.Errorf("Expected the wishlist's items to be replaced, got %v", items)
+	}
+
+	saved.ShelterID = testShelterID + 1
+	if err := manager.UpdateWishlist(ctx, saved); err == nil {
+		t.Error("Expected other shelters not to be able to change the wishlist")
+	}
+
+	if deleted, err := manager.DeleteWishlist(ctx, testShelterID+1, id); err != nil || deleted != 0 {
+		t.Errorf("Expected other shelters not to be able to delete the wishlist, got %d: %v", deleted, err)
+	}
+
+	if deleted, err := manager.DeleteWishlist(ctx, testShelterID, id); err != nil || deleted != 1 {
+		t.Fatalf("Expected the wishlist to be deleted, got %d: %v", deleted, err)
+	}
+
+	if _, err := manager.GetWishlist(ctx, id); err == nil {
+		t.Error("Expected the deleted wishlist to be gone")
+	}
+}
+
+func TestWishlistsCanOnlyListTheirSheltersItems(t *testing.T) {
+	itemManager := initItemManager()
+	defer cleanDatabase()
+	manager := &WishlistManager{Datasource: itemManager.Datasource}
+	ctx := context.Background()
+
+	ownID, err := itemManager.WriteItem(ctx, generateItem())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other := generateItem()
+	other.ShelterID = testShelterID + 1
+	otherID, err := itemManager.WriteItem(ctx, other)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wishlist := &Wishlist{ShelterID: testShelterID, Name: "Winter Drive", GoalDate: "December 20", ItemIDs: []int64{ownID, ownID, otherID, otherID + 100}}
+	err = manager.ValidateForWishlistUpdate(ctx, wishlist)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 4 {
+		t.Fatalf("Expected the goal date and three items to be rejected, got %v", err)
+	}
+
+	fields := []string{errs[0].Field, errs[1].Field, errs[2].Field, errs[3].Field}
+	if !reflect.DeepEqual(fields, []string{"GoalDate", "ItemIDs[1]", "ItemIDs[2]", "ItemIDs[3]"}) {
+		t.Errorf("Expected errors for the goal date and items, got %v", fields)
+	}
+
+	wishlist.GoalDate = "2024-12-20"
+	wishlist.ItemIDs = []int64{ownID}
+	if err := manager.ValidateForWishlistUpdate(ctx, wishlist); err != nil {
+		t.Errorf("Expected the wishlist to be valid, got %v", err)
+	}
+}
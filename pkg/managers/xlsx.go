@@ -0,0 +1,217 @@
+package managers
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// An XLSX workbook is a zip of XML parts. Only the parts needed to read the text and numbers in
+// the cells of the first sheet are decoded.
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText is either plain text, or runs of text in different formats.
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Reference string   `xml:"r,attr"`
+			Type      string   `xml:"t,attr"`
+			Value     string   `xml:"v"`
+			Inline    xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// MAX_XLSX_PART_SIZE bounds each part of a workbook once it's unzipped, since a small upload can
+// unzip to far more. MAX_XLSX_ROWS and MAX_XLSX_COLUMNS bound the sheet, since cells say where
+// they are and a sheet with one cell can claim to be anywhere.
+const MAX_XLSX_PART_SIZE = 20 << 20
+const MAX_XLSX_ROWS = 10000
+const MAX_XLSX_COLUMNS = 100
+
+var errNotXLSX = &ValidationError{Field: "File", Message: "The file isn't an XLSX workbook"}
+var errXLSXTooLarge = &ValidationError{Field: "File", Message: fmt.Sprintf("The workbook is too large to import. Please import up to %d rows at a time", MAX_XLSX_ROWS)}
+
+func (text xlsxText) String() string {
+	if len(text.Runs) == 0 {
+		return text.Text
+	}
+
+	runs := make([]string, len(text.Runs))
+	for i, run := range text.Runs {
+		runs[i] = run.Text
+	}
+	return strings.Join(runs, "")
+}
+
+// readXLSXRows returns the cells of the first sheet of a workbook. Rows are indexed from the top
+// of the sheet, so empty rows are kept as empty records. Cells past MAX_XLSX_COLUMNS are ignored.
+func readXLSXRows(r io.ReaderAt, size int64) ([][]string, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errNotXLSX
+	}
+
+	parts := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		parts[file.Name] = file
+	}
+
+	sheetPath, err := findFirstSheet(parts)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedStrings := &xlsxSharedStrings{}
+	if parts["xl/sharedStrings.xml"] != nil {
+		if err := decodeXLSXPart(parts["xl/sharedStrings.xml"], sharedStrings); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet := &xlsxWorksheet{}
+	if err := decodeXLSXPart(parts[sheetPath], sheet); err != nil {
+		return nil, err
+	}
+
+	records := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		if row.Number > MAX_XLSX_ROWS || len(records) >= MAX_XLSX_ROWS {
+			return nil, errXLSXTooLarge
+		}
+
+		for len(records) < row.Number-1 {
+			records = append(records, []string{})
+		}
+
+		record := make([]string, 0, len(row.Cells))
+		for _, cell := range row.Cells {
+			column := xlsxColumnIndex(cell.Reference)
+			if column < 0 {
+				column = len(record)
+			}
+
+			if column >= MAX_XLSX_COLUMNS {
+				continue
+			}
+
+			for len(record) <= column {
+				record = append(record, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err == nil && index >= 0 && index < len(sharedStrings.Items) {
+					record[column] = sharedStrings.Items[index].String()
+				}
+			case "inlineStr":
+				record[column] = cell.Inline.String()
+			default:
+				record[column] = cell.Value
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// findFirstSheet follows the workbook's relationships to the part holding its first sheet.
+func findFirstSheet(parts map[string]*zip.File) (string, error) {
+	workbook := &xlsxWorkbook{}
+	relationships := &xlsxRelationships{}
+	if parts["xl/workbook.xml"] == nil || parts["xl/_rels/workbook.xml.rels"] == nil {
+		return "", errNotXLSX
+	}
+
+	if err := decodeXLSXPart(parts["xl/workbook.xml"], workbook); err != nil {
+		return "", err
+	}
+
+	if err := decodeXLSXPart(parts["xl/_rels/workbook.xml.rels"], relationships); err != nil {
+		return "", err
+	}
+
+	if len(workbook.Sheets) == 0 {
+		return "", errNotXLSX
+	}
+
+	for _, relationship := range relationships.Relationships {
+		if relationship.ID != workbook.Sheets[0].RelationshipID {
+			continue
+		}
+
+		sheetPath := path.Join("xl", relationship.Target)
+		if strings.HasPrefix(relationship.Target, "/") {
+			sheetPath = strings.TrimPrefix(relationship.Target, "/")
+		}
+
+		if parts[sheetPath] != nil {
+			return sheetPath, nil
+		}
+	}
+	return "", errNotXLSX
+}
+
+// decodeXLSXPart refuses parts that say they're larger than MAX_XLSX_PART_SIZE unzipped, and stops
+// reading any that turn out to be.
+func decodeXLSXPart(file *zip.File, value interface{}) error {
+	if file.UncompressedSize64 > MAX_XLSX_PART_SIZE {
+		return errXLSXTooLarge
+	}
+
+	part, err := file.Open()
+	if err != nil {
+		return errNotXLSX
+	}
+	defer part.Close()
+
+	if err := xml.NewDecoder(io.LimitReader(part, MAX_XLSX_PART_SIZE)).Decode(value); err != nil {
+		return errNotXLSX
+	}
+	return nil
+}
+
+// xlsxColumnIndex turns a cell reference such as "AB12" into its zero-based column, 27. Columns
+// past MAX_XLSX_COLUMNS are all returned as MAX_XLSX_COLUMNS, so long references can't overflow.
+func xlsxColumnIndex(reference string) int {
+	column := 0
+	for _, character := range strings.ToUpper(reference) {
+		if character < 'A' || character > 'Z' {
+			break
+		}
+
+		column = column*26 + int(character-'A'+1)
+		if column > MAX_XLSX_COLUMNS {
+			return MAX_XLSX_COLUMNS
+		}
+	}
+	return column - 1
+}
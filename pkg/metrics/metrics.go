@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const NAMESPACE = "neighbors"
+
+// STATE_TIMEOUT bounds the database reads made for each scrape.
+const STATE_TIMEOUT = 5 * time.Second
+
+var HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: NAMESPACE,
+	Name:      "http_request_duration_seconds",
+	Help:      "Time to serve HTTP requests, by route, method and status code.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"route", "method", "status"})
+
+var QueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: NAMESPACE,
+	Name:      "db_query_duration_seconds",
+	Help:      "Time to run database queries, by the manager method that ran them.",
+	Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+}, []string{"manager", "method"})
+
+var EmailsSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: NAMESPACE,
+	Name:      "emails_sent_total",
+	Help:      "Emails handed to the sender, by sender, kind of email and result.",
+}, []string{"sender", "kind", "result"})
+
+var activeSessionsDescription = prometheus.NewDesc(NAMESPACE+"_active_sessions", "Sessions that haven't expired.", nil, nil)
+var itemsDescription = prometheus.NewDesc(NAMESPACE+"_items", "Items that haven't been deleted, by status.", []string{"status"}, nil)
+
+func init() {
+	prometheus.MustRegister(HTTPRequestDuration, QueryDuration, EmailsSent)
+}
+
+// RecordEmail counts an email as sent or failed.
+func RecordEmail(sender string, kind string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	EmailsSent.WithLabelValues(sender, kind, result).Inc()
+}
+
+// StateCollector reports the number of active sessions and items, read from the database when
+// metrics are scraped so they are always current.
+type StateCollector struct {
+	CountActiveSessions func(ctx context.Context) (int64, error)
+	CountItemsByStatus  func(ctx context.Context) (map[string]int64, error)
+}
+
+func (collector *StateCollector) Describe(descriptions chan<- *prometheus.Desc) {
+	descriptions <- activeSessionsDescription
+	descriptions <- itemsDescription
+}
+
+func (collector *StateCollector) Collect(metrics chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), STATE_TIMEOUT)
+	defer cancel()
+
+	sessions, err := collector.CountActiveSessions(ctx)
+	if err != nil {
+		metrics <- prometheus.NewInvalidMetric(activeSessionsDescription, err)
+	} else {
+		metrics <- prometheus.MustNewConstMetric(activeSessionsDescription, prometheus.GaugeValue, float64(sessions))
+	}
+
+	items, err := collector.CountItemsByStatus(ctx)
+	if err != nil {
+		metrics <- prometheus.NewInvalidMetric(itemsDescription, err)
+		return
+	}
+
+	for status, count := range items {
+		metrics <- prometheus.MustNewConstMetric(itemsDescription, prometheus.GaugeValue, float64(count), status)
+	}
+}
@@ -0,0 +1,163 @@
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var apiTokensEndpoint = "/tokens/"
+
+var errUnknownApiToken = errors.New("Unknown or revoked API token")
+
+// ApiTokenServiceHandler lets users create, list, and revoke their personal API tokens. Tokens
+// can only be managed from a browser session, so a leaked token can't be used to mint more.
+type ApiTokenServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	ApiTokenManager    *managers.ApiTokenManager
+}
+
+type apiTokenRequest struct {
+	Name   string
+	Scopes []string
+}
+
+type apiTokenResponse struct {
+	*managers.ApiToken
+	Token string
+}
+
+func (handler ApiTokenServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userSession := getCookieSession(r, handler.UserSessionManager)
+	if userSession == nil {
+		tpl, _ := retrievers.RetrieveTemplate("home/unauthorized")
+		w.WriteHeader(http.StatusUnauthorized)
+		if tpl != nil {
+			tpl.Execute(w, nil)
+		}
+		return
+	}
+
+	tokenID := strings.Trim(strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(apiTokensEndpoint, "/")), "/")
+	switch {
+	case r.Method == http.MethodGet && tokenID == "":
+		handler.handleGetApiTokens(w, r, userSession)
+	case r.Method == http.MethodPost && tokenID == "":
+		handler.handleCreateApiToken(w, r, userSession)
+	case r.Method == http.MethodDelete && tokenID != "":
+		handler.handleRevokeApiToken(w, r, userSession, tokenID)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (handler ApiTokenServiceHandler) handleGetApiTokens(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	tokens, err := handler.ApiTokenManager.GetApiTokensForUser(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "ApiTokenManager.GetApiTokensForUser failed", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(tokens)
+}
+
+func (handler ApiTokenServiceHandler) handleCreateApiToken(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	request := &apiTokenRequest{}
+	if err := decodeBody(r, request); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	plaintext, token, err := handler.ApiTokenManager.WriteApiToken(r.Context(), userSession.UserID, request.Name, request.Scopes)
+	if err != nil {
+		writeError(w, r, "ApiTokenManager.WriteApiToken failed", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(&apiTokenResponse{ApiToken: token, Token: plaintext})
+}
+
+func (handler ApiTokenServiceHandler) handleRevokeApiToken(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, tokenID string) {
+	id, err := strconv.ParseInt(tokenID, 10, 64)
+	if err != nil {
+		writeError(w, r, "", &managers.NotFoundError{Entity: "API token", ID: tokenID})
+		return
+	}
+
+	revoked, err := handler.ApiTokenManager.RevokeApiToken(r.Context(), userSession.UserID, id)
+	if err != nil {
+		writeError(w, r, "ApiTokenManager.RevokeApiToken failed", err)
+		return
+	}
+
+	if revoked == 0 {
+		writeError(w, r, "", &managers.NotFoundError{Entity: "API token", ID: id})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getRequestSession authenticates a request by its bearer token if it has one, and by its session
+// cookie otherwise. hasCredentials reports whether the request carried either.
+func getRequestSession(r *http.Request, sessionManager managers.SessionManger, tokenManager *managers.ApiTokenManager) (userSession *managers.UserSession, hasCredentials bool, err error) {
+	if token := bearerToken(r); token != "" {
+		if tokenManager == nil {
+			return nil, true, errUnknownApiToken
+		}
+
+		userSession, err = tokenManager.GetUserSession(r.Context(), token)
+		if err == nil && userSession == nil {
+			err = errUnknownApiToken
+		}
+		recordAuditSession(r, userSession)
+		return userSession, true, err
+	}
+
+	cookie, err := r.Cookie("NeighborsAuth")
+	if err != nil {
+		return nil, false, err
+	}
+
+	userSession, err = sessionManager.GetUserSession(r.Context(), cookie.Value)
+	if err == nil {
+		recordAuditSession(r, userSession)
+	}
+	return userSession, true, err
+}
+
+// getCookieSession returns the unexpired browser session for a request, or nil.
+func getCookieSession(r *http.Request, sessionManager managers.SessionManger) *managers.UserSession {
+	cookie, err := r.Cookie("NeighborsAuth")
+	if err != nil {
+		return nil
+	}
+
+	userSession, err := sessionManager.GetUserSession(r.Context(), cookie.Value)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("SessionManager.GetUserSession failed", logging.Fields{"error": err})
+		return nil
+	}
+
+	if userSession == nil || userSession.IsExpired(time.Now()) {
+		return nil
+	}
+	recordAuditSession(r, userSession)
+	return userSession
+}
+
+func bearerToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) < len("Bearer ") || !strings.EqualFold(authorization[:len("Bearer ")], "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(authorization[len("Bearer "):])
+}
@@ -0,0 +1,241 @@
+package resources
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var attachmentsEndpoint = "/attachments/"
+
+var errMissingAttachmentFile = &managers.ValidationError{Field: "File", Message: "Please choose a JPEG, PNG or GIF image of up to 5 MB"}
+
+// AttachmentServiceHandler uploads and serves the photos shelters attach to the items they need,
+// and the receipts samaritans attach when they drop items off. Photos are public, like the items
+// themselves, while receipts are only shown to the item's shelter and samaritan.
+type AttachmentServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	ApiTokenManager    *managers.ApiTokenManager
+	ItemManager        *managers.ItemManager
+	AttachmentManager  *managers.AttachmentManager
+}
+
+func (handler AttachmentServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userSession := handler.getSession(r)
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(attachmentsEndpoint, "/")), "/")
+	pathArray := strings.Split(path, "/")
+	switch {
+	case r.Method == http.MethodPost && path == "":
+		handler.handleAddAttachment(w, r, userSession)
+	case r.Method == http.MethodGet && len(pathArray) == 1 && path != "":
+		handler.handleGetAttachment(w, r, userSession, pathArray[0], false)
+	case r.Method == http.MethodGet && len(pathArray) == 2 && pathArray[1] == "thumbnail":
+		handler.handleGetAttachment(w, r, userSession, pathArray[0], true)
+	case r.Method == http.MethodDelete && len(pathArray) == 1 && path != "":
+		handler.handleDeleteAttachment(w, r, userSession, pathArray[0])
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// getSession returns the request's unexpired session, if it has the item scope the method needs.
+func (handler AttachmentServiceHandler) getSession(r *http.Request) *managers.UserSession {
+	userSession, hasCredentials, err := getRequestSession(r, handler.UserSessionManager, handler.ApiTokenManager)
+	if hasCredentials && err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": err})
+		return nil
+	}
+
+	if userSession == nil || userSession.IsExpired(time.Now()) || !userSession.HasScope(itemScopeForMethod(r.Method)) {
+		return nil
+	}
+	return userSession
+}
+
+// handleAddAttachment reads a multipart form with the item's ID, the attachment's Kind and the file.
+func (handler AttachmentServiceHandler) handleAddAttachment(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	if userSession == nil {
+		writeUnauthorized(w)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, managers.MAX_ATTACHMENT_SIZE+(1<<20))
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, r, "", errMissingAttachmentFile)
+		return
+	}
+	defer file.Close()
+
+	itemID, err := parseID(r.FormValue("ItemID"))
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return
+	}
+
+	item, err := handler.ItemManager.GetItem(r.Context(), itemID)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItem failed", err)
+		return
+	}
+
+	attachment := &managers.Attachment{ItemID: item.ID, UploaderID: userSession.UserID, Kind: managers.AttachmentKind(r.FormValue("Kind"))}
+	if !canAttach(userSession, item, attachment.Kind) {
+		writeError(w, r, "", &managers.ForbiddenError{Message: "Shelters can attach photos to their items, and samaritans receipts to the items they've claimed"})
+		return
+	}
+
+	content, err := ioutil.ReadAll(io.LimitReader(file, managers.MAX_ATTACHMENT_SIZE+1))
+	if err != nil {
+		writeError(w, r, "", errMissingAttachmentFile)
+		return
+	}
+
+	if _, err := handler.AttachmentManager.AddAttachment(r.Context(), attachment, content); err != nil {
+		writeError(w, r, "AttachmentManager.AddAttachment failed", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(attachment)
+}
+
+func (handler AttachmentServiceHandler) handleGetAttachment(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, attachmentID string, thumbnail bool) {
+	attachment, item, err := handler.getAttachment(r, attachmentID)
+	if err != nil {
+		writeError(w, r, "AttachmentManager.GetAttachment failed", err)
+		return
+	}
+
+	if !canViewAttachment(userSession, item, attachment) {
+		// Receipts are kept hidden rather than refused, so their IDs can't be probed.
+		writeError(w, r, "", &managers.NotFoundError{Entity: "Attachment", ID: attachment.ID})
+		return
+	}
+
+	file, err := handler.AttachmentManager.OpenAttachment(r.Context(), attachment, thumbnail)
+	if err != nil {
+		writeError(w, r, "AttachmentManager.OpenAttachment failed", err)
+		return
+	}
+	defer file.Close()
+
+	contentType := attachment.ContentType
+	if thumbnail {
+		contentType = "image/jpeg"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if attachment.Kind == managers.PHOTO {
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+	} else {
+		w.Header().Set("Cache-Control", "private, no-store")
+	}
+	io.Copy(w, file)
+}
+
+func (handler AttachmentServiceHandler) handleDeleteAttachment(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, attachmentID string) {
+	if userSession == nil {
+		writeUnauthorized(w)
+		return
+	}
+
+	attachment, item, err := handler.getAttachment(r, attachmentID)
+	if err != nil {
+		writeError(w, r, "AttachmentManager.GetAttachment failed", err)
+		return
+	}
+
+	if !canDeleteAttachment(userSession, item, attachment) {
+		writeError(w, r, "", &managers.ForbiddenError{Message: "Only the uploader or the item's shelter can remove an attachment"})
+		return
+	}
+
+	if err := handler.AttachmentManager.DeleteAttachment(r.Context(), attachment); err != nil {
+		writeError(w, r, "AttachmentManager.DeleteAttachment failed", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getAttachment loads an attachment along with the item it's attached to, which decides who can see it.
+func (handler AttachmentServiceHandler) getAttachment(r *http.Request, attachmentID string) (*managers.Attachment, *managers.Item, error) {
+	id, err := parseID(attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := handler.AttachmentManager.GetAttachment(r.Context(), id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item, err := handler.ItemManager.GetItem(r.Context(), attachment.ItemID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, item, nil
+}
+
+// canAttach lets shelters attach photos of what they need, and samaritans attach receipts for the
+// items they've claimed or delivered.
+func canAttach(userSession *managers.UserSession, item *managers.Item, kind managers.AttachmentKind) bool {
+	switch kind {
+	case managers.PHOTO:
+		return isShelterAuthorized(userSession, item)
+	case managers.RECEIPT:
+		return isSamaritanAuthorized(userSession, item) && (item.Status == managers.CLAIMED || item.Status == managers.DELIVERED)
+	}
+	return false
+}
+
+func canViewAttachment(userSession *managers.UserSession, item *managers.Item, attachment *managers.Attachment) bool {
+	if attachment.Kind == managers.PHOTO {
+		return true
+	}
+	return userSession != nil && !userSession.IsExpired(time.Now()) && (isShelterAuthorized(userSession, item) || isSamaritanAuthorized(userSession, item))
+}
+
+func canDeleteAttachment(userSession *managers.UserSession, item *managers.Item, attachment *managers.Attachment) bool {
+	return attachment.UploaderID == userSession.UserID || isShelterAuthorized(userSession, item)
+}
+
+// attachableKind is the kind of attachment a user can add to an item, or "" if they can't add any.
+func attachableKind(userSession *managers.UserSession, item *managers.Item) managers.AttachmentKind {
+	if userSession == nil || userSession.IsExpired(time.Now()) {
+		return ""
+	}
+
+	for _, kind := range []managers.AttachmentKind{managers.PHOTO, managers.RECEIPT} {
+		if canAttach(userSession, item, kind) {
+			return kind
+		}
+	}
+	return ""
+}
+
+// visibleAttachments leaves out the attachments a user can't see.
+func visibleAttachments(userSession *managers.UserSession, item *managers.Item, attachments []*managers.Attachment) []*managers.Attachment {
+	visible := make([]*managers.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		if canViewAttachment(userSession, item, attachment) {
+			visible = append(visible, attachment)
+		}
+	}
+	return visible
+}
+
+func writeUnauthorized(w http.ResponseWriter) {
+	tpl, _ := retrievers.RetrieveTemplate("home/unauthorized")
+	w.WriteHeader(http.StatusUnauthorized)
+	if tpl != nil {
+		tpl.Execute(w, nil)
+	}
+}
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestCannotAddAttachmentsWithNoCookie(t *testing.T) {
+	ash := &AttachmentServiceHandler{}
+
+	recorder := httptest.NewRecorder()
+	ash.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/attachments/", nil))
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("Expected users without a session to be unauthorized to attach files, got %v", recorder.Code)
+	}
+}
+
+func TestOnlyTheShelterCanAttachPhotos(t *testing.T) {
+	shelterID := rand.Int63()
+	item := &managers.Item{ShelterID: shelterID, SamaritanID: shelterID + 1, Status: managers.CLAIMED}
+	shelter := &managers.UserSession{UserType: managers.SHELTER, UserID: shelterID, LoginTime: time.Now().Unix()}
+	samaritan := &managers.UserSession{UserType: managers.SAMARITAN, UserID: shelterID + 1, LoginTime: time.Now().Unix()}
+
+	if !canAttach(shelter, item, managers.PHOTO) || canAttach(samaritan, item, managers.PHOTO) {
+		t.Error("Expected only the item's shelter to attach photos")
+	}
+
+	if canAttach(shelter, item, managers.RECEIPT) || !canAttach(samaritan, item, managers.RECEIPT) {
+		t.Error("Expected only the item's samaritan to attach receipts")
+	}
+}
+
+func TestReceiptsNeedAClaimedItem(t *testing.T) {
+	samaritanID := rand.Int63()
+	samaritan := &managers.UserSession{UserType: managers.SAMARITAN, UserID: samaritanID, LoginTime: time.Now().Unix()}
+	for status, allowed := range map[managers.ItemStatus]bool{managers.CREATED: false, managers.CLAIMED: true, managers.DELIVERED: true, managers.RECEIVED: false} {
+		item := &managers.Item{SamaritanID: samaritanID, Status: status}
+		if canAttach(samaritan, item, managers.RECEIPT) != allowed {
+			t.Errorf("Expected attaching a receipt to a %v item to be allowed: %v", status, allowed)
+		}
+	}
+}
+
+func TestReceiptsAreOnlyShownToTheShelterAndSamaritan(t *testing.T) {
+	shelterID := rand.Int63()
+	item := &managers.Item{ShelterID: shelterID, SamaritanID: shelterID + 1}
+	attachments := []*managers.Attachment{{ID: 1, Kind: managers.PHOTO}, {ID: 2, Kind: managers.RECEIPT}}
+	shelter := &managers.UserSession{UserType: managers.SHELTER, UserID: shelterID, LoginTime: time.Now().Unix()}
+	stranger := &managers.UserSession{UserType: managers.SAMARITAN, UserID: shelterID + 2, LoginTime: time.Now().Unix()}
+
+	if len(visibleAttachments(shelter, item, attachments)) != 2 {
+		t.Error("Expected the shelter to see the receipt")
+	}
+
+	for _, userSession := range []*managers.UserSession{nil, stranger} {
+		if visible := visibleAttachments(userSession, item, attachments); len(visible) != 1 || visible[0].Kind != managers.PHOTO {
+			t.Errorf("Expected %+v to only see the photo, got %v", userSession, visible)
+		}
+	}
+}
+
+func TestAttachmentsCanBeDeletedByTheUploaderOrShelter(t *testing.T) {
+	shelterID := rand.Int63()
+	item := &managers.Item{ShelterID: shelterID, SamaritanID: shelterID + 1}
+	receipt := &managers.Attachment{Kind: managers.RECEIPT, UploaderID: shelterID + 1}
+
+	for userID, userType := range map[int64]managers.UserType{shelterID: managers.SHELTER, shelterID + 1: managers.SAMARITAN} {
+		if !canDeleteAttachment(&managers.UserSession{UserType: userType, UserID: userID}, item, receipt) {
+			t.Errorf("Expected user %v to be able to delete the receipt", userID)
+		}
+	}
+
+	if canDeleteAttachment(&managers.UserSession{UserType: managers.SAMARITAN, UserID: shelterID + 2}, item, receipt) {
+		t.Error("Expected other users to be unable to delete the receipt")
+	}
+}
@@ -0,0 +1,183 @@
+package resources
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var auditEndpoint = "/admin/audit/"
+
+const auditDateFormat = "2006-01-02"
+
+// AuditServiceHandler lets administrators search the audit log and export it as CSV.
+type AuditServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	AuditManager       *managers.AuditManager
+	AuditRetriever     *retrievers.AuditRetriever
+}
+
+// AuditRequests attaches an audit actor to each request, so managers can record who made the
+// changes in it. The actor's session is filled in once the request is authenticated.
+func AuditRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor := &managers.AuditActor{IPAddress: clientIPAddress(r)}
+		next.ServeHTTP(w, r.WithContext(managers.WithAuditActor(r.Context(), actor)))
+	})
+}
+
+func recordAuditSession(r *http.Request, userSession *managers.UserSession) {
+	if actor := managers.AuditActorFromContext(r.Context()); actor != nil && userSession != nil {
+		actor.SetSession(userSession)
+	}
+}
+
+// getAdminSession returns the request's session if it belongs to an administrator. Otherwise it
+// responds with the unauthorized page and returns nil.
+func getAdminSession(w http.ResponseWriter, r *http.Request, userSessionManager managers.SessionManger) *managers.UserSession {
+	userSession := getCookieSession(r, userSessionManager)
+	if userSession == nil || userSession.UserType != managers.ADMIN {
+		tpl, _ := retrievers.RetrieveTemplate("home/unauthorized")
+		w.WriteHeader(http.StatusUnauthorized)
+		if tpl != nil {
+			tpl.Execute(w, nil)
+		}
+		return nil
+	}
+	return userSession
+}
+
+func (handler AuditServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userSession := getAdminSession(w, r, handler.UserSessionManager)
+	if userSession == nil {
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	search, err := parseAuditSearch(r)
+	if err != nil {
+		writeError(w, r, "", &managers.ValidationError{Message: "Invalid audit search: " + err.Error()})
+		return
+	}
+
+	if r.URL.Query().Get("format") == "csv" {
+		handler.handleExportAuditEvents(w, r, search)
+		return
+	}
+	handler.handleSearchAuditEvents(w, r, search, userSession)
+}
+
+func (handler AuditServiceHandler) handleSearchAuditEvents(w http.ResponseWriter, r *http.Request, search *managers.AuditSearch, userSession *managers.UserSession) {
+	events, err := handler.AuditManager.SearchAuditEvents(r.Context(), search)
+	if err != nil {
+		writeError(w, r, "AuditManager.SearchAuditEvents failed", err)
+		return
+	}
+
+	template, err := handler.AuditRetriever.RetrieveAllEntitiesTemplate()
+	if err != nil {
+		writeError(w, r, "AuditRetriever.RetrieveAllEntitiesTemplate failed", err)
+		return
+	}
+
+	page := search.Offset/search.Limit + 1
+	responseObject := map[string]interface{}{
+		"UserSession": userSession,
+		"Events":      events,
+		"Filters":     r.URL.Query(),
+		"Page":        page,
+		"ExportURL":   auditPageURL(r, "format", "csv"),
+	}
+
+	if page > 1 {
+		responseObject["PreviousURL"] = auditPageURL(r, "page", strconv.Itoa(page-1))
+	}
+
+	if len(events) == search.Limit {
+		responseObject["NextURL"] = auditPageURL(r, "page", strconv.Itoa(page+1))
+	}
+	err = template.Execute(w, responseObject)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+	}
+}
+
+func (handler AuditServiceHandler) handleExportAuditEvents(w http.ResponseWriter, r *http.Request, search *managers.AuditSearch) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="audit-`+time.Now().UTC().Format(auditDateFormat)+`.csv"`)
+	if err := handler.AuditManager.WriteAuditCSV(r.Context(), search, w); err != nil {
+		logging.FromContext(r.Context()).Error("AuditManager.WriteAuditCSV failed", logging.Fields{"error": err})
+	}
+}
+
+// parseAuditSearch reads the search filters from the query string. Dates are whole UTC days,
+// and until includes the day it names.
+func parseAuditSearch(r *http.Request) (*managers.AuditSearch, error) {
+	query := r.URL.Query()
+	search := &managers.AuditSearch{
+		Entity: strings.TrimSpace(query.Get("entity")),
+		Action: strings.TrimSpace(query.Get("action")),
+		Text:   strings.TrimSpace(query.Get("q")),
+		Limit:  managers.AUDIT_PAGE_SIZE,
+	}
+
+	var err error
+	if search.ActorID, err = parseOptionalInt(query.Get("actor")); err != nil {
+		return nil, err
+	}
+
+	if search.EntityID, err = parseOptionalInt(query.Get("entityId")); err != nil {
+		return nil, err
+	}
+
+	if since := query.Get("since"); since != "" {
+		sinceTime, err := time.Parse(auditDateFormat, since)
+		if err != nil {
+			return nil, err
+		}
+		search.Since = sinceTime.Unix()
+	}
+
+	if until := query.Get("until"); until != "" {
+		untilTime, err := time.Parse(auditDateFormat, until)
+		if err != nil {
+			return nil, err
+		}
+		search.Until = untilTime.AddDate(0, 0, 1).Unix()
+	}
+
+	page, err := parseOptionalInt(query.Get("page"))
+	if err != nil {
+		return nil, err
+	}
+
+	if page > 1 {
+		search.Offset = int(page-1) * search.Limit
+	}
+	return search, nil
+}
+
+func parseOptionalInt(value string) (int64, error) {
+	if strings.TrimSpace(value) == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+}
+
+// auditPageURL links to the current search with one parameter changed.
+func auditPageURL(r *http.Request, parameter string, value string) string {
+	query := r.URL.Query()
+	query.Del("page")
+	query.Del("format")
+	query.Set(parameter, value)
+	return auditEndpoint + "?" + query.Encode()
+}
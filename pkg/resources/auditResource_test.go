@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestOnlyAdminsCanViewAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler := AuditServiceHandler{UserSessionManager: getMockSessionManager(ctrl, testKey, managers.SHELTER, 1, nil)}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/audit/", nil)
+	req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("Expected shelters to be refused the audit log, got %v", recorder.Code)
+	}
+}
+
+func TestAuditRequestsAttributesChangesToTheSession(t *testing.T) {
+	var actor *managers.AuditActor
+	_, proxies, _ := net.ParseCIDR("192.0.2.0/24")
+	handler := ResolveClientAddresses([]*net.IPNet{proxies})(AuditRequests(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recordAuditSession(r, &managers.UserSession{SessionKey: testKey, UserID: 7})
+		actor = managers.AuditActorFromContext(r.Context())
+	})))
+
+	req := httptest.NewRequest(http.MethodPut, "/items/1", nil)
+	req.Header.Set("X-Forwarded-For", "10.0.0.1, 10.0.0.2")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if actor == nil || actor.UserID != 7 || actor.IPAddress != "10.0.0.2" || actor.Session != managers.HashSecret(testKey) {
+		t.Errorf("Expected the request's actor to be recorded, got %+v", actor)
+	}
+}
+
+func TestAuditSearchIncludesTheWholeUntilDay(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/admin/audit/?since=2020-01-01&until=2020-01-01&actor=3&page=2", nil)
+	search, err := parseAuditSearch(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if search.Since != since.Unix() || search.Until != since.AddDate(0, 0, 1).Unix() {
+		t.Errorf("Expected a search of one day, got %v to %v", search.Since, search.Until)
+	}
+
+	if search.ActorID != 3 || search.Offset != managers.AUDIT_PAGE_SIZE {
+		t.Errorf("Expected the second page of actor 3, got %+v", search)
+	}
+}
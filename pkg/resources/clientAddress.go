@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type clientAddressKey struct{}
+
+// ResolveClientAddresses works out the address of the client behind each request. X-Forwarded-For
+// is only read when the request came from one of the trusted proxies, since anyone else can send
+// whatever addresses they like in it. Each trusted proxy appends the address it got the request
+// from, so the client is the last address in the header that isn't another trusted proxy.
+func ResolveClientAddresses(trustedProxies []*net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			address := resolveClientAddress(r, trustedProxies)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientAddressKey{}, address)))
+		})
+	}
+}
+
+func resolveClientAddress(r *http.Request, trustedProxies []*net.IPNet) string {
+	address := remoteAddress(r)
+	if !isTrustedProxy(address, trustedProxies) {
+		return address
+	}
+
+	forwardedFor := strings.Split(strings.Join(r.Header["X-Forwarded-For"], ","), ",")
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		forwarded := strings.TrimSpace(forwardedFor[i])
+		if net.ParseIP(forwarded) == nil {
+			break
+		}
+
+		address = forwarded
+		if !isTrustedProxy(address, trustedProxies) {
+			break
+		}
+	}
+	return address
+}
+
+func isTrustedProxy(address string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIPAddress is the address ResolveClientAddresses worked out for the request, or the address
+// the request came from if it wasn't resolved.
+func clientIPAddress(r *http.Request) string {
+	if address, ok := r.Context().Value(clientAddressKey{}).(string); ok {
+		return address
+	}
+	return remoteAddress(r)
+}
+
+func remoteAddress(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
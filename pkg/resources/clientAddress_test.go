@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientAddressesAreOnlyForwardedByTrustedProxies(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	trusted := []*net.IPNet{proxies}
+	tests := []struct {
+		remoteAddr   string
+		forwardedFor string
+		expected     string
+	}{
+		{"203.0.113.9:4000", "198.51.100.1", "203.0.113.9"},
+		{"10.0.0.2:4000", "", "10.0.0.2"},
+		{"10.0.0.2:4000", "198.51.100.1, 203.0.113.7", "203.0.113.7"},
+		{"10.0.0.2:4000", "203.0.113.7, 10.0.0.3", "203.0.113.7"},
+		{"10.0.0.2:4000", "198.51.100.1, not-an-address", "10.0.0.2"},
+	}
+
+	for _, test := range tests {
+		var seen string
+		handler := ResolveClientAddresses(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen = clientIPAddress(r)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = test.remoteAddr
+		if test.forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", test.forwardedFor)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if seen != test.expected {
+			t.Errorf("Expected %s forwarding %q to resolve to %s, got %s", test.remoteAddr, test.forwardedFor, test.expected, seen)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var deletedAccountsEndpoint = "/admin/deleted/"
+
+// DeletedAccountServiceHandler lets administrators restore deleted accounts before they're purged.
+type DeletedAccountServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	UserManager        *managers.UserManager
+	UserRetriever      *retrievers.ShelterRetriever
+}
+
+func (handler DeletedAccountServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userSession := getAdminSession(w, r, handler.UserSessionManager)
+	if userSession == nil {
+		return
+	}
+
+	pathArray := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, deletedAccountsEndpoint), "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && len(pathArray) == 1 && pathArray[0] == "":
+		handler.handleGetDeletedAccounts(w, r, userSession)
+	case r.Method == http.MethodPost && len(pathArray) == 2 && pathArray[1] == "restore":
+		handler.handleRestoreAccount(w, r, pathArray[0])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (handler DeletedAccountServiceHandler) handleGetDeletedAccounts(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	users, err := handler.UserManager.GetDeletedUsers(r.Context())
+	if err != nil {
+		writeError(w, r, "UserManager.GetDeletedUsers failed", err)
+		return
+	}
+
+	template, err := handler.UserRetriever.RetrieveDeletedEntitiesTemplate()
+	if err != nil {
+		writeError(w, r, "UserRetriever.RetrieveDeletedEntitiesTemplate failed", err)
+		return
+	}
+
+	err = template.Execute(w, map[string]interface{}{
+		"UserSession": userSession,
+		"Users":       users,
+	})
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+	}
+}
+
+func (handler DeletedAccountServiceHandler) handleRestoreAccount(w http.ResponseWriter, r *http.Request, userID string) {
+	id, err := parseID(userID)
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return
+	}
+
+	restored, err := handler.UserManager.RestoreUser(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "UserManager.RestoreUser failed", err)
+		return
+	}
+
+	if !restored {
+		writeError(w, r, "", &managers.NotFoundError{Entity: "Deleted account", ID: id})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestOnlyAdminsCanRestoreAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler := DeletedAccountServiceHandler{UserSessionManager: getMockSessionManager(ctrl, testKey, managers.SHELTER, 1, nil)}
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/deleted/2/restore", nil)
+	req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	if recorder.Code != http.StatusUnauthorized {
+		t.Errorf("Expected shelters to be refused account restores, got %v", recorder.Code)
+	}
+}
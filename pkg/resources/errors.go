@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+const PROBLEM_CONTENT_TYPE = "application/problem+json"
+
+// INTERNAL_ERROR_MESSAGE is shown in place of unexpected errors, which can name internal hosts and queries.
+const INTERNAL_ERROR_MESSAGE = "It appears we the team at Neighbors has erred. In the mean time, click back to return to the previous page."
+
+var errAdminRegistration = &managers.ValidationError{Field: "UserType", Message: "Administrators can't sign up, ask an administrator to add you"}
+
+// problem is an RFC 7807 problem details body, which API clients get for failed requests.
+type problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// Field is the request field a validation error is about.
+	Field string `json:"field,omitempty"`
+	// Errors lists every invalid field when there's more than one to fix.
+	Errors []*invalidField `json:"errors,omitempty"`
+	// Current is the latest state of a record that an update conflicted with.
+	Current interface{} `json:"current,omitempty"`
+}
+
+type invalidField struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// errorStatus maps the errors managers return to the status they're reported with. Anything else
+// is unexpected.
+func errorStatus(err error) int {
+	switch err.(type) {
+	case *managers.ValidationError, managers.ValidationErrors, *managers.ItemImportError:
+		return http.StatusBadRequest
+	case *managers.ForbiddenError:
+		return http.StatusForbidden
+	case *managers.NotFoundError:
+		return http.StatusNotFound
+	case *managers.ConflictError:
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// writeError reports a failed request, as the error page to browsers and as problem details to
+// everything else. Unexpected errors are logged with failure, and their details are kept from the user.
+func writeError(w http.ResponseWriter, r *http.Request, failure string, err error) {
+	writeProblem(w, r, failure, err, nil)
+}
+
+// writeVersionConflict rejects an update based on a stale version, sending back the current state
+// so the client can reconcile its changes against it.
+func writeVersionConflict(w http.ResponseWriter, r *http.Request, err error, current interface{}) {
+	writeProblem(w, r, "", err, current)
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, failure string, err error, current interface{}) {
+	status := errorStatus(err)
+	detail := err.Error()
+	if status == http.StatusInternalServerError {
+		logging.FromContext(r.Context()).Error(failure, logging.Fields{"error": err})
+		detail = INTERNAL_ERROR_MESSAGE
+	}
+
+	body := &problem{Type: "about:blank", Title: http.StatusText(status), Status: status, Detail: detail, Instance: r.URL.Path, Current: current}
+	switch validationErr := err.(type) {
+	case *managers.ValidationError:
+		body.Field = validationErr.Field
+	case managers.ValidationErrors:
+		for _, fieldErr := range validationErr {
+			body.Errors = append(body.Errors, &invalidField{Field: fieldErr.Field, Message: fieldErr.Message})
+		}
+	}
+
+	if acceptsHTML(r) {
+		writeErrorPage(w, body)
+		return
+	}
+
+	w.Header().Set("Content-Type", PROBLEM_CONTENT_TYPE)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeErrorPage(w http.ResponseWriter, body *problem) {
+	tpl, _ := retrievers.RetrieveTemplate("home/error")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(body.Status)
+	if tpl != nil {
+		tpl.Execute(w, body)
+	}
+}
+
+// acceptsHTML tells pages loaded by the browser apart from requests made by scripts and API clients.
+func acceptsHTML(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+// parseID reads an ID from the request path.
+func parseID(value string) (int64, error) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, &managers.ValidationError{Field: "ID", Message: strconv.Quote(value) + " isn't a valid ID"}
+	}
+	return id, nil
+}
+
+// decodeBody reads a JSON request body into value.
+func decodeBody(r *http.Request, value interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(value); err != nil {
+		return &managers.ValidationError{Message: "The request body isn't valid JSON: " + err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestDomainErrorsMapToStatuses(t *testing.T) {
+	expected := map[error]int{
+		&managers.ValidationError{Message: "Quantity is required"}: http.StatusBadRequest,
+		&managers.ForbiddenError{Message: "No"}:                    http.StatusForbidden,
+		&managers.NotFoundError{Entity: "Item", ID: 1}:             http.StatusNotFound,
+		managers.ErrItemVersionConflict:                            http.StatusConflict,
+		managers.ErrDuplicateEmail:                                 http.StatusConflict,
+		errors.New("connection refused"):                           http.StatusInternalServerError,
+	}
+	for err, status := range expected {
+		if errorStatus(err) != status {
+			t.Errorf("Expected %v to map to %v, got %v", err, status, errorStatus(err))
+		}
+	}
+}
+
+func TestAPIClientsGetProblemDetails(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/items/abc", nil)
+	recorder := httptest.NewRecorder()
+	_, err := parseID("abc")
+	writeError(recorder, req, "Invalid ID", err)
+
+	body := &problem{}
+	if err := json.NewDecoder(recorder.Body).Decode(body); err != nil {
+		t.Fatal(err)
+	}
+
+	if recorder.Code != http.StatusBadRequest || recorder.Header().Get("Content-Type") != PROBLEM_CONTENT_TYPE {
+		t.Errorf("Expected a 400 problem, got %v %v", recorder.Code, recorder.Header().Get("Content-Type"))
+	}
+
+	if body.Status != http.StatusBadRequest || body.Field != "ID" || body.Instance != "/items/abc" || !strings.Contains(body.Detail, "abc") {
+		t.Errorf("Expected the problem to describe the invalid ID, got %+v", body)
+	}
+}
+
+func TestBrowsersGetTheErrorPageWithoutInternalDetails(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/items/1", nil)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	recorder := httptest.NewRecorder()
+	writeError(recorder, req, "ItemManager.GetItem failed", errors.New("dial tcp db.internal:5432: connection refused"))
+
+	page := recorder.Body.String()
+	if recorder.Code != http.StatusInternalServerError || !strings.Contains(page, "Internal Server Error") {
+		t.Errorf("Expected the error page, got %v: %s", recorder.Code, page)
+	}
+
+	if strings.Contains(page, "db.internal") {
+		t.Errorf("Expected the error's details to be hidden, got %s", page)
+	}
+}
+
+func TestValidationErrorsListEachField(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/items/", nil)
+	recorder := httptest.NewRecorder()
+	err := managers.ValidationErrors{
+		&managers.ValidationError{Field: "Category", Message: "Category is required"},
+		&managers.ValidationError{Field: "Quantity", Message: "Quantity must be from 1 to 127"},
+	}
+	writeError(recorder, req, "", err)
+
+	body := &problem{}
+	if err := json.NewDecoder(recorder.Body).Decode(body); err != nil {
+		t.Fatal(err)
+	}
+
+	if recorder.Code != http.StatusBadRequest || len(body.Errors) != 2 || body.Errors[1].Field != "Quantity" || body.Errors[1].Message != "Quantity must be from 1 to 127" {
+		t.Errorf("Expected a 400 listing both fields, got %v %+v", recorder.Code, body)
+	}
+}
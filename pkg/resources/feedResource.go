@@ -0,0 +1,300 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/feeds"
+	"github.com/kwhite17/Neighbors/pkg/ical"
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+var feedsEndpoint = "/feeds/"
+
+// FEED_SIZE is how many of the newest open items a feed lists.
+const FEED_SIZE = 50
+
+var errNotASamaritan = &managers.ForbiddenError{Message: "Only samaritans have a drop-off calendar"}
+
+// FeedServiceHandler serves feeds of open items, for anyone to follow in a feed reader, and each
+// samaritan's calendar of drop-offs. Calendar apps can't log in, so a samaritan's calendar is only
+// served at a URL holding their feed token, and asking for a new URL retires the old one.
+type FeedServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	UserManager        *managers.UserManager
+	ItemManager        *managers.ItemManager
+	IntakeManager      *managers.IntakeManager
+	FeedManager        *managers.FeedManager
+}
+
+type calendarFeedResponse struct {
+	URL string
+}
+
+func (handler FeedServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(feedsEndpoint, "/")), "/")
+	pathArray := strings.Split(path, "/")
+	switch {
+	case r.Method == http.MethodPost && path == "calendar":
+		handler.handleIssueCalendarFeed(w, r)
+	case r.Method != http.MethodGet:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	case len(pathArray) == 1 && strings.HasPrefix(path, "items."):
+		handler.handleGetItemFeed(w, r, 0, strings.TrimPrefix(path, "items."))
+	case len(pathArray) == 2 && pathArray[0] == "shelters":
+		handler.handleGetShelterFeed(w, r, pathArray[1])
+	case len(pathArray) == 2 && pathArray[0] == "calendar" && strings.HasSuffix(pathArray[1], ".ics"):
+		handler.handleGetCalendarFeed(w, r, strings.TrimSuffix(pathArray[1], ".ics"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (handler FeedServiceHandler) handleGetShelterFeed(w http.ResponseWriter, r *http.Request, name string) {
+	dot := strings.LastIndex(name, ".")
+	if dot < 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	id, err := parseID(name[:dot])
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return
+	}
+
+	shelter, err := handler.UserManager.GetUser(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "UserManager.GetUser failed", err)
+		return
+	}
+
+	if shelter.UserType != managers.SHELTER {
+		writeError(w, r, "", &managers.NotFoundError{Entity: "Shelter", ID: id})
+		return
+	}
+	handler.handleGetItemFeed(w, r, id, name[dot+1:])
+}
+
+// handleGetItemFeed lists the newest open items, either every shelter's or just one's, as Atom or RSS.
+func (handler FeedServiceHandler) handleGetItemFeed(w http.ResponseWriter, r *http.Request, shelterID int64, extension string) {
+	if extension != "atom" && extension != "rss" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	items, err := handler.ItemManager.GetOpenItems(r.Context(), shelterID, FEED_SIZE)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetOpenItems failed", err)
+		return
+	}
+
+	shelters := make(map[int64]*managers.User)
+	origin := requestOrigin(r)
+	feed := &feeds.Feed{
+		ID:          origin + r.URL.Path,
+		Title:       "Items needed on Neighbors",
+		Description: "Items shelters have asked for that no one has claimed yet",
+		Link:        origin + "/items/",
+		Self:        origin + r.URL.Path,
+		Author:      "Neighbors",
+		Updated:     time.Now(),
+		Entries:     make([]*feeds.Entry, 0, len(items)),
+	}
+	if shelterID > 0 {
+		shelter, err := handler.getShelter(r.Context(), shelters, shelterID)
+		if err != nil {
+			writeError(w, r, "UserManager.GetUser failed", err)
+			return
+		}
+		feed.Title = "Items needed by " + shelter.Name
+		feed.Description = "Items " + shelter.Name + " has asked for that no one has claimed yet"
+		feed.Link = fmt.Sprintf("%s/shelters/%d", origin, shelterID)
+		feed.Author = shelter.Name
+	}
+
+	for _, item := range items {
+		shelter, err := handler.getShelter(r.Context(), shelters, item.ShelterID)
+		if err != nil {
+			writeError(w, r, "UserManager.GetUser failed", err)
+			return
+		}
+
+		// Items outlive deleted shelters until they're purged, but nobody can drop anything off for them.
+		if shelter == nil {
+			continue
+		}
+
+		link := fmt.Sprintf("%s/items/%d", origin, item.ID)
+		created := time.Unix(item.CreatedTime, 0)
+		feed.Entries = append(feed.Entries, &feeds.Entry{
+			ID:        link,
+			Title:     item.FormattedQuantity() + " " + item.Category + " for " + shelter.Name,
+			Link:      link,
+			Summary:   fmt.Sprintf("%s needs %s %s (gender: %s, size: %s).", shelter.Name, item.FormattedQuantity(), item.Category, item.Gender, item.Size),
+			Published: created,
+			Updated:   created,
+		})
+	}
+	if len(feed.Entries) > 0 {
+		feed.Updated = feed.Entries[0].Updated
+	}
+
+	if extension == "atom" {
+		w.Header().Set("Content-Type", feeds.ATOM_CONTENT_TYPE)
+		err = feed.WriteAtom(w)
+	} else {
+		w.Header().Set("Content-Type", feeds.RSS_CONTENT_TYPE)
+		err = feed.WriteRSS(w)
+	}
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't write feed", logging.Fields{"error": err})
+	}
+}
+
+// handleGetCalendarFeed lists the drop-offs the token's samaritan has booked, and the dates they
+// should drop their claimed items off by.
+func (handler FeedServiceHandler) handleGetCalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	samaritanID, userType, err := handler.FeedManager.GetFeedTokenOwner(r.Context(), token)
+	if err != nil {
+		writeError(w, r, "FeedManager.GetFeedTokenOwner failed", err)
+		return
+	}
+
+	if samaritanID == 0 || userType != managers.SAMARITAN {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	items, err := handler.ItemManager.GetItemsForSamaritan(r.Context(), samaritanID)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItemsForSamaritan failed", err)
+		return
+	}
+
+	calendar, err := handler.buildDropOffCalendar(r.Context(), items, requestOrigin(r))
+	if err != nil {
+		writeError(w, r, "Couldn't build drop-off calendar", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", ical.CONTENT_TYPE)
+	w.Header().Set("Cache-Control", "private, no-store")
+	if err := calendar.Encode(w); err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't write calendar", logging.Fields{"error": err})
+	}
+}
+
+// buildDropOffCalendar has an event for each booked drop-off, sharing its UID with the invitation
+// emailed when it was booked, and an all-day event on each claimed item's deadline.
+func (handler FeedServiceHandler) buildDropOffCalendar(ctx context.Context, items []*managers.Item, origin string) (*ical.Calendar, error) {
+	calendar := &ical.Calendar{Name: "Neighbors drop-offs", Events: make([]*ical.Event, 0)}
+	shelters := make(map[int64]*managers.User)
+	schedules := make(map[int64]*managers.IntakeSchedule)
+	now := time.Now()
+	for _, item := range items {
+		if !item.IsAwaitingDelivery() {
+			continue
+		}
+
+		shelter, err := handler.getShelter(ctx, shelters, item.ShelterID)
+		if err != nil {
+			return nil, err
+		}
+
+		if shelter == nil {
+			continue
+		}
+
+		schedule, ok := schedules[item.ShelterID]
+		if !ok {
+			schedule, err = handler.IntakeManager.GetIntakeSchedule(ctx, item.ShelterID)
+			if err != nil {
+				return nil, err
+			}
+			schedules[item.ShelterID] = schedule
+		}
+
+		dropOff, err := handler.IntakeManager.GetDropOff(ctx, item.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		description := item.FormattedQuantity() + " " + item.Category + " for " + shelter.Name
+		address := fmt.Sprintf("%s, %s, %s %s", shelter.Street, shelter.City, shelter.State, shelter.PostalCode)
+		url := fmt.Sprintf("%s/items/%d", origin, item.ID)
+		if dropOff != nil {
+			calendar.Events = append(calendar.Events, &ical.Event{
+				UID:         fmt.Sprintf("dropoff-%d@neighbors", item.ID),
+				Start:       time.Unix(dropOff.StartTime, 0),
+				End:         time.Unix(dropOff.EndTime, 0),
+				Summary:     "Drop off " + description,
+				Description: schedule.Instructions,
+				Location:    address,
+				URL:         url,
+				Stamp:       now,
+			})
+		}
+
+		if deadline := item.ClaimDeadline(); !deadline.IsZero() {
+			calendar.Events = append(calendar.Events, &ical.Event{
+				UID:      fmt.Sprintf("deadline-%d@neighbors", item.ID),
+				Start:    deadline.In(schedule.Location()),
+				Summary:  "Last day to drop off " + description,
+				Location: address,
+				URL:      url,
+				AllDay:   true,
+				Stamp:    now,
+			})
+		}
+	}
+	return calendar, nil
+}
+
+// handleIssueCalendarFeed gives the signed-in samaritan a new calendar URL, replacing the last.
+func (handler FeedServiceHandler) handleIssueCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	userSession := getCookieSession(r, handler.UserSessionManager)
+	if userSession == nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if userSession.UserType != managers.SAMARITAN {
+		writeError(w, r, "", errNotASamaritan)
+		return
+	}
+
+	token, err := handler.FeedManager.IssueFeedToken(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "FeedManager.IssueFeedToken failed", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(&calendarFeedResponse{URL: requestOrigin(r) + feedsEndpoint + "calendar/" + token + ".ics"})
+}
+
+// getShelter looks up an item's shelter once per feed. It returns nil for shelters that have been
+// deleted.
+func (handler FeedServiceHandler) getShelter(ctx context.Context, shelters map[int64]*managers.User, shelterID int64) (*managers.User, error) {
+	if shelter, ok := shelters[shelterID]; ok {
+		return shelter, nil
+	}
+
+	shelter, err := handler.UserManager.GetUser(ctx, shelterID)
+	if _, notFound := err.(*managers.NotFoundError); notFound {
+		shelter, err = nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	shelters[shelterID] = shelter
+	return shelter, nil
+}
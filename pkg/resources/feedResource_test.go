@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func initFeedServiceHandler(t *testing.T) (FeedServiceHandler, int64, int64, func()) {
+	datasource := database.StandardDatasource{Database: database.InitDatabase(database.SQLITE3)}
+	handler := FeedServiceHandler{
+		UserManager:   &managers.UserManager{Datasource: datasource},
+		ItemManager:   &managers.ItemManager{Datasource: datasource},
+		IntakeManager: &managers.IntakeManager{Datasource: datasource},
+		FeedManager:   &managers.FeedManager{Datasource: datasource},
+	}
+
+	userIDs := make([]int64, 2)
+	for i, userType := range []managers.UserType{managers.SHELTER, managers.SAMARITAN} {
+		contactInfo := &managers.ContactInformation{Name: fmt.Sprintf("Neighbor %d", i), Email: fmt.Sprintf("neighbor%d@example.org", i), Street: "1 Main St", City: "Boston", State: "MA", PostalCode: "02110"}
+		userID, err := handler.UserManager.WriteUser(context.Background(), &managers.User{UserType: userType, Username: fmt.Sprintf("neighbor%d", i), ContactInformation: contactInfo}, "password1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		userIDs[i] = userID
+	}
+	return handler, userIDs[0], userIDs[1], func() { datasource.Close() }
+}
+
+func writeFeedItem(t *testing.T, handler FeedServiceHandler, shelterID int64, samaritanID int64) int64 {
+	item := &managers.Item{Category: "Socks", Gender: "Unisex", Quantity: 2, Unit: managers.PAIR, ShelterID: shelterID, Size: "M", Status: managers.CREATED}
+	id, err := handler.ItemManager.WriteItem(context.Background(), item)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if samaritanID > 0 {
+		item.ID = id
+		item.SamaritanID = samaritanID
+		item.Status = managers.CLAIMED
+		if err := handler.ItemManager.UpdateItem(context.Background(), item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return id
+}
+
+func getFeed(handler FeedServiceHandler, method string, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+	return recorder
+}
+
+func TestItemFeedsOnlyListOpenItems(t *testing.T) {
+	handler, shelterID, samaritanID, cleanup := initFeedServiceHandler(t)
+	defer cleanup()
+	openItemID := writeFeedItem(t, handler, shelterID, 0)
+	claimedItemID := writeFeedItem(t, handler, shelterID, samaritanID)
+
+	for _, path := range []string{"/feeds/items.atom", "/feeds/items.rss", fmt.Sprintf("/feeds/shelters/%d.atom", shelterID)} {
+		recorder := getFeed(handler, http.MethodGet, path)
+		body := recorder.Body.String()
+		if recorder.Code != http.StatusOK || !strings.Contains(body, fmt.Sprintf("/items/%d<", openItemID)) || strings.Contains(body, fmt.Sprintf("/items/%d<", claimedItemID)) {
+			t.Errorf("Expected %v to list only the open item, got %v: %s", path, recorder.Code, body)
+		}
+	}
+
+	for _, path := range []string{"/feeds/items.json", fmt.Sprintf("/feeds/shelters/%d", shelterID), fmt.Sprintf("/feeds/shelters/%d.atom", samaritanID)} {
+		if recorder := getFeed(handler, http.MethodGet, path); recorder.Code != http.StatusNotFound {
+			t.Errorf("Expected %v not to be found, got %v", path, recorder.Code)
+		}
+	}
+}
+
+func TestCalendarFeedsNeedTheCurrentToken(t *testing.T) {
+	handler, shelterID, samaritanID, cleanup := initFeedServiceHandler(t)
+	defer cleanup()
+	itemID := writeFeedItem(t, handler, shelterID, samaritanID)
+
+	oldToken, _ := handler.FeedManager.IssueFeedToken(context.Background(), samaritanID)
+	token, _ := handler.FeedManager.IssueFeedToken(context.Background(), samaritanID)
+
+	recorder := getFeed(handler, http.MethodGet, "/feeds/calendar/"+token+".ics")
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), fmt.Sprintf("UID:deadline-%d@neighbors", itemID)) {
+		t.Errorf("Expected the calendar to show the claim deadline, got %v: %s", recorder.Code, recorder.Body)
+	}
+
+	if recorder := getFeed(handler, http.MethodGet, "/feeds/calendar/"+oldToken+".ics"); recorder.Code != http.StatusNotFound {
+		t.Errorf("Expected replaced calendar URLs to stop working, got %v", recorder.Code)
+	}
+}
+
+func TestCannotIssueCalendarFeedWithNoCookie(t *testing.T) {
+	handler := FeedServiceHandler{}
+
+	if recorder := getFeed(handler, http.MethodPost, "/feeds/calendar"); recorder.Code != http.StatusUnauthorized {
+		t.Errorf("Expected users without a session to be unauthorized, got %v", recorder.Code)
+	}
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/email"
+	"github.com/kwhite17/Neighbors/pkg/logging"
+)
+
+const HEALTH_CHECK_TIMEOUT = 5 * time.Second
+
+// HealthServiceHandler serves /healthz, which succeeds whenever the process is serving, and
+// /readyz, which also requires the database and email transport to be reachable. Readiness fails
+// once ShuttingDown is closed, so traffic moves elsewhere while in-flight requests drain.
+type HealthServiceHandler struct {
+	Datasource   database.Datasource
+	EmailSender  email.EmailSender
+	ShuttingDown <-chan struct{}
+}
+
+type healthResponse struct {
+	Status string
+	Checks map[string]string `json:",omitempty"`
+}
+
+func (handler HealthServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	switch r.URL.Path {
+	case "/healthz":
+		json.NewEncoder(w).Encode(&healthResponse{Status: "ok"})
+	case "/readyz":
+		handler.handleReadiness(w, r)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (handler HealthServiceHandler) handleReadiness(w http.ResponseWriter, r *http.Request) {
+	select {
+	case <-handler.ShuttingDown:
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(&healthResponse{Status: "shutting down"})
+		return
+	default:
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), HEALTH_CHECK_TIMEOUT)
+	defer cancel()
+	response := &healthResponse{Status: "ok", Checks: make(map[string]string)}
+	checks := map[string]func(context.Context) error{
+		"database": handler.Datasource.Ping,
+		"email":    handler.EmailSender.Ping,
+	}
+	for name, check := range checks {
+		response.Checks[name] = "ok"
+		// The error is only logged, since it can name internal hosts.
+		if err := check(ctx); err != nil {
+			logging.FromContext(ctx).Error("Readiness check failed", logging.Fields{"check": name, "error": err})
+			response.Status = "unavailable"
+			response.Checks[name] = "unavailable"
+		}
+	}
+
+	if response.Status != "ok" {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/email"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+type fakeEmailSender struct {
+	email.EmailSender
+	pingErr error
+}
+
+func (sender *fakeEmailSender) Ping(ctx context.Context) error {
+	return sender.pingErr
+}
+
+func (sender *fakeEmailSender) DeliverEmail(ctx context.Context, previousItem *managers.Item, currentItem *managers.Item, userSession *managers.UserSession) error {
+	return nil
+}
+
+func checkHealth(handler HealthServiceHandler, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder
+}
+
+func TestReadinessRequiresEmailTransport(t *testing.T) {
+	datasource := database.StandardDatasource{Database: database.InitDatabase(database.SQLITE3)}
+	defer datasource.Close()
+	handler := HealthServiceHandler{Datasource: datasource, EmailSender: &fakeEmailSender{}, ShuttingDown: make(chan struct{})}
+
+	if recorder := checkHealth(handler, "/readyz"); recorder.Code != http.StatusOK {
+		t.Errorf("Expected to be ready, got %v: %s", recorder.Code, recorder.Body)
+	}
+
+	handler.EmailSender = &fakeEmailSender{pingErr: errors.New("dial tcp smtp.internal:25: connection refused")}
+	recorder := checkHealth(handler, "/readyz")
+	if recorder.Code != http.StatusServiceUnavailable || strings.Contains(recorder.Body.String(), "smtp.internal") {
+		t.Errorf("Expected an unavailable email transport to fail readiness without details, got %v: %s", recorder.Code, recorder.Body)
+	}
+
+	if recorder := checkHealth(handler, "/healthz"); recorder.Code != http.StatusOK {
+		t.Errorf("Expected liveness not to depend on email, got %v", recorder.Code)
+	}
+}
+
+func TestReadinessFailsWhileShuttingDown(t *testing.T) {
+	shuttingDown := make(chan struct{})
+	close(shuttingDown)
+	handler := HealthServiceHandler{ShuttingDown: shuttingDown}
+
+	if recorder := checkHealth(handler, "/readyz"); recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected readiness to fail while shutting down, got %v", recorder.Code)
+	}
+}
@@ -2,11 +2,11 @@ package resources
 
 import (
 	"database/sql"
-	"log"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/kwhite17/Neighbors/pkg/logging"
 	"github.com/kwhite17/Neighbors/pkg/managers"
 	"github.com/kwhite17/Neighbors/pkg/retrievers"
 )
@@ -32,12 +32,7 @@ func (hsh HomeServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		tpl, err := retrievers.RetrieveMultiTemplate("home/layout", "home/index")
 
 		if err != nil {
-			tpl, _ = retrievers.RetrieveTemplate("home/error")
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			if tpl != nil {
-				tpl.Execute(w, nil)
-			}
+			writeError(w, r, "Couldn't load template", err)
 			return
 		}
 
@@ -46,7 +41,7 @@ func (hsh HomeServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		})
 
 		if err != nil {
-			log.Println(err)
+			logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
 		}
 	}
 
@@ -66,7 +61,7 @@ func (hsh HomeServiceHandler) isAuthorized(r *http.Request) (bool, *managers.Use
 		shelterSession, err := hsh.UserSessionManager.GetUserSession(r.Context(), cookie.Value)
 
 		if err != nil {
-			log.Println(err)
+			logging.FromContext(r.Context()).Error("UserSessionManager.GetUserSession failed", logging.Fields{"error": err})
 			return err == sql.ErrNoRows, shelterSession
 		}
 
@@ -81,14 +76,14 @@ func (hsh HomeServiceHandler) isAuthorized(r *http.Request) (bool, *managers.Use
 		shelterSession, err := hsh.UserSessionManager.GetUserSession(r.Context(), cookie.Value)
 
 		if err != nil {
-			log.Println(err)
+			logging.FromContext(r.Context()).Error("UserSessionManager.GetUserSession failed", logging.Fields{"error": err})
 			return false, shelterSession
 		}
 
 		shelterID, err := strconv.ParseInt(pathArray[len(pathArray)-1], 10, strconv.IntSize)
 
 		if err != nil {
-			log.Println(err)
+			logging.FromContext(r.Context()).Error("Invalid ID", logging.Fields{"error": err})
 			return false, shelterSession
 		}
 
@@ -101,7 +96,7 @@ func (hsh HomeServiceHandler) isAuthorized(r *http.Request) (bool, *managers.Use
 			shelterSession, err = hsh.UserSessionManager.GetUserSession(r.Context(), cookie.Value)
 
 			if err != nil && err != sql.ErrNoRows {
-				log.Println(err)
+				logging.FromContext(r.Context()).Error("UserSessionManager.GetUserSession failed", logging.Fields{"error": err})
 				return false, shelterSession
 			}
 		}
@@ -110,7 +105,7 @@ func (hsh HomeServiceHandler) isAuthorized(r *http.Request) (bool, *managers.Use
 			shelterID, err := strconv.ParseInt(pathArray[len(pathArray)-2], 10, strconv.IntSize)
 
 			if err != nil {
-				log.Println(err)
+				logging.FromContext(r.Context()).Error("Invalid ID", logging.Fields{"error": err})
 				return false, shelterSession
 			}
 
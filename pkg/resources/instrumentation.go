@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/metrics"
+	"github.com/kwhite17/Neighbors/pkg/tracing"
+)
+
+// statusRecorder remembers the status code a handler responded with.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (recorder *statusRecorder) WriteHeader(status int) {
+	if recorder.status == 0 {
+		recorder.status = status
+	}
+	recorder.ResponseWriter.WriteHeader(status)
+}
+
+func (recorder *statusRecorder) Write(body []byte) (int, error) {
+	if recorder.status == 0 {
+		recorder.status = http.StatusOK
+	}
+	return recorder.ResponseWriter.Write(body)
+}
+
+// TraceRequests gives each request an ID, which is passed down through its context to the
+// queries it runs and returned in the X-Request-ID header. An ID set by a proxy in front of the
+// server is kept, so its logs line up with ours. Everything the request logs goes through logger,
+// tagged with the ID.
+func TraceRequests(logger *logging.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(tracing.REQUEST_ID_HEADER)
+			if !tracing.IsValidRequestID(requestID) {
+				requestID = tracing.NewRequestID()
+			}
+
+			w.Header().Set(tracing.REQUEST_ID_HEADER, requestID)
+			ctx := tracing.WithRequestID(r.Context(), requestID)
+			ctx = logging.WithLogger(ctx, logger.With(logging.Fields{"requestID": requestID}))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// MeasureRequests records how long each request took by the route it matched. It's router
+// middleware, so the route is known; routes are prefixes, which keeps the number of labels small.
+func MeasureRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+
+		route := "unmatched"
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+			}
+		}
+		metrics.HTTPRequestDuration.WithLabelValues(route, r.Method, strconv.Itoa(recorder.status)).Observe(time.Since(start).Seconds())
+	})
+}
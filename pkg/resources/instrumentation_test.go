@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/tracing"
+)
+
+func TestRequestIDsReachTheHandlerAndTheResponse(t *testing.T) {
+	var seen string
+	logs := &bytes.Buffer{}
+	handler := TraceRequests(logging.New(logs, logging.INFO, logging.JSON_FORMAT))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = tracing.RequestIDFromContext(r.Context())
+		logging.FromContext(r.Context()).Info("Handled", nil)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.Header.Set(tracing.REQUEST_ID_HEADER, "from-proxy-1")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	if seen != "from-proxy-1" || recorder.Header().Get(tracing.REQUEST_ID_HEADER) != "from-proxy-1" {
+		t.Errorf("Expected the proxy's request ID to be kept, got %q", seen)
+	}
+
+	if !strings.Contains(logs.String(), `"requestID":"from-proxy-1"`) {
+		t.Errorf("Expected the request's logs to carry its ID, got %s", logs)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/items", nil)
+	req.Header.Set(tracing.REQUEST_ID_HEADER, "forged\nERROR - something")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	if seen == "" || seen == req.Header.Get(tracing.REQUEST_ID_HEADER) || recorder.Header().Get(tracing.REQUEST_ID_HEADER) != seen {
+		t.Errorf("Expected an invalid request ID to be replaced, got %q", seen)
+	}
+
+	if tracing.RequestIDFromContext(context.Background()) != "" {
+		t.Error("Expected no request ID outside of a request")
+	}
+}
@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+var errNotAShelter = &managers.ForbiddenError{Message: "Only shelters have intake hours"}
+
+// itemUpdate is an item being changed. DropOffTime is the start of the slot a samaritan picked
+// when claiming the item, or moving its drop-off.
+type itemUpdate struct {
+	managers.Item
+	DropOffTime *time.Time
+}
+
+// dropOffSlots is what samaritans choose from when they claim one of a shelter's items.
+type dropOffSlots struct {
+	TimeZone     string
+	Instructions string
+	Slots        []*managers.DropOffSlot
+}
+
+// handleIntakeSchedule shows a shelter the page for setting its intake hours, and saves them.
+func (handler UserServiceHandler) handleIntakeSchedule(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, tplMap map[string]interface{}) {
+	if userSession.UserType != managers.SHELTER {
+		writeError(w, r, "", errNotAShelter)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		schedule, err := handler.IntakeManager.GetIntakeSchedule(r.Context(), userSession.UserID)
+		if err != nil {
+			writeError(w, r, "IntakeManager.GetIntakeSchedule failed", err)
+			return
+		}
+
+		t, err := handler.UserRetriever.RetrieveIntakeTemplate()
+		if err != nil {
+			writeError(w, r, "UserRetriever.RetrieveIntakeTemplate failed", err)
+			return
+		}
+
+		tplMap["IntakeSchedule"] = schedule
+		if err := t.Execute(w, tplMap); err != nil {
+			logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+		}
+	case http.MethodPut:
+		schedule := &managers.IntakeSchedule{}
+		if err := decodeBody(r, schedule); err != nil {
+			writeError(w, r, "Couldn't decode request body", err)
+			return
+		}
+
+		schedule.ShelterID = userSession.UserID
+		if err := handler.IntakeManager.ValidateForIntakeScheduleUpdate(r.Context(), schedule); err != nil {
+			writeError(w, r, "", err)
+			return
+		}
+
+		if err := handler.IntakeManager.SaveIntakeSchedule(r.Context(), schedule); err != nil {
+			writeError(w, r, "IntakeManager.SaveIntakeSchedule failed", err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// handleGetDropOffSlots lists the drop-off slots a shelter has open.
+func (handler UserServiceHandler) handleGetDropOffSlots(w http.ResponseWriter, r *http.Request, shelterID string) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := parseID(shelterID)
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return
+	}
+
+	user, err := handler.UserManager.GetUser(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "UserManager.GetUser failed", err)
+		return
+	}
+
+	if user.UserType != managers.SHELTER {
+		writeError(w, r, "", &managers.NotFoundError{Entity: "Shelter", ID: id})
+		return
+	}
+
+	schedule, err := handler.IntakeManager.GetIntakeSchedule(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "IntakeManager.GetIntakeSchedule failed", err)
+		return
+	}
+
+	slots, err := handler.IntakeManager.AvailableSlots(r.Context(), schedule, time.Now())
+	if err != nil {
+		writeError(w, r, "IntakeManager.AvailableSlots failed", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(&dropOffSlots{TimeZone: schedule.TimeZone, Instructions: schedule.Instructions, Slots: slots})
+}
+
+// dropOffToBook returns the slot a samaritan picked for an item they're claiming, if it needs
+// booking. Shelters with intake hours need a slot picked when the item is claimed; after that the
+// booking only changes when the samaritan picks another slot. The schedule is returned with the
+// slot, for the emails that announce the booking.
+func (handler ItemServiceHandler) dropOffToBook(ctx context.Context, previousItem *managers.Item, update *itemUpdate, userSession *managers.UserSession) (*time.Time, *managers.IntakeSchedule, error) {
+	item := &update.Item
+	if userSession.UserType != managers.SAMARITAN || !item.IsAwaitingDelivery() {
+		return nil, nil, nil
+	}
+
+	schedule, err := handler.IntakeManager.GetIntakeSchedule(ctx, item.ShelterID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if update.DropOffTime == nil {
+		if schedule.TakesBookings() && !previousItem.IsAwaitingDelivery() {
+			return nil, nil, managers.ErrDropOffSlotRequired
+		}
+		return nil, nil, nil
+	}
+	return update.DropOffTime, schedule, nil
+}
+
+// addDropOffDetails adds an item's booked drop-off to its page, along with the slots the samaritan
+// can move it to, or pick from when claiming the item.
+func (handler ItemServiceHandler) addDropOffDetails(ctx context.Context, item *managers.Item, userSession *managers.UserSession, tplMap map[string]interface{}) error {
+	if item.Status == managers.RECEIVED {
+		return nil
+	}
+
+	schedule, err := handler.IntakeManager.GetIntakeSchedule(ctx, item.ShelterID)
+	if err != nil {
+		return err
+	}
+	tplMap["IntakeSchedule"] = schedule
+
+	dropOff, err := handler.IntakeManager.GetDropOff(ctx, item.ID)
+	if err != nil {
+		return err
+	}
+
+	if dropOff != nil {
+		tplMap["DropOff"] = dropOff.In(schedule)
+	}
+
+	if userSession != nil && userSession.UserType == managers.SAMARITAN && schedule.TakesBookings() {
+		slots, err := handler.IntakeManager.AvailableSlots(ctx, schedule, time.Now())
+		if err != nil {
+			return err
+		}
+		tplMap["DropOffSlots"] = slots
+	}
+	return nil
+}
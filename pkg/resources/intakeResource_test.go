@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCannotSetIntakeHoursWithNoCookie(t *testing.T) {
+	handler := &UserServiceHandler{}
+
+	for _, method := range []string{http.MethodGet, http.MethodPut} {
+		req := httptest.NewRequest(method, "/shelters/1/intake", nil)
+		if isAuthorized, _ := handler.isAuthorized(req); isAuthorized {
+			t.Errorf("Expected users without a session to be unauthorized to %v intake hours", method)
+		}
+	}
+}
+
+func TestAnyoneCanSeeOpenDropOffSlots(t *testing.T) {
+	handler := &UserServiceHandler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/shelters/1/slots", nil)
+	if isAuthorized, _ := handler.isAuthorized(req); !isAuthorized {
+		t.Error("Expected users without a session to be able to see a shelter's drop-off slots")
+	}
+}
@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/skip2/go-qrcode"
+)
+
+// DELIVERY_QR_CODE_SIZE is the width and height of delivery QR codes, in pixels.
+const DELIVERY_QR_CODE_SIZE = 256
+
+// deliveryCode is a samaritan's one-time code for dropping an item off. QRCode is a PNG data URL
+// of the intake page's address with the code filled in, so shelter staff can scan it with a phone.
+type deliveryCode struct {
+	Code   string
+	URL    string
+	QRCode string
+}
+
+type deliveryConfirmation struct {
+	Code string
+}
+
+// handleIssueDeliveryCode gives the samaritan who claimed an item a new delivery code, replacing
+// any they were given before.
+func (handler ItemServiceHandler) handleIssueDeliveryCode(w http.ResponseWriter, r *http.Request, itemID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := parseID(itemID)
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return
+	}
+
+	item, err := handler.ItemManager.GetItem(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItem failed", err)
+		return
+	}
+
+	code, err := handler.DeliveryManager.IssueDeliveryCode(r.Context(), item)
+	if err != nil {
+		writeError(w, r, "DeliveryManager.IssueDeliveryCode failed", err)
+		return
+	}
+
+	receiveURL := requestOrigin(r) + itemsEndpoint + "receive?code=" + url.QueryEscape(code)
+	qrCode, err := qrcode.Encode(receiveURL, qrcode.Medium, DELIVERY_QR_CODE_SIZE)
+	if err != nil {
+		writeError(w, r, "Couldn't encode delivery QR code", err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(&deliveryCode{
+		Code:   code,
+		URL:    receiveURL,
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(qrCode),
+	})
+}
+
+// handleReceiveItem shows the intake page, where shelter staff enter or scan a delivery code, and
+// marks the item the code was issued for as received.
+func (handler ItemServiceHandler) handleReceiveItem(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, tplMap map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		t, err := handler.ItemRetriever.RetrieveReceiveTemplate()
+		if err != nil {
+			writeError(w, r, "ItemRetriever.RetrieveReceiveTemplate failed", err)
+			return
+		}
+		tplMap["Code"] = r.URL.Query().Get("code")
+		t.Execute(w, tplMap)
+	case http.MethodPost:
+		confirmation := &deliveryConfirmation{}
+		if err := decodeBody(r, confirmation); err != nil {
+			writeError(w, r, "Couldn't decode request body", err)
+			return
+		}
+
+		previousItem, item, err := handler.DeliveryManager.ConfirmDelivery(r.Context(), userSession.UserID, confirmation.Code)
+		if err != nil {
+			writeError(w, r, "DeliveryManager.ConfirmDelivery failed", err)
+			return
+		}
+
+		if err := handler.EmailSender.DeliverEmail(r.Context(), previousItem, item, userSession); err != nil {
+			logging.FromContext(r.Context()).Error("EmailSender.DeliverEmail failed", logging.Fields{"error": err})
+		}
+		json.NewEncoder(w).Encode(item)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// isDeliveryCodeAuthorized only gives delivery codes to the samaritan who claimed the item.
+func (handler ItemServiceHandler) isDeliveryCodeAuthorized(r *http.Request, pathArray []string, userSession *managers.UserSession) bool {
+	if userSession == nil || userSession.IsExpired(time.Now()) {
+		return false
+	}
+
+	itemID, err := parseID(pathArray[len(pathArray)-2])
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Invalid ID", logging.Fields{"error": err})
+		return false
+	}
+
+	item, err := handler.ItemManager.GetItem(r.Context(), itemID)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("ItemManager.GetItem failed", logging.Fields{"error": err})
+		return false
+	}
+	return isSamaritanAuthorized(userSession, item)
+}
+
+// deliveryRole is the part a user plays in confirming an item's delivery: the samaritan shows a
+// delivery code and the shelter receives it. It's "" for everyone else.
+func deliveryRole(userSession *managers.UserSession, item *managers.Item) string {
+	if userSession == nil || userSession.IsExpired(time.Now()) || !item.IsAwaitingDelivery() {
+		return ""
+	}
+
+	if isSamaritanAuthorized(userSession, item) {
+		return "samaritan"
+	}
+
+	if isShelterAuthorized(userSession, item) {
+		return "shelter"
+	}
+	return ""
+}
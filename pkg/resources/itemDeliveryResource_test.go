@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestCannotReceiveItemsWithNoCookie(t *testing.T) {
+	ish := &ItemServiceHandler{}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		req := httptest.NewRequest(method, "/items/receive", nil)
+		if isAuthorized, _ := ish.isAuthorized(req); isAuthorized {
+			t.Errorf("Expected users without a session to be unauthorized to %v the intake page", method)
+		}
+	}
+}
+
+func TestCannotGetDeliveryCodesWithNoCookie(t *testing.T) {
+	ish := &ItemServiceHandler{}
+
+	req := httptest.NewRequest(http.MethodPost, "/items/1/deliveryCode", nil)
+	if isAuthorized, _ := ish.isAuthorized(req); isAuthorized {
+		t.Error("Expected users without a session to be unauthorized to get delivery codes")
+	}
+}
+
+func TestOnlyTheClaimingSamaritanAndShelterTakePartInDeliveries(t *testing.T) {
+	shelterID := rand.Int63()
+	item := &managers.Item{ShelterID: shelterID, SamaritanID: shelterID + 1, Status: managers.DELIVERED}
+	expected := map[*managers.UserSession]string{
+		{UserType: managers.SHELTER, UserID: shelterID, LoginTime: time.Now().Unix()}:       "shelter",
+		{UserType: managers.SAMARITAN, UserID: shelterID + 1, LoginTime: time.Now().Unix()}: "samaritan",
+		{UserType: managers.SAMARITAN, UserID: shelterID + 2, LoginTime: time.Now().Unix()}: "",
+		{UserType: managers.SAMARITAN, UserID: shelterID + 1}:                               "",
+	}
+	for userSession, role := range expected {
+		if deliveryRole(userSession, item) != role {
+			t.Errorf("Expected %+v to have the role %q, got %q", userSession, role, deliveryRole(userSession, item))
+		}
+	}
+
+	item.Status = managers.RECEIVED
+	if deliveryRole(&managers.UserSession{UserType: managers.SHELTER, UserID: shelterID, LoginTime: time.Now().Unix()}, item) != "" {
+		t.Error("Expected received items to need no delivery confirmation")
+	}
+}
+
+func TestDeliveryLinksUseTheForwardedScheme(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/items/1/deliveryCode", nil)
+	req.Host = "neighbors.example.org"
+	if origin := requestOrigin(req); origin != "http://neighbors.example.org" {
+		t.Errorf("Expected a plain HTTP origin, got %v", origin)
+	}
+
+	req.Header.Set("X-Forwarded-Proto", "https")
+	if origin := requestOrigin(req); origin != "https://neighbors.example.org" {
+		t.Errorf("Expected the proxy's scheme to be used, got %v", origin)
+	}
+}
@@ -1,14 +1,18 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/kwhite17/Neighbors/pkg/database"
 	"github.com/kwhite17/Neighbors/pkg/managers"
 )
 
@@ -125,6 +129,36 @@ func TestCannotEditItemsWithCookieAndNoSession(t *testing.T) {
 	}
 }
 
+func TestCannotCreateItemsWithUnknownBearerToken(t *testing.T) {
+	ish := &ItemServiceHandler{}
+
+	req := httptest.NewRequest(http.MethodPost, "/items/", nil)
+	req.Header.Set("Authorization", "Bearer nbr_unknown")
+	isAuthorized, userSession := ish.isAuthorized(req)
+	if isAuthorized {
+		t.Error("Expected requests with an unknown token to be unable to create items")
+	}
+
+	if userSession != nil {
+		t.Error("Expected no user session to be returned for POST TestWithUnknownBearerToken")
+	}
+}
+
+func TestItemScopesFollowRequestMethod(t *testing.T) {
+	userSession := &managers.UserSession{Scopes: []string{managers.ITEMS_READ}}
+	if !userSession.HasScope(itemScopeForMethod(http.MethodGet)) {
+		t.Error("Expected items:read to allow viewing items")
+	}
+
+	if userSession.HasScope(itemScopeForMethod(http.MethodPut)) {
+		t.Error("Expected items:read not to allow updating items")
+	}
+
+	if !(&managers.UserSession{}).HasScope(itemScopeForMethod(http.MethodPut)) {
+		t.Error("Expected browser sessions to have every scope")
+	}
+}
+
 func TestCanNeverAuthorizeUserWithExpiredCookie(t *testing.T) {
 	userSession := &managers.UserSession{LoginTime: 0}
 	if isUserAuthorized(userSession, nil, http.MethodGet) {
@@ -389,3 +423,67 @@ func TestShouldSendNotificationForUpdateByShelter(t *testing.T) {
 		t.Error("Samaritans should be notified if samaritan changes item.")
 	}
 }
+
+func TestCannotExportItemsWithNoCookie(t *testing.T) {
+	ish := &ItemServiceHandler{}
+
+	req := httptest.NewRequest(http.MethodGet, "/items/export", nil)
+	if isAuthorized, _ := ish.isAuthorized(req); isAuthorized {
+		t.Error("Expected users without a session to be unauthorized to export items")
+	}
+}
+
+func TestCannotImportItemsAsSamaritan(t *testing.T) {
+	ish := &ItemServiceHandler{}
+	ctrl := gomock.NewController(t)
+	ish.UserSessionManager = getMockSessionManager(ctrl, testKey, managers.SAMARITAN, 1, nil)
+	defer ctrl.Finish()
+
+	req := httptest.NewRequest(http.MethodGet, "/items/import", nil)
+	req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+	if isAuthorized, _ := ish.isAuthorized(req); isAuthorized {
+		t.Error("Expected samaritans to be unauthorized to import items")
+	}
+}
+
+func TestUpdatesOnlyChangeTheItemInTheURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	datasource := database.StandardDatasource{Database: database.InitDatabase(database.SQLITE3)}
+	defer datasource.Close()
+	ctx := context.Background()
+
+	itemManager := &managers.ItemManager{Datasource: datasource}
+	ownItemID, _ := itemManager.WriteItem(ctx, &managers.Item{Category: "Blankets", Quantity: 20, Unit: managers.EACH, ShelterID: 1, Status: managers.CREATED})
+	otherItemID, _ := itemManager.WriteItem(ctx, &managers.Item{Category: "Blankets", Quantity: 30, Unit: managers.EACH, ShelterID: 2, Status: managers.CREATED})
+
+	shelter := &managers.UserSession{SessionKey: testKey, UserType: managers.SHELTER, UserID: 1, LoginTime: time.Now().Unix()}
+	sessionManager := NewMockSessionManger(ctrl)
+	sessionManager.EXPECT().GetUserSession(gomock.Any(), gomock.Any()).AnyTimes().Return(shelter, nil)
+	handler := ItemServiceHandler{ItemManager: itemManager, UserSessionManager: sessionManager, EmailSender: &fakeEmailSender{}}
+
+	body := fmt.Sprintf(`{"ID":%d,"ShelterID":1,"Category":"Blankets","Gender":"Any","Size":"Twin","Quantity":5,"Unit":"each","Status":%d,"Version":1}`, otherItemID, managers.CREATED)
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", ownItemID), strings.NewReader(body))
+	req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("Expected the update to succeed, got %v: %s", recorder.Code, recorder.Body.String())
+	}
+
+	if otherItem, _ := itemManager.GetItem(ctx, otherItemID); otherItem.Quantity != 30 || otherItem.ShelterID != 2 {
+		t.Errorf("Expected another shelter's item to be left alone, got %+v", otherItem)
+	}
+
+	if ownItem, _ := itemManager.GetItem(ctx, ownItemID); ownItem.Quantity != 5 || ownItem.ShelterID != 1 {
+		t.Errorf("Expected the item in the URL to be updated, got %+v", ownItem)
+	}
+
+	body = fmt.Sprintf(`{"ShelterID":2,"Category":"Blankets","Gender":"Any","Size":"Twin","Quantity":5,"Unit":"each","Status":%d,"Version":2}`, managers.CREATED)
+	req = httptest.NewRequest(http.MethodPut, fmt.Sprintf("/items/%d", ownItemID), strings.NewReader(body))
+	req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if ownItem, _ := itemManager.GetItem(ctx, ownItemID); ownItem.ShelterID != 1 {
+		t.Errorf("Expected the item to stay with its shelter, got %+v", ownItem)
+	}
+}
@@ -0,0 +1,94 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+// MAX_ITEM_IMPORT_SIZE bounds uploaded spreadsheets, which are read into memory.
+const MAX_ITEM_IMPORT_SIZE = 5 << 20
+
+var errMissingImportFile = &managers.ValidationError{Field: "File", Message: "Please choose a CSV or XLSX file of up to 5 MB"}
+
+// itemImport is the outcome of an upload: every row with its problems, and the IDs of the items
+// posted from the valid rows. Previews post nothing.
+type itemImport struct {
+	Rows    []*managers.ItemImportRow
+	ItemIDs []int64
+}
+
+// handleImportItems shows the import page, and reads uploaded spreadsheets. An upload is only
+// previewed until the shelter confirms it, and then its valid rows are posted together.
+func (handler ItemServiceHandler) handleImportItems(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, tplMap map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		t, err := handler.ItemRetriever.RetrieveImportTemplate()
+		if err != nil {
+			writeError(w, r, "ItemRetriever.RetrieveImportTemplate failed", err)
+			return
+		}
+		t.Execute(w, tplMap)
+	case http.MethodPost:
+		r.Body = http.MaxBytesReader(w, r.Body, MAX_ITEM_IMPORT_SIZE)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			writeError(w, r, "", errMissingImportFile)
+			return
+		}
+		defer file.Close()
+
+		rows, err := readItemImport(file, header)
+		if err != nil {
+			writeError(w, r, "Couldn't read item import", err)
+			return
+		}
+
+		result := &itemImport{Rows: rows, ItemIDs: []int64{}}
+		if r.FormValue("preview") == "" {
+			result.ItemIDs, err = handler.ItemManager.ImportItems(r.Context(), userSession.UserID, managers.ImportableItems(rows))
+			if err != nil {
+				writeError(w, r, "ItemManager.ImportItems failed", err)
+				return
+			}
+		}
+		json.NewEncoder(w).Encode(result)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func readItemImport(file multipart.File, header *multipart.FileHeader) ([]*managers.ItemImportRow, error) {
+	if strings.EqualFold(filepath.Ext(header.Filename), ".xlsx") {
+		return managers.ReadItemsXLSX(file, header.Size)
+	}
+	return managers.ReadItemsCSV(file)
+}
+
+// handleExportItems downloads the shelter's items as CSV, with their status and who claimed them.
+func (handler ItemServiceHandler) handleExportItems(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	items, err := handler.ItemManager.GetItemsForShelter(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItemsForShelter failed", err)
+		return
+	}
+
+	filename := fmt.Sprintf("neighbors-items-%d-%s.csv", userSession.UserID, time.Now().UTC().Format("20060102"))
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+filename+"\"")
+	if err := managers.WriteItemsCSV(w, items); err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't write item export", logging.Fields{"error": err})
+	}
+}
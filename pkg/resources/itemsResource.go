@@ -2,7 +2,6 @@ package resources
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -11,6 +10,7 @@ import (
 
 	"github.com/kwhite17/Neighbors/pkg/email"
 
+	"github.com/kwhite17/Neighbors/pkg/logging"
 	"github.com/kwhite17/Neighbors/pkg/managers"
 	"github.com/kwhite17/Neighbors/pkg/retrievers"
 )
@@ -19,8 +19,12 @@ var itemsEndpoint = "/items/"
 
 type ItemServiceHandler struct {
 	ItemManager        *managers.ItemManager
+	AttachmentManager  *managers.AttachmentManager
+	DeliveryManager    *managers.DeliveryManager
+	IntakeManager      *managers.IntakeManager
 	ItemRetriever      *retrievers.ItemRetriever
 	UserSessionManager managers.SessionManger
+	ApiTokenManager    *managers.ApiTokenManager
 	EmailSender        email.EmailSender
 }
 
@@ -44,54 +48,49 @@ func (handler ItemServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	case "new":
 		t, err := handler.ItemRetriever.RetrieveCreateEntityTemplate()
 		if err != nil {
-			t, _ = retrievers.RetrieveTemplate("home/error")
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			if t != nil {
-				t.Execute(w, nil)
-			}
+			writeError(w, r, "ItemRetriever.RetrieveCreateEntityTemplate failed", err)
 			return
 		}
 		t.Execute(w, tplMap)
 	case "edit":
-		itemID, err := strconv.ParseInt(pathArray[len(pathArray)-2], 10, 64)
+		itemID, err := parseID(pathArray[len(pathArray)-2])
 
 		if err != nil {
-			t, _ := retrievers.RetrieveTemplate("home/error")
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			if t != nil {
-				t.Execute(w, nil)
-			}
+			writeError(w, r, "Invalid ID", err)
 			return
 		}
 
 		item, err := handler.ItemManager.GetItem(r.Context(), itemID)
 
 		if err != nil {
-			t, _ := retrievers.RetrieveTemplate("home/error")
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			if t != nil {
-				t.Execute(w, nil)
-			}
+			writeError(w, r, "ItemManager.GetItem failed", err)
 			return
 		}
 
 		t, err := handler.ItemRetriever.RetrieveEditEntityTemplate()
 
 		if err != nil {
-			t, _ := retrievers.RetrieveTemplate("home/error")
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
-			if t != nil {
-				t.Execute(w, nil)
-			}
+			writeError(w, r, "ItemRetriever.RetrieveEditEntityTemplate failed", err)
+			return
+		}
+
+		if err := handler.addDropOffDetails(r.Context(), item, userSession, tplMap); err != nil {
+			writeError(w, r, "Couldn't look up drop-off slots", err)
 			return
 		}
 
 		tplMap["Item"] = item
 		t.Execute(w, tplMap)
+	case "restore":
+		handler.handleRestoreItem(w, r, pathArray[len(pathArray)-2])
+	case "import":
+		handler.handleImportItems(w, r, userSession, tplMap)
+	case "export":
+		handler.handleExportItems(w, r, userSession)
+	case "receive":
+		handler.handleReceiveItem(w, r, userSession, tplMap)
+	case "deliveryCode":
+		handler.handleIssueDeliveryCode(w, r, pathArray[len(pathArray)-2])
 	default:
 		handler.requestMethodHandler(w, r, userSession)
 	}
@@ -114,15 +113,31 @@ func (handler ItemServiceHandler) requestMethodHandler(w http.ResponseWriter, r
 
 func (handler ItemServiceHandler) handleCreateItem(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
 	item := &managers.Item{}
-	err := json.NewDecoder(r.Body).Decode(item)
+	err := decodeBody(r, item)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "Couldn't decode request body", err)
 		return
 	}
 
 	item.ShelterID = userSession.UserID
-	itemID, _ := handler.ItemManager.WriteItem(r.Context(), item)
+	if item.Status == 0 {
+		item.Status = managers.CREATED
+	}
+
+	if item.Unit == "" {
+		item.Unit = managers.DefaultUnit(item.Category)
+	}
+
+	if err := handler.ItemManager.ValidateForItemCreate(r.Context(), item); err != nil {
+		writeError(w, r, "", err)
+		return
+	}
+
+	itemID, err := handler.ItemManager.WriteItem(r.Context(), item)
+	if err != nil {
+		writeError(w, r, "ItemManager.WriteItem failed", err)
+		return
+	}
 	item.ID = itemID
 
 	json.NewEncoder(w).Encode(item)
@@ -137,49 +152,62 @@ func (handler ItemServiceHandler) handleGetItem(w http.ResponseWriter, r *http.R
 }
 
 func (handler ItemServiceHandler) handleGetSingleItem(w http.ResponseWriter, r *http.Request, itemID string, userSession *managers.UserSession) {
-	id, err := strconv.ParseInt(itemID, 10, 64)
+	id, err := parseID(itemID)
 	if err != nil {
-		t, _ := retrievers.RetrieveTemplate("home/error")
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		if t != nil {
-			t.Execute(w, nil)
-		}
+		writeError(w, r, "Invalid ID", err)
 		return
 	}
 
 	item, err := handler.ItemManager.GetItem(r.Context(), id)
 	if err != nil {
-		t, _ := retrievers.RetrieveTemplate("home/error")
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		if t != nil {
-			t.Execute(w, nil)
-		}
+		writeError(w, r, "ItemManager.GetItem failed", err)
+		return
+	}
+
+	attachments, err := handler.AttachmentManager.GetAttachmentsForItem(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "AttachmentManager.GetAttachmentsForItem failed", err)
+		return
+	}
+
+	deliveryReceipt, err := handler.DeliveryManager.GetDeliveryReceipt(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "DeliveryManager.GetDeliveryReceipt failed", err)
 		return
 	}
 
 	template, err := handler.ItemRetriever.RetrieveSingleEntityTemplate()
 	if err != nil {
-		t, _ := retrievers.RetrieveTemplate("home/error")
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
-		if t != nil {
-			t.Execute(w, nil)
-		}
+		writeError(w, r, "ItemRetriever.RetrieveSingleEntityTemplate failed", err)
 		return
 	}
 
 	responseObject := make(map[string]interface{}, 0)
 	responseObject["Item"] = item
+	responseObject["Attachments"] = visibleAttachments(userSession, item, attachments)
+	responseObject["AttachmentKind"] = attachableKind(userSession, item)
+	responseObject["DeliveryReceipt"] = deliveryReceipt
+	responseObject["DeliveryRole"] = deliveryRole(userSession, item)
 	responseObject["UserSession"] = userSession
+	if err := handler.addDropOffDetails(r.Context(), item, userSession, responseObject); err != nil {
+		writeError(w, r, "Couldn't look up drop-off slots", err)
+		return
+	}
 	template.Execute(w, responseObject)
 }
 
 func (handler ItemServiceHandler) handleGetAllItems(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
-	items, _ := handler.ItemManager.GetItems(r.Context())
+	items, err := handler.ItemManager.GetItems(r.Context())
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItems failed", err)
+		return
+	}
 
-	template, _ := handler.ItemRetriever.RetrieveAllEntitiesTemplate()
+	template, err := handler.ItemRetriever.RetrieveAllEntitiesTemplate()
+	if err != nil {
+		writeError(w, r, "ItemRetriever.RetrieveAllEntitiesTemplate failed", err)
+		return
+	}
 	responseObject := make(map[string]interface{}, 0)
 	responseObject["Items"] = items
 	responseObject["UserSession"] = userSession
@@ -187,38 +215,90 @@ func (handler ItemServiceHandler) handleGetAllItems(w http.ResponseWriter, r *ht
 }
 
 func (handler ItemServiceHandler) handleUpdateItem(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
-	item := &managers.Item{}
-	err := json.NewDecoder(r.Body).Decode(item)
+	update := &itemUpdate{}
+	err := decodeBody(r, update)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "Couldn't decode request body", err)
 		return
 	}
 
-	previousItem, err := handler.ItemManager.GetItem(r.Context(), item.ID)
+	itemID, err := parseID(strings.TrimPrefix(r.URL.Path, itemsEndpoint))
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "Invalid ID", err)
 		return
 	}
 
+	previousItem, err := handler.ItemManager.GetItem(r.Context(), itemID)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItem failed", err)
+		return
+	}
+
+	// The body can't move the update to another item or hand the item to another shelter, since
+	// only the item in the URL was authorized.
+	item := &update.Item
+	item.ID = previousItem.ID
+	item.ShelterID = previousItem.ShelterID
+
 	if item.Status == managers.CREATED {
 		item.SamaritanID = 0
 	} else if userSession.UserType == managers.SAMARITAN {
 		item.SamaritanID = userSession.UserID
 	}
 
-	err = handler.ItemManager.UpdateItem(r.Context(), item)
+	if item.Unit == "" {
+		item.Unit = previousItem.Unit
+	}
+
+	if err := handler.ItemManager.ValidateForItemUpdate(r.Context(), item); err != nil {
+		writeError(w, r, "", err)
+		return
+	}
+
+	dropOffTime, schedule, err := handler.dropOffToBook(r.Context(), previousItem, update, userSession)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "IntakeManager.GetIntakeSchedule failed", err)
+		return
+	}
+
+	var dropOff *managers.DropOff
+	if dropOffTime != nil {
+		dropOff, err = handler.IntakeManager.UpdateItemAndBookDropOff(r.Context(), item, *dropOffTime)
+	} else {
+		err = handler.ItemManager.UpdateItem(r.Context(), item)
+	}
+	if err == managers.ErrItemVersionConflict {
+		currentItem, err := handler.ItemManager.GetItem(r.Context(), item.ID)
+		if err != nil {
+			writeError(w, r, "ItemManager.GetItem failed", err)
+			return
+		}
+		writeVersionConflict(w, r, managers.ErrItemVersionConflict, currentItem)
+		return
+	}
+
+	if err != nil {
+		writeError(w, r, "ItemManager.UpdateItem failed", err)
 		return
 	}
 
 	if shouldSendUpdateNotification(previousItem, item, userSession) {
 		err = handler.EmailSender.DeliverEmail(r.Context(), previousItem, item, userSession)
 		if err != nil {
-			log.Println(err)
+			logging.FromContext(r.Context()).Error("EmailSender.DeliverEmail failed", logging.Fields{"error": err})
+		}
+	}
+
+	if item.Status == managers.CREATED && previousItem.IsAwaitingDelivery() {
+		if err := handler.IntakeManager.CancelDropOff(r.Context(), item.ID); err != nil {
+			logging.FromContext(r.Context()).Error("IntakeManager.CancelDropOff failed", logging.Fields{"error": err})
+		}
+	}
+
+	if dropOff != nil {
+		err = handler.EmailSender.DeliverDropOffEmail(r.Context(), item, dropOff, schedule)
+		if err != nil {
+			logging.FromContext(r.Context()).Error("EmailSender.DeliverDropOffEmail failed", logging.Fields{"error": err})
 		}
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -229,71 +309,126 @@ func (handler ItemServiceHandler) handleDeleteItem(w http.ResponseWriter, r *htt
 
 	_, err := handler.ItemManager.DeleteItem(r.Context(), shelterID)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "ItemManager.DeleteItem failed", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRestoreItem brings back an item the shelter deleted, as long as it hasn't been purged yet.
+func (handler ItemServiceHandler) handleRestoreItem(w http.ResponseWriter, r *http.Request, itemID string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := parseID(itemID)
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return
+	}
+
+	restored, err := handler.ItemManager.RestoreItem(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "ItemManager.RestoreItem failed", err)
 		return
 	}
 
+	if !restored {
+		writeError(w, r, "", &managers.NotFoundError{Entity: "Deleted item", ID: id})
+		return
+	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
 func (handler ItemServiceHandler) isAuthorized(r *http.Request) (bool, *managers.UserSession) {
-	var userSession *managers.UserSession
-	var userSessionError error
-	cookie, cookieError := r.Cookie("NeighborsAuth")
+	userSession, hasCredentials, userSessionError := getRequestSession(r, handler.UserSessionManager, handler.ApiTokenManager)
 	pathArray := strings.Split(strings.TrimPrefix(r.URL.Path, usersEndpoint), "/")
 
-	if cookieError != nil {
-		userSession, userSessionError = nil, cookieError
-	} else {
-		userSession, userSessionError = handler.UserSessionManager.GetUserSession(r.Context(), cookie.Value)
+	if userSession != nil && !userSession.HasScope(itemScopeForMethod(r.Method)) {
+		return false, nil
 	}
 
-	if r.Method == http.MethodGet && pathArray[len(pathArray)-1] != "edit" {
+	if r.Method == http.MethodGet && pathArray[len(pathArray)-1] != "edit" && !isShelterOnlyPage(pathArray[len(pathArray)-1]) {
 		if userSessionError != nil {
-			log.Println(userSessionError)
+			logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		}
 
 		return true, userSession
 	}
 
-	if cookie == nil {
+	if !hasCredentials {
 		if userSessionError != nil {
-			log.Println(userSessionError)
+			logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		}
 		return false, userSession
 	}
 
 	if userSessionError != nil {
-		log.Println(userSessionError)
+		logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		return false, userSession
 	}
 
+	if isShelterOnlyPage(pathArray[len(pathArray)-1]) {
+		return isUserAuthorized(userSession, nil, http.MethodPost), userSession
+	}
+
+	if r.Method == http.MethodPost && pathArray[len(pathArray)-1] == "restore" {
+		return handler.isRestoreAuthorized(r, pathArray, userSession), userSession
+	}
+
+	if r.Method == http.MethodPost && pathArray[len(pathArray)-1] == "deliveryCode" {
+		return handler.isDeliveryCodeAuthorized(r, pathArray, userSession), userSession
+	}
+
 	if r.Method == http.MethodPost {
 		return isUserAuthorized(userSession, nil, http.MethodPost), userSession
 	}
 
 	itemID, err := strconv.ParseInt(pathArray[getElementIDPathIndex(pathArray, r.Method)], 10, strconv.IntSize)
 	if err != nil {
-		log.Println(err)
+		logging.FromContext(r.Context()).Error("Invalid ID", logging.Fields{"error": err})
 		return false, userSession
 	}
 
 	item, err := handler.ItemManager.GetItem(r.Context(), itemID)
-	if err != nil {
-		log.Println(err)
+	if err != nil || item == nil {
+		logging.FromContext(r.Context()).Error("ItemManager.GetItem failed", logging.Fields{"error": err})
 		return false, userSession
 	}
 
 	return isUserAuthorized(userSession, item, r.Method), userSession
 }
 
+// isRestoreAuthorized only lets the shelter that deleted an item restore it.
+func (handler ItemServiceHandler) isRestoreAuthorized(r *http.Request, pathArray []string, userSession *managers.UserSession) bool {
+	itemID, err := strconv.ParseInt(pathArray[len(pathArray)-2], 10, strconv.IntSize)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Invalid ID", logging.Fields{"error": err})
+		return false
+	}
+
+	item, err := handler.ItemManager.GetDeletedItem(r.Context(), itemID)
+	if err != nil || item == nil {
+		logging.FromContext(r.Context()).Error("ItemManager.GetDeletedItem failed", logging.Fields{"error": err})
+		return false
+	}
+	return isUserAuthorized(userSession, item, http.MethodDelete)
+}
+
+// isShelterOnlyPage reports whether an item page works on all of a shelter's items, so only
+// shelters can use it.
+func isShelterOnlyPage(page string) bool {
+	return page == "import" || page == "export" || page == "receive"
+}
+
 func isUserAuthorized(userSession *managers.UserSession, item *managers.Item, httpMethod string) bool {
 	if userSession == nil {
 		return false
 	}
 
-	if time.Now().After(time.Unix(userSession.LoginTime+24*7*3600, 0)) {
+	if userSession.IsExpired(time.Now()) {
 		return false
 	}
 
@@ -324,6 +459,13 @@ func isUserAuthorized(userSession *managers.UserSession, item *managers.Item, ht
 	}
 }
 
+func itemScopeForMethod(httpMethod string) string {
+	if httpMethod == http.MethodGet {
+		return managers.ITEMS_READ
+	}
+	return managers.ITEMS_WRITE
+}
+
 func isShelterAuthorized(userSession *managers.UserSession, item *managers.Item) bool {
 	return item.ShelterID == userSession.UserID && userSession.UserType == managers.SHELTER
 }
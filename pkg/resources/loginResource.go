@@ -1,17 +1,21 @@
 package resources
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
 	"html/template"
-	"log"
+	"math"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/kwhite17/Neighbors/pkg/email"
+	"github.com/kwhite17/Neighbors/pkg/logging"
 	"github.com/kwhite17/Neighbors/pkg/managers"
 	"github.com/kwhite17/Neighbors/pkg/retrievers"
 )
@@ -29,9 +33,22 @@ func GenerateResetPassword(n int) string {
 	return string(b)
 }
 
+// loginResponse tells the login page whether it has to collect a two-factor code before the
+// session is issued, or send the user to set up two-factor authentication afterwards.
+type loginResponse struct {
+	*managers.User
+	TwoFactorRequired           bool   `json:",omitempty"`
+	TwoFactorChallenge          string `json:",omitempty"`
+	TwoFactorEnrollmentRequired bool   `json:",omitempty"`
+	AccountReopened             bool   `json:",omitempty"`
+}
+
 type LoginServiceHandler struct {
 	UserSessionManager managers.SessionManger
 	UserManager        *managers.UserManager
+	TwoFactorManager   *managers.TwoFactorManager
+	AccountManager     *managers.AccountManager
+	LoginLimiter       *managers.LoginLimiter
 	LoginRetriever     *retrievers.LoginRetriever
 	EmailSender        email.EmailSender
 }
@@ -62,8 +79,7 @@ func (lsh LoginServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "LoginRetriever.RetrieveSingleEntityTemplate failed", err)
 
 			return
 		}
@@ -72,69 +88,135 @@ func (lsh LoginServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	case "DELETE":
 		_, err := lsh.UserSessionManager.DeleteUserSession(r.Context(), userSession.SessionKey)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "UserSessionManager.DeleteUserSession failed", err)
+			return
 		}
 
 		w.WriteHeader(http.StatusNoContent)
 	case "POST":
 		loginData := make(map[string]string, 0)
-		err := json.NewDecoder(r.Body).Decode(&loginData)
-
-		shelter, err := lsh.UserManager.GetPasswordForUsername(r.Context(), loginData["Name"])
+		err := decodeBody(r, &loginData)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "Couldn't decode request body", err)
+			return
+		}
+
+		identifier := loginData["Identifier"]
+		if identifier == "" {
+			identifier = loginData["Name"]
+		}
+
+		shelter, err := lsh.UserManager.GetPasswordForLogin(r.Context(), identifier)
+		if err != nil && err != sql.ErrNoRows {
+			writeError(w, r, "UserManager.GetPasswordForLogin failed", err)
 			return
 		}
 
-		err = bcrypt.CompareHashAndPassword([]byte(shelter.Password), []byte(loginData["Password"]))
+		var userID int64
+		if shelter != nil {
+			userID = shelter.ID
+		}
+
+		ipAddress := clientIPAddress(r)
+		retryAfter, err := lsh.LoginLimiter.AllowLogin(r.Context(), ipAddress, userID, identifier)
 		if err != nil {
-			log.Println(err)
+			writeError(w, r, "LoginLimiter.AllowLogin failed", err)
+			return
+		}
+
+		if retryAfter > 0 {
+			writeTooManyRequests(w, retryAfter)
+			return
+		}
+
+		if shelter == nil || bcrypt.CompareHashAndPassword([]byte(shelter.Password), []byte(loginData["Password"])) != nil {
+			lsh.handleFailedLogin(r.Context(), identifier, ipAddress, shelter)
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
 
+		shelter.Password = ""
+		response := &loginResponse{User: shelter}
+		twoFactorSecret, err := lsh.TwoFactorManager.GetTwoFactorSecret(r.Context(), shelter.ID)
+		if err != nil {
+			writeError(w, r, "TwoFactorManager.GetTwoFactorSecret failed", err)
+			return
+		}
+
+		// Failed passwords are only forgotten once the whole login succeeds, so they keep counting
+		// while the second factor is being guessed.
+		if twoFactorSecret.IsEnabled() {
+			response.TwoFactorChallenge, err = lsh.TwoFactorManager.WriteChallenge(r.Context(), shelter.ID, shelter.UserType)
+			if err != nil {
+				writeError(w, r, "TwoFactorManager.WriteChallenge failed", err)
+				return
+			}
+
+			response.TwoFactorRequired = true
+			json.NewEncoder(w).Encode(response)
+			return
+		}
+
+		if err := lsh.LoginLimiter.RecordSuccess(r.Context(), shelter.ID); err != nil {
+			logging.FromContext(r.Context()).Error("LoginLimiter.RecordSuccess failed", logging.Fields{"error": err})
+		}
+
+		response.TwoFactorEnrollmentRequired, err = lsh.TwoFactorManager.IsEnrollmentRequired(r.Context(), shelter.ID, shelter.UserType)
+		if err != nil {
+			writeError(w, r, "TwoFactorManager.IsEnrollmentRequired failed", err)
+			return
+		}
+
+		response.AccountReopened, err = lsh.AccountManager.ReopenAccount(r.Context(), shelter.ID)
+		if err != nil {
+			writeError(w, r, "AccountManager.ReopenAccount failed", err)
+			return
+		}
+
 		sessionKey, err := lsh.UserSessionManager.WriteUserSession(r.Context(), shelter.ID, shelter.UserType)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "UserSessionManager.WriteUserSession failed", err)
 			return
 		}
 
-		cookie := http.Cookie{Name: "NeighborsAuth", Value: sessionKey, HttpOnly: false, MaxAge: 24 * 3600 * 7, Secure: false, Path: "/"}
-		shelter.Password = ""
-		http.SetCookie(w, &cookie)
-		json.NewEncoder(w).Encode(shelter)
+		setSessionCookie(w, sessionKey)
+		json.NewEncoder(w).Encode(response)
 	case "PUT":
 		resetData := make(map[string]string, 0)
-		err := json.NewDecoder(r.Body).Decode(&resetData)
+		err := decodeBody(r, &resetData)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "Couldn't decode request body", err)
 			return
 		}
 
 		emailAddress := resetData["Email"]
+		retryAfter, err := lsh.LoginLimiter.AllowReset(r.Context(), clientIPAddress(r), emailAddress)
+		if err != nil {
+			writeError(w, r, "LoginLimiter.AllowReset failed", err)
+			return
+		}
+
+		if retryAfter > 0 {
+			writeTooManyRequests(w, retryAfter)
+			return
+		}
+
 		unencryptedPassword := GenerateResetPassword(RESET_PASSWORD_LENGTH)
 		err = lsh.UserManager.UpdatePasswordForUser(r.Context(), emailAddress, unencryptedPassword)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "UserManager.UpdatePasswordForUser failed", err)
 			return
 		}
 
 		user, err := lsh.UserManager.GetUserByEmail(r.Context(), emailAddress)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "UserManager.GetUserByEmail failed", err)
 			return
 		}
 
 		err = lsh.EmailSender.DeliverPasswordResetEmail(r.Context(), user, unencryptedPassword)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "EmailSender.DeliverPasswordResetEmail failed", err)
 			return
 		}
 
@@ -144,6 +226,39 @@ func (lsh LoginServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+func (lsh LoginServiceHandler) handleFailedLogin(ctx context.Context, identifier string, ipAddress string, shelter *managers.User) {
+	logging.FromContext(ctx).Warn("Failed login", logging.Fields{"identifier": identifier, "ipAddress": ipAddress})
+	if err := lsh.UserManager.RecordFailedLogin(ctx, identifier); err != nil {
+		logging.FromContext(ctx).Error("UserManager.RecordFailedLogin failed", logging.Fields{"error": err})
+	}
+
+	var userID int64
+	if shelter != nil {
+		userID = shelter.ID
+	}
+
+	lockedUntil, err := lsh.LoginLimiter.RecordFailure(ctx, userID, identifier)
+	if err != nil {
+		logging.FromContext(ctx).Error("LoginLimiter.RecordFailure failed", logging.Fields{"error": err})
+		return
+	}
+
+	if lockedUntil.IsZero() || shelter == nil {
+		return
+	}
+
+	user, err := lsh.UserManager.GetUser(ctx, shelter.ID)
+	if err != nil || user == nil {
+		logging.FromContext(ctx).Error("UserManager.GetUser failed", logging.Fields{"error": err})
+		return
+	}
+
+	err = lsh.EmailSender.DeliverAccountLockoutEmail(ctx, user, lockedUntil)
+	if err != nil {
+		logging.FromContext(ctx).Error("EmailSender.DeliverAccountLockoutEmail failed", logging.Fields{"error": err})
+	}
+}
+
 func (lsh LoginServiceHandler) isAuthorized(r *http.Request) (bool, *managers.UserSession) {
 	var userSession *managers.UserSession
 	var userSessionError error
@@ -153,6 +268,9 @@ func (lsh LoginServiceHandler) isAuthorized(r *http.Request) (bool, *managers.Us
 		userSession, userSessionError = nil, cookieError
 	} else {
 		userSession, userSessionError = lsh.UserSessionManager.GetUserSession(r.Context(), cookie.Value)
+		if userSessionError == nil {
+			recordAuditSession(r, userSession)
+		}
 	}
 
 	if r.Method == http.MethodPut {
@@ -161,26 +279,36 @@ func (lsh LoginServiceHandler) isAuthorized(r *http.Request) (bool, *managers.Us
 
 	if r.Method == http.MethodGet || r.Method == http.MethodPost {
 		if userSessionError != nil {
-			log.Println(userSessionError)
+			logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		}
 		return true, userSession
 	}
 
 	if cookie == nil || r.Method != http.MethodDelete {
 		if userSessionError != nil {
-			log.Println(userSessionError)
+			logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		}
 		return false, nil
 	}
 
 	if userSessionError != nil || userSession == nil {
-		log.Println(userSessionError)
+		logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		return false, nil
 	}
 
-	if time.Now().After(time.Unix(userSession.LoginTime+24*7*3600, 0)) {
+	if userSession.IsExpired(time.Now()) {
 		return false, nil
 	}
 
 	return userSession.SessionKey == cookie.Value, userSession
 }
+
+func writeTooManyRequests(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.WriteHeader(http.StatusTooManyRequests)
+}
+
+func setSessionCookie(w http.ResponseWriter, sessionKey string) {
+	cookie := http.Cookie{Name: "NeighborsAuth", Value: sessionKey, HttpOnly: false, MaxAge: 24 * 3600 * 7, Secure: false, Path: "/"}
+	http.SetCookie(w, &cookie)
+}
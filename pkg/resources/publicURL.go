@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+)
+
+type publicOriginKey struct{}
+
+// LinkToPublicOrigin makes the absolute links the app hands out, in emails, feeds and widgets,
+// point at origin, like https://neighbors.example.org. The Host header can't be used for them,
+// since the client chooses it and some of the responses are cached for everyone. An empty origin
+// leaves links pointing at the request's host, which is only safe in development.
+func LinkToPublicOrigin(origin string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if origin == "" {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), publicOriginKey{}, origin)))
+		})
+	}
+}
+
+// requestOrigin is the scheme and host absolute links should start with: the public origin if one
+// is configured, or else the host the request was made to, as the user's browser sees it.
+func requestOrigin(r *http.Request) string {
+	if origin, ok := r.Context().Value(publicOriginKey{}).(string); ok {
+		return origin
+	}
+
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
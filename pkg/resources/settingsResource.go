@@ -0,0 +1,75 @@
+package resources
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var settingsEndpoint = "/admin/settings/"
+
+// SettingsServiceHandler lets administrators change the site settings.
+type SettingsServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	SettingsManager    *managers.SettingsManager
+}
+
+func (handler SettingsServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userSession := getAdminSession(w, r, handler.UserSessionManager)
+	if userSession == nil {
+		return
+	}
+
+	if strings.Trim(strings.TrimPrefix(r.URL.Path, settingsEndpoint), "/") != "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		handler.handleGetSettings(w, r, userSession)
+	case http.MethodPut:
+		handler.handleSaveSettings(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (handler SettingsServiceHandler) handleGetSettings(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	settings, err := handler.SettingsManager.GetSiteSettings(r.Context())
+	if err != nil {
+		writeError(w, r, "SettingsManager.GetSiteSettings failed", err)
+		return
+	}
+
+	template, err := retrievers.RetrieveSiteSettingsTemplate()
+	if err != nil {
+		writeError(w, r, "RetrieveSiteSettingsTemplate failed", err)
+		return
+	}
+
+	err = template.Execute(w, map[string]interface{}{
+		"UserSession":  userSession,
+		"SiteSettings": settings,
+	})
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+	}
+}
+
+func (handler SettingsServiceHandler) handleSaveSettings(w http.ResponseWriter, r *http.Request) {
+	settings := &managers.SiteSettings{}
+	if err := decodeBody(r, settings); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	if err := handler.SettingsManager.SaveSiteSettings(r.Context(), settings); err != nil {
+		writeError(w, r, "SettingsManager.SaveSiteSettings failed", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
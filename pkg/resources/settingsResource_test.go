@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestAdminsCanRequireShelterTwoFactor(t *testing.T) {
+	datasource := database.StandardDatasource{Database: database.InitDatabase(database.SQLITE3)}
+	defer datasource.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	settingsManager := &managers.SettingsManager{Datasource: datasource}
+
+	for _, userType := range []managers.UserType{managers.SHELTER, managers.ADMIN} {
+		sessionManager := NewMockSessionManger(ctrl)
+		sessionManager.EXPECT().GetUserSession(gomock.Any(), gomock.Any()).AnyTimes().Return(&managers.UserSession{SessionKey: testKey, UserType: userType, UserID: 1, LoginTime: time.Now().Unix()}, nil)
+		handler := SettingsServiceHandler{UserSessionManager: sessionManager, SettingsManager: settingsManager}
+		req := httptest.NewRequest(http.MethodPut, "/admin/settings/", strings.NewReader(`{"RequireShelterTwoFactor":true}`))
+		req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+
+		settings, err := settingsManager.GetSiteSettings(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if userType == managers.SHELTER && (recorder.Code != http.StatusUnauthorized || settings.RequireShelterTwoFactor) {
+			t.Errorf("Expected shelters to be refused changing site settings, got %v", recorder.Code)
+		}
+
+		if userType == managers.ADMIN && (recorder.Code != http.StatusNoContent || !settings.RequireShelterTwoFactor) {
+			t.Errorf("Expected admins to be able to require two-factor, got %v", recorder.Code)
+		}
+	}
+
+	required, err := (&managers.TwoFactorManager{Datasource: datasource}).IsEnrollmentRequired(context.Background(), 1, managers.SHELTER)
+	if err != nil || !required {
+		t.Errorf("Expected the saved setting to require enrollment: %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package resources
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+// RequireTwoFactorEnrollment turns away changes from users who policy requires to set up
+// two-factor authentication and haven't yet, whether they come from a browser session or an API
+// token. Reads are let through, and so are requests without a valid session, which next rejects
+// or serves as it would anyone's.
+func RequireTwoFactorEnrollment(sessionManager managers.SessionManger, tokenManager *managers.ApiTokenManager, twoFactorManager *managers.TwoFactorManager) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			userSession, _, err := getRequestSession(r, sessionManager, tokenManager)
+			if err != nil || userSession == nil || userSession.IsExpired(time.Now()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			enrollmentRequired, err := twoFactorManager.IsEnrollmentRequired(r.Context(), userSession.UserID, userSession.UserType)
+			if err != nil {
+				writeError(w, r, "TwoFactorManager.IsEnrollmentRequired failed", err)
+				return
+			}
+
+			if enrollmentRequired {
+				writeError(w, r, "", &managers.ForbiddenError{Message: "Please set up two-factor authentication from your profile before making changes"})
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestChangesWaitForRequiredTwoFactorEnrollment(t *testing.T) {
+	datasource := database.StandardDatasource{Database: database.InitDatabase(database.SQLITE3)}
+	defer datasource.Close()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	settingsManager := &managers.SettingsManager{Datasource: datasource}
+	if err := settingsManager.SaveSiteSettings(context.Background(), &managers.SiteSettings{RequireShelterTwoFactor: true}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		userType managers.UserType
+		method   string
+		expected int
+	}{
+		{managers.SHELTER, http.MethodPost, http.StatusForbidden},
+		{managers.SHELTER, http.MethodPut, http.StatusForbidden},
+		{managers.SHELTER, http.MethodGet, http.StatusNoContent},
+		{managers.SAMARITAN, http.MethodPost, http.StatusNoContent},
+	}
+
+	for _, test := range tests {
+		sessionManager := NewMockSessionManger(ctrl)
+		sessionManager.EXPECT().GetUserSession(gomock.Any(), gomock.Any()).AnyTimes().Return(&managers.UserSession{SessionKey: testKey, UserType: test.userType, UserID: 1, LoginTime: time.Now().Unix()}, nil)
+		twoFactorManager := &managers.TwoFactorManager{Datasource: datasource}
+		handler := RequireTwoFactorEnrollment(sessionManager, nil, twoFactorManager)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		req := httptest.NewRequest(test.method, "/tokens", nil)
+		req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		if rr.Code != test.expected {
+			t.Errorf("Expected %s by user type %d to get %d, got %d", test.method, test.userType, test.expected, rr.Code)
+		}
+	}
+}
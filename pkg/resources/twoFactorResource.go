@@ -0,0 +1,269 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var twoFactorEndpoint = "/session/2fa/"
+
+type TwoFactorServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	UserManager        *managers.UserManager
+	TwoFactorManager   *managers.TwoFactorManager
+	AccountManager     *managers.AccountManager
+	LoginLimiter       *managers.LoginLimiter
+	LoginRetriever     *retrievers.LoginRetriever
+}
+
+type twoFactorRequest struct {
+	Challenge string
+	Code      string
+}
+
+func (handler TwoFactorServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(twoFactorEndpoint, "/")), "/")
+	if r.Method == http.MethodPost && path == "verify" {
+		handler.handleVerifyChallenge(w, r)
+		return
+	}
+
+	userSession := getCookieSession(r, handler.UserSessionManager)
+	if userSession == nil {
+		tpl, _ := retrievers.RetrieveTemplate("home/unauthorized")
+		w.WriteHeader(http.StatusUnauthorized)
+		if tpl != nil {
+			tpl.Execute(w, nil)
+		}
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && path == "":
+		handler.handleGetTwoFactorPage(w, r, userSession)
+	case r.Method == http.MethodPost && path == "enroll":
+		handler.handleBeginEnrollment(w, r, userSession)
+	case r.Method == http.MethodPost && path == "confirm":
+		handler.handleConfirmEnrollment(w, r, userSession)
+	case r.Method == http.MethodPost && path == "recovery":
+		handler.handleRegenerateRecoveryCodes(w, r, userSession)
+	case r.Method == http.MethodDelete && path == "":
+		handler.handleDisableTwoFactor(w, r, userSession)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (handler TwoFactorServiceHandler) handleGetTwoFactorPage(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	secret, err := handler.TwoFactorManager.GetTwoFactorSecret(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.GetTwoFactorSecret failed", err)
+		return
+	}
+
+	enrollmentRequired, err := handler.TwoFactorManager.IsEnrollmentRequired(r.Context(), userSession.UserID, userSession.UserType)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.IsEnrollmentRequired failed", err)
+		return
+	}
+
+	t, err := handler.LoginRetriever.RetrieveTwoFactorTemplate()
+	if err != nil {
+		writeError(w, r, "LoginRetriever.RetrieveTwoFactorTemplate failed", err)
+		return
+	}
+
+	err = t.Execute(w, map[string]interface{}{
+		"UserSession":        userSession,
+		"TwoFactorEnabled":   secret.IsEnabled(),
+		"EnrollmentRequired": enrollmentRequired,
+	})
+	if err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+	}
+}
+
+func (handler TwoFactorServiceHandler) handleBeginEnrollment(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	user, err := handler.UserManager.GetUser(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "UserManager.GetUser failed", err)
+		return
+	}
+
+	enrollment, err := handler.TwoFactorManager.BeginEnrollment(r.Context(), user.ID, user.Email)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.BeginEnrollment failed", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(enrollment)
+}
+
+func (handler TwoFactorServiceHandler) handleConfirmEnrollment(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	request := &twoFactorRequest{}
+	if err := decodeBody(r, request); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	recoveryCodes, err := handler.TwoFactorManager.ConfirmEnrollment(r.Context(), userSession.UserID, request.Code)
+	switch err {
+	case nil:
+		json.NewEncoder(w).Encode(map[string][]string{"RecoveryCodes": recoveryCodes})
+	case managers.ErrInvalidTwoFactorCode:
+		w.WriteHeader(http.StatusUnauthorized)
+	default:
+		writeError(w, r, "TwoFactorManager.ConfirmEnrollment failed", err)
+	}
+}
+
+func (handler TwoFactorServiceHandler) handleRegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	if !handler.verifyRequestCode(w, r, userSession) {
+		return
+	}
+
+	recoveryCodes, err := handler.TwoFactorManager.RegenerateRecoveryCodes(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.RegenerateRecoveryCodes failed", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string][]string{"RecoveryCodes": recoveryCodes})
+}
+
+func (handler TwoFactorServiceHandler) handleDisableTwoFactor(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	required, err := handler.TwoFactorManager.IsRequired(r.Context(), userSession.UserType)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.IsRequired failed", err)
+		return
+	}
+
+	if required {
+		writeError(w, r, "", &managers.ForbiddenError{Message: "Two-factor authentication is required for shelter accounts"})
+		return
+	}
+
+	if !handler.verifyRequestCode(w, r, userSession) {
+		return
+	}
+
+	err = handler.TwoFactorManager.DisableTwoFactor(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.DisableTwoFactor failed", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleVerifyChallenge is the second login step: it exchanges the challenge issued after a
+// correct password, plus a valid code, for a session.
+func (handler TwoFactorServiceHandler) handleVerifyChallenge(w http.ResponseWriter, r *http.Request) {
+	request := &twoFactorRequest{}
+	if err := decodeBody(r, request); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	challenge, err := handler.TwoFactorManager.GetChallenge(r.Context(), request.Challenge)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.GetChallenge failed", err)
+		return
+	}
+
+	if challenge == nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	retryAfter, err := handler.LoginLimiter.AllowTwoFactor(r.Context(), clientIPAddress(r), challenge.UserID)
+	if err != nil {
+		writeError(w, r, "LoginLimiter.AllowTwoFactor failed", err)
+		return
+	}
+
+	if retryAfter > 0 {
+		writeTooManyRequests(w, retryAfter)
+		return
+	}
+
+	verified, err := handler.TwoFactorManager.VerifyCode(r.Context(), challenge.UserID, request.Code)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.VerifyCode failed", err)
+		return
+	}
+
+	if !verified {
+		handler.handleFailedChallenge(r, challenge)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	handler.TwoFactorManager.DeleteChallenge(r.Context(), challenge.ChallengeKey)
+	handler.recordLoginSuccess(r, challenge.UserID)
+	accountReopened, err := handler.AccountManager.ReopenAccount(r.Context(), challenge.UserID)
+	if err != nil {
+		writeError(w, r, "AccountManager.ReopenAccount failed", err)
+		return
+	}
+
+	sessionKey, err := handler.UserSessionManager.WriteUserSession(r.Context(), challenge.UserID, challenge.UserType)
+	if err != nil {
+		writeError(w, r, "UserSessionManager.WriteUserSession failed", err)
+		return
+	}
+
+	setSessionCookie(w, sessionKey)
+	json.NewEncoder(w).Encode(&loginResponse{User: &managers.User{ID: challenge.UserID, UserType: challenge.UserType}, AccountReopened: accountReopened})
+}
+
+// handleFailedChallenge counts the wrong code against the account, and discards the challenge once
+// the account is locked out, so the password has to be entered again after the lockout.
+func (handler TwoFactorServiceHandler) handleFailedChallenge(r *http.Request, challenge *managers.TwoFactorChallenge) {
+	logging.FromContext(r.Context()).Warn("Failed two-factor code", logging.Fields{"userID": challenge.UserID, "ipAddress": clientIPAddress(r)})
+	lockedUntil, err := handler.LoginLimiter.RecordTwoFactorFailure(r.Context(), challenge.UserID)
+	if err != nil {
+		logging.FromContext(r.Context()).Error("LoginLimiter.RecordTwoFactorFailure failed", logging.Fields{"error": err})
+		return
+	}
+
+	if !lockedUntil.IsZero() {
+		handler.TwoFactorManager.DeleteChallenge(r.Context(), challenge.ChallengeKey)
+	}
+}
+
+// recordLoginSuccess forgets the account's failed codes, and its failed passwords, which the login
+// step leaves until the second factor passes.
+func (handler TwoFactorServiceHandler) recordLoginSuccess(r *http.Request, userID int64) {
+	if err := handler.LoginLimiter.RecordTwoFactorSuccess(r.Context(), userID); err != nil {
+		logging.FromContext(r.Context()).Error("LoginLimiter.RecordTwoFactorSuccess failed", logging.Fields{"error": err})
+	}
+
+	if err := handler.LoginLimiter.RecordSuccess(r.Context(), userID); err != nil {
+		logging.FromContext(r.Context()).Error("LoginLimiter.RecordSuccess failed", logging.Fields{"error": err})
+	}
+}
+
+func (handler TwoFactorServiceHandler) verifyRequestCode(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) bool {
+	request := &twoFactorRequest{}
+	if err := decodeBody(r, request); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return false
+	}
+
+	verified, err := handler.TwoFactorManager.VerifyCode(r.Context(), userSession.UserID, request.Code)
+	if err != nil {
+		writeError(w, r, "TwoFactorManager.VerifyCode failed", err)
+		return false
+	}
+
+	if !verified {
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+	return verified
+}
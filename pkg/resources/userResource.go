@@ -3,13 +3,16 @@ package resources
 import (
 	"database/sql"
 	"encoding/json"
-	"log"
+	"fmt"
 	"net/http"
-	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/kwhite17/Neighbors/pkg/email"
+	"github.com/kwhite17/Neighbors/pkg/logging"
 	"github.com/kwhite17/Neighbors/pkg/managers"
 	"github.com/kwhite17/Neighbors/pkg/retrievers"
 )
@@ -20,7 +23,13 @@ type UserServiceHandler struct {
 	UserManager        *managers.UserManager
 	ItemManager        *managers.ItemManager
 	UserSessionManager managers.SessionManger
+	ApiTokenManager    *managers.ApiTokenManager
+	AccountManager     *managers.AccountManager
+	IntakeManager      *managers.IntakeManager
+	WidgetManager      *managers.WidgetManager
+	WishlistManager    *managers.WishlistManager
 	UserRetriever      *retrievers.ShelterRetriever
+	EmailSender        email.EmailSender
 }
 
 func (handler UserServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -43,39 +52,49 @@ func (handler UserServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	case "new":
 		t, err := handler.UserRetriever.RetrieveCreateEntityTemplate()
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "UserRetriever.RetrieveCreateEntityTemplate failed", err)
 			return
 		}
 		err = t.Execute(w, tplMap)
 
 		if err != nil {
-			log.Println(err)
+			logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
 		}
 	case "edit":
-		userID, err := strconv.ParseInt(pathArray[len(pathArray)-2], 10, 64)
+		userID, err := parseID(pathArray[len(pathArray)-2])
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "Invalid ID", err)
 			return
 		}
 
 		user, err := handler.UserManager.GetUser(r.Context(), userID)
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "UserManager.GetUser failed", err)
 			return
 		}
 
 		t, err := handler.UserRetriever.RetrieveEditEntityTemplate()
 		if err != nil {
-			log.Println(err)
-			w.WriteHeader(http.StatusInternalServerError)
+			writeError(w, r, "UserRetriever.RetrieveEditEntityTemplate failed", err)
 			return
 		}
 
 		tplMap["User"] = user
 		t.Execute(w, tplMap)
+	case "export":
+		userID, err := parseID(pathArray[len(pathArray)-2])
+		if err != nil {
+			writeError(w, r, "Invalid ID", err)
+			return
+		}
+
+		handler.handleExportUser(w, r, userID)
+	case "intake":
+		handler.handleIntakeSchedule(w, r, userSession, tplMap)
+	case "slots":
+		handler.handleGetDropOffSlots(w, r, pathArray[len(pathArray)-2])
+	case "widget":
+		handler.handleWidgetSettings(w, r, userSession, tplMap)
 	default:
 		handler.requestMethodHandler(w, r, userSession)
 	}
@@ -88,63 +107,91 @@ func (handler UserServiceHandler) requestMethodHandler(w http.ResponseWriter, r
 	case http.MethodGet:
 		handler.handleGetUser(w, r, userSession)
 	case http.MethodDelete:
-		handler.handleDeleteUser(w, r)
+		handler.handleCloseAccount(w, r, userSession)
 	case http.MethodPut:
-		handler.handleUpdateUser(w, r)
+		handler.handleUpdateUser(w, r, userSession)
 	default:
 		w.WriteHeader(http.StatusMethodNotAllowed)
 	}
 }
 
+// registration is the sign up form. The form sends UserType as a string, and API clients as a number.
+type registration struct {
+	managers.ContactInformation
+	Username string
+	Password string
+	UserType json.Number
+}
+
 func (handler UserServiceHandler) handleCreateUser(w http.ResponseWriter, r *http.Request) {
-	createData := make(map[string]interface{}, 0)
-	err := json.NewDecoder(r.Body).Decode(&createData)
+	form := &registration{}
+	err := decodeBody(r, form)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	userType, _ := form.UserType.Int64()
+	if managers.UserType(userType) == managers.ADMIN {
+		writeError(w, r, "", errAdminRegistration)
 		return
 	}
 
-	userType, err := strconv.Atoi(reflect.ValueOf(createData["UserType"]).String())
-	user := &managers.User{ContactInformation: handler.buildContactInformation(createData), UserType: managers.UserType(userType)}
-	if !handler.UserManager.ValidateForUserCreate(r.Context(), user) {
-		w.WriteHeader(http.StatusBadRequest)
+	contactInformation := form.ContactInformation
+	user := &managers.User{ContactInformation: &contactInformation, UserType: managers.UserType(userType), Username: form.Username}
+	if err := handler.UserManager.ValidateForUserCreate(r.Context(), user, form.Password); err != nil {
+		writeError(w, r, "", err)
 		return
 	}
 
-	userID, err := handler.UserManager.WriteUser(r.Context(), user, createData["Password"].(string))
+	userID, err := handler.UserManager.WriteUser(r.Context(), user, form.Password)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "UserManager.WriteUser failed", err)
 		return
 	}
 
 	user.ID = userID
 	cookieID, err := handler.UserSessionManager.WriteUserSession(r.Context(), userID, user.UserType)
 	if err != nil {
-		handler.UserManager.DeleteUser(r.Context(), userID)
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		if purgeErr := handler.UserManager.PurgeUser(r.Context(), userID); purgeErr != nil {
+			logging.FromContext(r.Context()).Error("UserManager.PurgeUser failed", logging.Fields{"error": purgeErr, "userID": userID})
+		}
+		writeError(w, r, "UserSessionManager.WriteUserSession failed", err)
 		return
 	}
-	cookie := http.Cookie{Name: "NeighborsAuth", Value: cookieID, HttpOnly: false, MaxAge: 24 * 3600 * 7, Secure: false, Path: "/"}
-	http.SetCookie(w, &cookie)
+	setSessionCookie(w, cookieID)
 	json.NewEncoder(w).Encode(user)
 }
 
-func (handler UserServiceHandler) handleUpdateUser(w http.ResponseWriter, r *http.Request) {
+func (handler UserServiceHandler) handleUpdateUser(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
 	user := &managers.User{}
-	err := json.NewDecoder(r.Body).Decode(user)
+	err := decodeBody(r, user)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	// Users can only change their own profile, whatever ID the body names.
+	user.ID = userSession.UserID
+
+	if err := handler.UserManager.ValidateForUserUpdate(r.Context(), user); err != nil {
+		writeError(w, r, "", err)
 		return
 	}
 
 	err = handler.UserManager.UpdateUser(r.Context(), user)
+	if err == managers.ErrUserVersionConflict {
+		currentUser, err := handler.UserManager.GetUser(r.Context(), user.ID)
+		if err != nil {
+			writeError(w, r, "UserManager.GetUser failed", err)
+			return
+		}
+		writeVersionConflict(w, r, managers.ErrUserVersionConflict, currentUser)
+		return
+	}
+
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "UserManager.UpdateUser failed", err)
 		return
 	}
 
@@ -160,30 +207,26 @@ func (handler UserServiceHandler) handleGetUser(w http.ResponseWriter, r *http.R
 }
 
 func (handler UserServiceHandler) handleGetSingleUser(w http.ResponseWriter, r *http.Request, userID string, userSession *managers.UserSession) {
-	id, err := strconv.ParseInt(userID, 10, 64)
+	id, err := parseID(userID)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "Invalid ID", err)
 		return
 	}
 	user, err := handler.UserManager.GetUser(r.Context(), id)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "UserManager.GetUser failed", err)
 		return
 	}
 
 	items, err := handler.ItemManager.GetItemsForShelter(r.Context(), id)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "ItemManager.GetItemsForShelter failed", err)
 		return
 	}
 
 	template, err := handler.UserRetriever.RetrieveSingleEntityTemplate()
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "UserRetriever.RetrieveSingleEntityTemplate failed", err)
 		return
 	}
 
@@ -191,10 +234,43 @@ func (handler UserServiceHandler) handleGetSingleUser(w http.ResponseWriter, r *
 	responseObject["User"] = user
 	responseObject["Items"] = items
 	responseObject["UserSession"] = userSession
+	if userSession != nil && userSession.UserID == id && userSession.Scopes == nil && handler.ApiTokenManager != nil {
+		apiTokens, err := handler.ApiTokenManager.GetApiTokensForUser(r.Context(), id)
+		if err != nil {
+			writeError(w, r, "ApiTokenManager.GetApiTokensForUser failed", err)
+			return
+		}
+		responseObject["ApiTokens"] = apiTokens
+		responseObject["ApiTokenScopes"] = managers.ApiTokenScopes
+	}
+
+	if userSession != nil && userSession.UserID == id && userSession.UserType == managers.SHELTER {
+		deletedItems, err := handler.ItemManager.GetDeletedItemsForShelter(r.Context(), id)
+		if err != nil {
+			writeError(w, r, "ItemManager.GetDeletedItemsForShelter failed", err)
+			return
+		}
+		responseObject["DeletedItems"] = deletedItems
+	}
+
+	if user.UserType == managers.SHELTER {
+		schedule, err := handler.IntakeManager.GetIntakeSchedule(r.Context(), id)
+		if err != nil {
+			writeError(w, r, "IntakeManager.GetIntakeSchedule failed", err)
+			return
+		}
+		responseObject["IntakeSchedule"] = schedule
+
+		wishlists, err := handler.WishlistManager.GetWishlistsForShelter(r.Context(), id)
+		if err != nil {
+			writeError(w, r, "WishlistManager.GetWishlistsForShelter failed", err)
+			return
+		}
+		responseObject["Wishlists"] = wishlists
+	}
 	err = template.Execute(w, responseObject)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "Couldn't render template", err)
 		return
 	}
 }
@@ -209,64 +285,107 @@ func (handler UserServiceHandler) handleGetAllUsers(w http.ResponseWriter, r *ht
 	template.Execute(w, responseObject)
 }
 
-func (handler UserServiceHandler) handleDeleteUser(w http.ResponseWriter, r *http.Request) {
-	userID := strings.TrimPrefix(r.URL.Path, usersEndpoint)
+// handleCloseAccount starts the closure grace period rather than deleting the user outright, since
+// other users' item history points at the account. The password is required so a borrowed browser
+// or a leaked token can't close it.
+func (handler UserServiceHandler) handleCloseAccount(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	closeData := make(map[string]string, 0)
+	if err := decodeBody(r, &closeData); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	user, err := handler.UserManager.GetUser(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "UserManager.GetUser failed", err)
+		return
+	}
+
+	credentials, err := handler.UserManager.GetPasswordForLogin(r.Context(), user.Email)
+	if err != nil {
+		writeError(w, r, "UserManager.GetPasswordForLogin failed", err)
+		return
+	}
 
-	_, err := handler.UserManager.DeleteUser(r.Context(), userID)
+	if bcrypt.CompareHashAndPassword([]byte(credentials.Password), []byte(closeData["Password"])) != nil {
+		writeError(w, r, "", &managers.ForbiddenError{Message: "That password is incorrect"})
+		return
+	}
+
+	deletionTime, err := handler.AccountManager.CloseAccount(r.Context(), user)
 	if err != nil {
-		log.Println(err)
-		w.WriteHeader(http.StatusInternalServerError)
+		writeError(w, r, "AccountManager.CloseAccount failed", err)
 		return
 	}
 
+	if !deletionTime.IsZero() {
+		err = handler.EmailSender.DeliverAccountClosureEmail(r.Context(), user, deletionTime)
+		if err != nil {
+			logging.FromContext(r.Context()).Error("EmailSender.DeliverAccountClosureEmail failed", logging.Fields{"error": err})
+		}
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: "NeighborsAuth", Value: "", MaxAge: -1, Path: "/"})
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (handler UserServiceHandler) buildContactInformation(createData map[string]interface{}) *managers.ContactInformation {
-	return &managers.ContactInformation{
-		City:       createData["City"].(string),
-		Email:      createData["Email"].(string),
-		Name:       createData["Name"].(string),
-		PostalCode: createData["PostalCode"].(string),
-		State:      createData["State"].(string),
-		Street:     createData["Street"].(string),
+func (handler UserServiceHandler) handleExportUser(w http.ResponseWriter, r *http.Request, userID int64) {
+	export, err := handler.AccountManager.ExportAccount(r.Context(), userID)
+	if err != nil {
+		writeError(w, r, "AccountManager.ExportAccount failed", err)
+		return
+	}
+
+	filename := fmt.Sprintf("neighbors-export-%d-%s", userID, time.Unix(export.ExportedTime, 0).UTC().Format("20060102"))
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", "attachment; filename=\""+filename+".json\"")
+		json.NewEncoder(w).Encode(export)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+filename+".zip\"")
+	if err = export.WriteZip(w); err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't write account export", logging.Fields{"error": err})
 	}
 }
 
 func (handler UserServiceHandler) isAuthorized(r *http.Request) (bool, *managers.UserSession) {
-	var userSession *managers.UserSession
-	var userSessionError error
-	cookie, cookieError := r.Cookie("NeighborsAuth")
+	userSession, hasCredentials, userSessionError := getRequestSession(r, handler.UserSessionManager, handler.ApiTokenManager)
 	pathArray := strings.Split(strings.TrimPrefix(r.URL.Path, usersEndpoint), "/")
 
-	if cookieError != nil {
-		userSession, userSessionError = nil, cookieError
-	} else {
-		userSession, userSessionError = handler.UserSessionManager.GetUserSession(r.Context(), cookie.Value)
+	if userSession != nil && !userSession.HasScope(profileScopeForMethod(r.Method)) {
+		return false, nil
 	}
 
-	if r.Method == http.MethodGet && pathArray[len(pathArray)-1] != "edit" {
+	if r.Method == http.MethodGet && !isOwnerOnlyPage(pathArray[len(pathArray)-1]) {
 		if userSessionError != nil {
-			log.Println(userSessionError)
+			logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		}
 		return true, userSession
 	}
 
-	if cookie == nil && r.Method == http.MethodPost {
+	if !hasCredentials && r.Method == http.MethodPost {
 		if userSessionError != nil {
-			log.Println(userSessionError)
+			logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		}
 		return true, userSession
 	}
 
 	if userSessionError != nil {
-		log.Println(userSessionError)
+		logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": userSessionError})
 		return userSessionError == sql.ErrNoRows && r.Method == http.MethodPost, userSession
 	}
 
-	userID, err := strconv.ParseInt(pathArray[getElementIDPathIndex(pathArray, r.Method)], 10, strconv.IntSize)
+	idIndex := getElementIDPathIndex(pathArray, r.Method)
+	if page := pathArray[len(pathArray)-1]; page == "intake" || page == "widget" {
+		idIndex = len(pathArray) - 2
+	}
+
+	userID, err := strconv.ParseInt(pathArray[idIndex], 10, strconv.IntSize)
 	if err != nil {
-		log.Println(err)
+		logging.FromContext(r.Context()).Error("Invalid ID", logging.Fields{"error": err})
 		return false, userSession
 	}
 
@@ -278,7 +397,7 @@ func (handler UserServiceHandler) isUserAuthorized(userSession *managers.UserSes
 		return false
 	}
 
-	if time.Now().After(time.Unix(userSession.LoginTime+24*7*3600, 0)) {
+	if userSession.IsExpired(time.Now()) {
 		return false
 	}
 
@@ -293,3 +412,15 @@ func (handler UserServiceHandler) isUserAuthorized(userSession *managers.UserSes
 		return false
 	}
 }
+
+// isOwnerOnlyPage reports whether a user page is only for the user themselves, unlike their public profile.
+func isOwnerOnlyPage(page string) bool {
+	return page == "edit" || page == "export" || page == "intake" || page == "widget"
+}
+
+func profileScopeForMethod(httpMethod string) string {
+	if httpMethod == http.MethodGet {
+		return managers.PROFILE_READ
+	}
+	return managers.PROFILE_WRITE
+}
@@ -1,15 +1,19 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 
+	"github.com/kwhite17/Neighbors/pkg/database"
 	"github.com/kwhite17/Neighbors/pkg/managers"
 )
 
@@ -181,3 +185,44 @@ func getMockSessionManager(ctrl *gomock.Controller, sessionKey string, userType
 	}
 	return sessionManager
 }
+
+func TestSheltersCanSaveTheirProfileFromTheEditPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	datasource := database.StandardDatasource{Database: database.InitDatabase(database.SQLITE3)}
+	defer datasource.Close()
+	handler := &UserServiceHandler{UserManager: &managers.UserManager{Datasource: datasource}}
+
+	contactInfo := &managers.ContactInformation{Name: "Harbor Shelter", Email: "harbor@example.org", Street: "1 Main St", City: "Boston", State: "MA", PostalCode: "02110"}
+	shelterID, err := handler.UserManager.WriteUser(context.Background(), &managers.User{UserType: managers.SHELTER, Username: "harbor", ContactInformation: contactInfo}, "password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessionManager := NewMockSessionManger(ctrl)
+	sessionManager.EXPECT().GetUserSession(gomock.Any(), gomock.Any()).AnyTimes().Return(&managers.UserSession{SessionKey: testKey, UserType: managers.SHELTER, UserID: shelterID, LoginTime: time.Now().Unix()}, nil)
+	handler.UserSessionManager = sessionManager
+
+	// This is everything users/edit.html sends.
+	putProfile := func(street string) int {
+		body := fmt.Sprintf(`{"Name":"Harbor House","Username":"harbor","Email":"harbor@example.org","Street":%q,"City":"Boston","State":"MA","PostalCode":"02110","Country":"","ID":%d,"Version":%d}`, street, shelterID, 1)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/shelters/%d", shelterID), strings.NewReader(body))
+		req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+
+	if code := putProfile(""); code != http.StatusBadRequest {
+		t.Errorf("Expected a shelter without a street to be rejected, got %v", code)
+	}
+
+	if code := putProfile("2 Harbor Way"); code != http.StatusNoContent {
+		t.Fatalf("Expected the profile to be saved, got %v", code)
+	}
+
+	shelter, err := handler.UserManager.GetUser(context.Background(), shelterID)
+	if err != nil || shelter.Name != "Harbor House" || shelter.Street != "2 Harbor Way" || shelter.UserType != managers.SHELTER {
+		t.Errorf("Expected the saved profile, got %+v: %v", shelter, err)
+	}
+}
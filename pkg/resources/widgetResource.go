@@ -0,0 +1,321 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var widgetEndpoint = "/widget/"
+
+// WIDGET_SIZE is how many of the newest open items the widget shows.
+const WIDGET_SIZE = 20
+
+// WIDGET_MAX_AGE is how long, in seconds, browsers and caches can keep the widget.
+const WIDGET_MAX_AGE = 300
+
+var errOriginNotAllowed = &managers.ForbiddenError{Message: "This shelter hasn't allowed its widget on this site"}
+
+// WidgetServiceHandler serves the read-only widget shelters embed in their own sites to show the
+// items they need, as a page for a frame, a script that renders in place, and the JSON both are
+// built from. Shelters choose which sites can embed it and how often each visitor can load it.
+type WidgetServiceHandler struct {
+	UserManager   *managers.UserManager
+	ItemManager   *managers.ItemManager
+	WidgetManager *managers.WidgetManager
+}
+
+type widgetPayload struct {
+	Shelter *widgetShelter
+	Items   []*widgetItem
+}
+
+type widgetShelter struct {
+	ID    int64
+	Name  string
+	City  string
+	State string
+	URL   string
+}
+
+// widgetItem is an open item, with the link samaritans follow to help with it.
+type widgetItem struct {
+	ID       int64
+	Category string
+	Gender   string
+	Size     string
+	Quantity string
+	HelpURL  string
+}
+
+func (handler WidgetServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(widgetEndpoint, "/")), "/")
+	pathArray := strings.Split(path, "/")
+	if len(pathArray) != 2 || pathArray[0] != "shelters" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	name := pathArray[1]
+	extension := ""
+	if dot := strings.LastIndex(name, "."); dot >= 0 {
+		name, extension = name[:dot], name[dot+1:]
+	}
+
+	switch {
+	case r.Method == http.MethodOptions && extension == "json":
+		handler.handlePreflight(w, r, name)
+	case r.Method != http.MethodGet:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	case extension == "":
+		handler.handleGetWidgetPage(w, r, name)
+	case extension == "json":
+		handler.handleGetWidgetItems(w, r, name)
+	case extension == "js":
+		handler.handleGetWidgetScript(w, r, name)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// handleGetWidgetPage serves the widget as a page, for shelters to embed in a frame.
+func (handler WidgetServiceHandler) handleGetWidgetPage(w http.ResponseWriter, r *http.Request, shelterID string) {
+	settings, ok := handler.allowRequest(w, r, shelterID)
+	if !ok {
+		return
+	}
+
+	payload, err := handler.buildPayload(r, settings.ShelterID)
+	if err != nil {
+		writeError(w, r, "Couldn't build widget", err)
+		return
+	}
+
+	t, err := retrievers.RetrieveWidgetTemplate()
+	if err != nil {
+		writeError(w, r, "retrievers.RetrieveWidgetTemplate failed", err)
+		return
+	}
+
+	frameAncestors := "*"
+	if len(settings.AllowedOrigins) > 0 {
+		frameAncestors = "'self' " + strings.Join(settings.AllowedOrigins, " ")
+	}
+	w.Header().Set("Content-Security-Policy", "frame-ancestors "+frameAncestors)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	setWidgetCacheHeaders(w)
+	if err := t.Execute(w, payload); err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+	}
+}
+
+// handleGetWidgetItems serves the shelter's open items to the widget script, and to anyone else
+// building their own.
+func (handler WidgetServiceHandler) handleGetWidgetItems(w http.ResponseWriter, r *http.Request, shelterID string) {
+	settings, ok := handler.allowRequest(w, r, shelterID)
+	if !ok {
+		return
+	}
+
+	payload, err := handler.buildPayload(r, settings.ShelterID)
+	if err != nil {
+		writeError(w, r, "Couldn't build widget", err)
+		return
+	}
+
+	setCORSHeaders(w, r, settings)
+	setWidgetCacheHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(payload)
+}
+
+// handleGetWidgetScript serves the script shelters embed. It's the same for every shelter: it reads
+// the items from the JSON next to wherever it was loaded from.
+func (handler WidgetServiceHandler) handleGetWidgetScript(w http.ResponseWriter, r *http.Request, shelterID string) {
+	if _, ok := handler.allowRequest(w, r, shelterID); !ok {
+		return
+	}
+
+	script, err := retrievers.RetrieveWidgetScript()
+	if err != nil {
+		writeError(w, r, "retrievers.RetrieveWidgetScript failed", err)
+		return
+	}
+
+	setWidgetCacheHeaders(w)
+	w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+	w.Write(script)
+}
+
+func (handler WidgetServiceHandler) handlePreflight(w http.ResponseWriter, r *http.Request, shelterID string) {
+	settings, ok := handler.getSettings(w, r, shelterID)
+	if !ok {
+		return
+	}
+
+	if !settings.AllowsOrigin(r.Header.Get("Origin")) {
+		writeError(w, r, "", errOriginNotAllowed)
+		return
+	}
+
+	setCORSHeaders(w, r, settings)
+	w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+	w.Header().Set("Access-Control-Max-Age", fmt.Sprint(WIDGET_MAX_AGE))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// allowRequest checks the page asking for the widget is on a site the shelter allows, and that the
+// visitor hasn't loaded it too often. Browsers only say which site is asking when a script fetches
+// the JSON, so pages in frames are held to the allowed sites by their Content-Security-Policy instead.
+func (handler WidgetServiceHandler) allowRequest(w http.ResponseWriter, r *http.Request, shelterID string) (*managers.WidgetSettings, bool) {
+	settings, ok := handler.getSettings(w, r, shelterID)
+	if !ok {
+		return nil, false
+	}
+
+	if origin := r.Header.Get("Origin"); origin != "" && !settings.AllowsOrigin(origin) {
+		writeError(w, r, "", errOriginNotAllowed)
+		return nil, false
+	}
+
+	retryAfter, err := handler.WidgetManager.AllowWidgetRequest(r.Context(), settings, clientIPAddress(r))
+	if err != nil {
+		writeError(w, r, "WidgetManager.AllowWidgetRequest failed", err)
+		return nil, false
+	}
+
+	if retryAfter > 0 {
+		setCORSHeaders(w, r, settings)
+		writeTooManyRequests(w, retryAfter)
+		return nil, false
+	}
+	return settings, true
+}
+
+func (handler WidgetServiceHandler) getSettings(w http.ResponseWriter, r *http.Request, shelterID string) (*managers.WidgetSettings, bool) {
+	id, err := parseID(shelterID)
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return nil, false
+	}
+
+	shelter, err := handler.UserManager.GetUser(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "UserManager.GetUser failed", err)
+		return nil, false
+	}
+
+	if shelter.UserType != managers.SHELTER {
+		writeError(w, r, "", &managers.NotFoundError{Entity: "Shelter", ID: id})
+		return nil, false
+	}
+
+	settings, err := handler.WidgetManager.GetWidgetSettings(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "WidgetManager.GetWidgetSettings failed", err)
+		return nil, false
+	}
+	return settings, true
+}
+
+func (handler WidgetServiceHandler) buildPayload(r *http.Request, shelterID int64) (*widgetPayload, error) {
+	shelter, err := handler.UserManager.GetUser(r.Context(), shelterID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := handler.ItemManager.GetOpenItems(r.Context(), shelterID, WIDGET_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := requestOrigin(r)
+	payload := &widgetPayload{
+		Shelter: &widgetShelter{ID: shelter.ID, Name: shelter.Name, City: shelter.City, State: shelter.State, URL: fmt.Sprintf("%s/shelters/%d", origin, shelter.ID)},
+		Items:   make([]*widgetItem, len(items)),
+	}
+	for i, item := range items {
+		payload.Items[i] = &widgetItem{
+			ID:       item.ID,
+			Category: item.Category,
+			Gender:   item.Gender,
+			Size:     item.Size,
+			Quantity: item.FormattedQuantity(),
+			HelpURL:  fmt.Sprintf("%s/items/%d", origin, item.ID),
+		}
+	}
+	return payload, nil
+}
+
+// setCORSHeaders lets pages on the shelter's allowed sites read the widget's JSON. Responses differ
+// by origin when the shelter has listed sites, so caches have to keep them apart.
+func setCORSHeaders(w http.ResponseWriter, r *http.Request, settings *managers.WidgetSettings) {
+	w.Header().Add("Vary", "Origin")
+	if len(settings.AllowedOrigins) == 0 {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		return
+	}
+
+	if origin := r.Header.Get("Origin"); origin != "" && settings.AllowsOrigin(origin) {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+}
+
+func setWidgetCacheHeaders(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", WIDGET_MAX_AGE))
+}
+
+// handleWidgetSettings shows a shelter the page for embedding its widget, and saves which sites can
+// embed it and how often.
+func (handler UserServiceHandler) handleWidgetSettings(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, tplMap map[string]interface{}) {
+	if userSession.UserType != managers.SHELTER {
+		writeError(w, r, "", &managers.ForbiddenError{Message: "Only shelters have a widget"})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		settings, err := handler.WidgetManager.GetWidgetSettings(r.Context(), userSession.UserID)
+		if err != nil {
+			writeError(w, r, "WidgetManager.GetWidgetSettings failed", err)
+			return
+		}
+
+		t, err := handler.UserRetriever.RetrieveWidgetSettingsTemplate()
+		if err != nil {
+			writeError(w, r, "UserRetriever.RetrieveWidgetSettingsTemplate failed", err)
+			return
+		}
+
+		tplMap["WidgetSettings"] = settings
+		tplMap["WidgetURL"] = fmt.Sprintf("%s%sshelters/%d", requestOrigin(r), widgetEndpoint, userSession.UserID)
+		if err := t.Execute(w, tplMap); err != nil {
+			logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+		}
+	case http.MethodPut:
+		settings := &managers.WidgetSettings{}
+		if err := decodeBody(r, settings); err != nil {
+			writeError(w, r, "Couldn't decode request body", err)
+			return
+		}
+
+		settings.ShelterID = userSession.UserID
+		if err := handler.WidgetManager.ValidateForWidgetSettingsUpdate(r.Context(), settings); err != nil {
+			writeError(w, r, "", err)
+			return
+		}
+
+		if err := handler.WidgetManager.SaveWidgetSettings(r.Context(), settings); err != nil {
+			writeError(w, r, "WidgetManager.SaveWidgetSettings failed", err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
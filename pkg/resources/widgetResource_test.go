@@ -0,0 +1,115 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func initWidgetServiceHandler(t *testing.T) (WidgetServiceHandler, int64, func()) {
+	feedHandler, shelterID, _, cleanup := initFeedServiceHandler(t)
+	writeFeedItem(t, feedHandler, shelterID, 0)
+	handler := WidgetServiceHandler{
+		UserManager:   feedHandler.UserManager,
+		ItemManager:   feedHandler.ItemManager,
+		WidgetManager: &managers.WidgetManager{Datasource: feedHandler.ItemManager.Datasource, Store: &managers.MemoryRateLimitStore{}},
+	}
+	return handler, shelterID, cleanup
+}
+
+func getWidget(handler WidgetServiceHandler, path string, origin string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestAnySiteCanEmbedAWidgetByDefault(t *testing.T) {
+	handler, shelterID, cleanup := initWidgetServiceHandler(t)
+	defer cleanup()
+
+	recorder := getWidget(handler, fmt.Sprintf("/widget/shelters/%d.json", shelterID), "https://anywhere.example")
+	if recorder.Code != http.StatusOK || recorder.Header().Get("Access-Control-Allow-Origin") != "*" || !strings.Contains(recorder.Body.String(), `"HelpURL":"http://example.com/items/`) {
+		t.Errorf("Expected the open items to be readable from any site, got %v %v: %s", recorder.Code, recorder.Header(), recorder.Body)
+	}
+
+	recorder = getWidget(handler, fmt.Sprintf("/widget/shelters/%d", shelterID), "")
+	if recorder.Code != http.StatusOK || recorder.Header().Get("Content-Security-Policy") != "frame-ancestors *" || !strings.Contains(recorder.Body.String(), "Help with this") {
+		t.Errorf("Expected a page any site can frame, got %v %v: %s", recorder.Code, recorder.Header(), recorder.Body)
+	}
+
+	if recorder := getWidget(handler, fmt.Sprintf("/widget/shelters/%d.js", shelterID), ""); recorder.Code != http.StatusOK || !strings.HasPrefix(recorder.Header().Get("Cache-Control"), "public") {
+		t.Errorf("Expected a cacheable script, got %v %v", recorder.Code, recorder.Header())
+	}
+}
+
+func TestSheltersCanLimitWhichSitesEmbedTheirWidget(t *testing.T) {
+	handler, shelterID, cleanup := initWidgetServiceHandler(t)
+	defer cleanup()
+	settings := &managers.WidgetSettings{ShelterID: shelterID, AllowedOrigins: []string{"https://shelter.example"}, RequestsPerMinute: 2}
+	if err := handler.WidgetManager.SaveWidgetSettings(context.Background(), settings); err != nil {
+		t.Fatal(err)
+	}
+
+	path := fmt.Sprintf("/widget/shelters/%d.json", shelterID)
+	if recorder := getWidget(handler, path, "https://elsewhere.example"); recorder.Code != http.StatusForbidden || recorder.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf("Expected other sites to be refused, got %v %v", recorder.Code, recorder.Header())
+	}
+
+	if recorder := getWidget(handler, path, "https://shelter.example"); recorder.Code != http.StatusOK || recorder.Header().Get("Access-Control-Allow-Origin") != "https://shelter.example" {
+		t.Errorf("Expected the shelter's site to be allowed, got %v %v", recorder.Code, recorder.Header())
+	}
+
+	if recorder := getWidget(handler, fmt.Sprintf("/widget/shelters/%d", shelterID), ""); recorder.Header().Get("Content-Security-Policy") != "frame-ancestors 'self' https://shelter.example" {
+		t.Errorf("Expected only the shelter's site to be able to frame the widget, got %v", recorder.Header())
+	}
+
+	if recorder := getWidget(handler, path, "https://shelter.example"); recorder.Code != http.StatusTooManyRequests || recorder.Header().Get("Retry-After") == "" {
+		t.Errorf("Expected visitors to be limited to %d loads a minute, got %v", settings.RequestsPerMinute, recorder.Code)
+	}
+}
+
+func TestWidgetsAreOnlyForShelters(t *testing.T) {
+	handler, _, cleanup := initWidgetServiceHandler(t)
+	defer cleanup()
+
+	for _, path := range []string{"/widget/shelters/2.json", "/widget/shelters/99", "/widget/shelters/1.xml", "/widget/items/1"} {
+		if recorder := getWidget(handler, path, ""); recorder.Code != http.StatusNotFound {
+			t.Errorf("Expected %v not to be found, got %v", path, recorder.Code)
+		}
+	}
+}
+
+func TestCannotChangeWidgetSettingsWithNoCookie(t *testing.T) {
+	handler := &UserServiceHandler{}
+
+	for _, method := range []string{http.MethodGet, http.MethodPut} {
+		req := httptest.NewRequest(method, "/shelters/1/widget", nil)
+		if isAuthorized, _ := handler.isAuthorized(req); isAuthorized {
+			t.Errorf("Expected users without a session to be unauthorized to %v widget settings", method)
+		}
+	}
+}
+
+func TestWidgetLinksIgnoreTheHostHeader(t *testing.T) {
+	handler, shelterID, cleanup := initWidgetServiceHandler(t)
+	defer cleanup()
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/widget/shelters/%d.json", shelterID), nil)
+	req.Host = "attacker.example"
+	recorder := httptest.NewRecorder()
+	LinkToPublicOrigin("https://neighbors.example.org")(handler).ServeHTTP(recorder, req)
+
+	body := recorder.Body.String()
+	if recorder.Code != http.StatusOK || strings.Contains(body, "attacker.example") || !strings.Contains(body, `"HelpURL":"https://neighbors.example.org/items/`) {
+		t.Errorf("Expected links to the public URL, got %v: %s", recorder.Code, body)
+	}
+}
@@ -0,0 +1,266 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/logging"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+var wishlistsEndpoint = "/wishlists/"
+
+var errNoWishlists = &managers.ForbiddenError{Message: "Only shelters have wishlists"}
+
+// WishlistServiceHandler lets shelters group some of the items they need into a wishlist for a
+// donation drive, and serves each wishlist's page, which anyone with its link can see. The page
+// shows how close the drive is to its goals as the items are claimed and received.
+type WishlistServiceHandler struct {
+	UserSessionManager managers.SessionManger
+	ApiTokenManager    *managers.ApiTokenManager
+	UserManager        *managers.UserManager
+	ItemManager        *managers.ItemManager
+	WishlistManager    *managers.WishlistManager
+	WishlistRetriever  *retrievers.WishlistRetriever
+}
+
+func (handler WishlistServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userSession := handler.getSession(r)
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, strings.TrimSuffix(wishlistsEndpoint, "/")), "/")
+	pathArray := strings.Split(path, "/")
+	switch {
+	case r.Method == http.MethodGet && path == "new":
+		handler.handleEditWishlistPage(w, r, userSession, "")
+	case r.Method == http.MethodGet && len(pathArray) == 2 && pathArray[1] == "edit":
+		handler.handleEditWishlistPage(w, r, userSession, pathArray[0])
+	case r.Method == http.MethodGet && len(pathArray) == 1 && path != "":
+		handler.handleGetWishlist(w, r, userSession, pathArray[0])
+	case r.Method == http.MethodPost && path == "":
+		handler.handleCreateWishlist(w, r, userSession)
+	case r.Method == http.MethodPut && len(pathArray) == 1 && path != "":
+		handler.handleUpdateWishlist(w, r, userSession, pathArray[0])
+	case r.Method == http.MethodDelete && len(pathArray) == 1 && path != "":
+		handler.handleDeleteWishlist(w, r, userSession, pathArray[0])
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// getSession returns the request's unexpired session, if it has the item scope the method needs.
+func (handler WishlistServiceHandler) getSession(r *http.Request) *managers.UserSession {
+	userSession, hasCredentials, err := getRequestSession(r, handler.UserSessionManager, handler.ApiTokenManager)
+	if hasCredentials && err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't read session", logging.Fields{"error": err})
+		return nil
+	}
+
+	if userSession == nil || userSession.IsExpired(time.Now()) || !userSession.HasScope(itemScopeForMethod(r.Method)) {
+		return nil
+	}
+	return userSession
+}
+
+// handleGetWishlist serves the wishlist's share page, with its progress toward each goal and links
+// for samaritans to help with the items still open.
+func (handler WishlistServiceHandler) handleGetWishlist(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, wishlistID string) {
+	wishlist, ok := handler.getWishlist(w, r, wishlistID)
+	if !ok {
+		return
+	}
+
+	shelter, err := handler.UserManager.GetUser(r.Context(), wishlist.ShelterID)
+	if err != nil {
+		writeError(w, r, "UserManager.GetUser failed", err)
+		return
+	}
+
+	items, err := handler.ItemManager.GetItemsForWishlist(r.Context(), wishlist.ID)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItemsForWishlist failed", err)
+		return
+	}
+
+	t, err := handler.WishlistRetriever.RetrieveSingleEntityTemplate()
+	if err != nil {
+		writeError(w, r, "WishlistRetriever.RetrieveSingleEntityTemplate failed", err)
+		return
+	}
+
+	tplMap := map[string]interface{}{
+		"UserSession": userSession,
+		"Wishlist":    wishlist,
+		"Shelter":     shelter,
+		"Items":       items,
+		"Goals":       managers.BuildWishlistGoals(items),
+		"ShareURL":    fmt.Sprintf("%s%s%d", requestOrigin(r), wishlistsEndpoint, wishlist.ID),
+		"IsOwner":     userSession != nil && userSession.UserID == wishlist.ShelterID,
+	}
+	if err := t.Execute(w, tplMap); err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+	}
+}
+
+// handleEditWishlistPage shows the signed-in shelter the form for a new wishlist, or for changing
+// one of theirs, with their items to choose from.
+func (handler WishlistServiceHandler) handleEditWishlistPage(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, wishlistID string) {
+	if !requireShelter(w, r, userSession) {
+		return
+	}
+
+	wishlist := &managers.Wishlist{ShelterID: userSession.UserID, ItemIDs: make([]int64, 0)}
+	if wishlistID != "" {
+		var ok bool
+		if wishlist, ok = handler.getOwnWishlist(w, r, userSession, wishlistID); !ok {
+			return
+		}
+	}
+
+	items, err := handler.ItemManager.GetItemsForShelter(r.Context(), userSession.UserID)
+	if err != nil {
+		writeError(w, r, "ItemManager.GetItemsForShelter failed", err)
+		return
+	}
+
+	t, err := handler.WishlistRetriever.RetrieveEditEntityTemplate()
+	if err != nil {
+		writeError(w, r, "WishlistRetriever.RetrieveEditEntityTemplate failed", err)
+		return
+	}
+
+	selected := make(map[int64]bool, len(wishlist.ItemIDs))
+	for _, itemID := range wishlist.ItemIDs {
+		selected[itemID] = true
+	}
+
+	tplMap := map[string]interface{}{
+		"UserSession":   userSession,
+		"Wishlist":      wishlist,
+		"Items":         items,
+		"SelectedItems": selected,
+	}
+	if err := t.Execute(w, tplMap); err != nil {
+		logging.FromContext(r.Context()).Error("Couldn't render template", logging.Fields{"error": err})
+	}
+}
+
+func (handler WishlistServiceHandler) handleCreateWishlist(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) {
+	if !requireShelter(w, r, userSession) {
+		return
+	}
+
+	wishlist := &managers.Wishlist{}
+	if err := decodeBody(r, wishlist); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	wishlist.ShelterID = userSession.UserID
+	if err := handler.WishlistManager.ValidateForWishlistUpdate(r.Context(), wishlist); err != nil {
+		writeError(w, r, "", err)
+		return
+	}
+
+	if _, err := handler.WishlistManager.WriteWishlist(r.Context(), wishlist); err != nil {
+		writeError(w, r, "WishlistManager.WriteWishlist failed", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("%s%d", wishlistsEndpoint, wishlist.ID))
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(wishlist)
+}
+
+func (handler WishlistServiceHandler) handleUpdateWishlist(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, wishlistID string) {
+	if !requireShelter(w, r, userSession) {
+		return
+	}
+
+	previous, ok := handler.getOwnWishlist(w, r, userSession, wishlistID)
+	if !ok {
+		return
+	}
+
+	wishlist := &managers.Wishlist{}
+	if err := decodeBody(r, wishlist); err != nil {
+		writeError(w, r, "Couldn't decode request body", err)
+		return
+	}
+
+	wishlist.ID = previous.ID
+	wishlist.ShelterID = userSession.UserID
+	if err := handler.WishlistManager.ValidateForWishlistUpdate(r.Context(), wishlist); err != nil {
+		writeError(w, r, "", err)
+		return
+	}
+
+	if err := handler.WishlistManager.UpdateWishlist(r.Context(), wishlist); err != nil {
+		writeError(w, r, "WishlistManager.UpdateWishlist failed", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler WishlistServiceHandler) handleDeleteWishlist(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, wishlistID string) {
+	if !requireShelter(w, r, userSession) {
+		return
+	}
+
+	wishlist, ok := handler.getOwnWishlist(w, r, userSession, wishlistID)
+	if !ok {
+		return
+	}
+
+	if _, err := handler.WishlistManager.DeleteWishlist(r.Context(), userSession.UserID, wishlist.ID); err != nil {
+		writeError(w, r, "WishlistManager.DeleteWishlist failed", err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (handler WishlistServiceHandler) getWishlist(w http.ResponseWriter, r *http.Request, wishlistID string) (*managers.Wishlist, bool) {
+	id, err := parseID(wishlistID)
+	if err != nil {
+		writeError(w, r, "Invalid ID", err)
+		return nil, false
+	}
+
+	wishlist, err := handler.WishlistManager.GetWishlist(r.Context(), id)
+	if err != nil {
+		writeError(w, r, "WishlistManager.GetWishlist failed", err)
+		return nil, false
+	}
+	return wishlist, true
+}
+
+// getOwnWishlist looks up one of the signed-in shelter's wishlists. Other shelters' wishlists are
+// public, but they can't change them.
+func (handler WishlistServiceHandler) getOwnWishlist(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession, wishlistID string) (*managers.Wishlist, bool) {
+	wishlist, ok := handler.getWishlist(w, r, wishlistID)
+	if !ok {
+		return nil, false
+	}
+
+	if wishlist.ShelterID != userSession.UserID {
+		writeError(w, r, "", &managers.ForbiddenError{Message: "You can only change your own wishlists"})
+		return nil, false
+	}
+	return wishlist, true
+}
+
+func requireShelter(w http.ResponseWriter, r *http.Request, userSession *managers.UserSession) bool {
+	if userSession == nil {
+		writeUnauthorized(w)
+		return false
+	}
+
+	if userSession.UserType != managers.SHELTER {
+		writeError(w, r, "", errNoWishlists)
+		return false
+	}
+	return true
+}
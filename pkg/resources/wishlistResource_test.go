@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kwhite17/Neighbors/pkg/database"
+	"github.com/kwhite17/Neighbors/pkg/managers"
+	"github.com/kwhite17/Neighbors/pkg/retrievers"
+)
+
+func initWishlistServiceHandler(t *testing.T) (WishlistServiceHandler, int64, func()) {
+	datasource := database.StandardDatasource{Database: database.InitDatabase(database.SQLITE3)}
+	handler := WishlistServiceHandler{
+		UserManager:       &managers.UserManager{Datasource: datasource},
+		ItemManager:       &managers.ItemManager{Datasource: datasource},
+		WishlistManager:   &managers.WishlistManager{Datasource: datasource},
+		WishlistRetriever: &retrievers.WishlistRetriever{},
+	}
+
+	contactInfo := &managers.ContactInformation{Name: "Harbor Shelter", Email: "harbor@example.org", Street: "1 Main St", City: "Boston", State: "MA", PostalCode: "02110"}
+	shelterID, err := handler.UserManager.WriteUser(context.Background(), &managers.User{UserType: managers.SHELTER, Username: "harbor", ContactInformation: contactInfo}, "password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return handler, shelterID, func() { datasource.Close() }
+}
+
+func serveWishlist(handler WishlistServiceHandler, userSession *managers.UserSession, method string, path string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if userSession != nil {
+		req.AddCookie(&http.Cookie{Name: "NeighborsAuth", Value: testKey})
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestWishlistPagesShowProgressToAnyone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, shelterID, cleanup := initWishlistServiceHandler(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	openID, _ := handler.ItemManager.WriteItem(ctx, &managers.Item{Category: "Blankets", Quantity: 20, Unit: managers.EACH, ShelterID: shelterID, Status: managers.CREATED})
+	receivedID, _ := handler.ItemManager.WriteItem(ctx, &managers.Item{Category: "Blankets", Quantity: 30, Unit: managers.EACH, ShelterID: shelterID, Status: managers.RECEIVED})
+
+	shelter := &managers.UserSession{SessionKey: testKey, UserType: managers.SHELTER, UserID: shelterID, LoginTime: time.Now().Unix()}
+	sessionManager := NewMockSessionManger(ctrl)
+	sessionManager.EXPECT().GetUserSession(gomock.Any(), gomock.Any()).AnyTimes().Return(shelter, nil)
+	handler.UserSessionManager = sessionManager
+
+	body := fmt.Sprintf(`{"Name":"Winter Drive","GoalDate":"2024-12-20","ItemIDs":[%d,%d]}`, openID, receivedID)
+	recorder := serveWishlist(handler, shelter, http.MethodPost, "/wishlists/", body)
+	if recorder.Code != http.StatusCreated {
+		t.Fatalf("Expected the wishlist to be created, got %v: %s", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = serveWishlist(handler, nil, http.MethodGet, recorder.Header().Get("Location"), "")
+	page := recorder.Body.String()
+	if recorder.Code != http.StatusOK || !strings.Contains(page, "Winter Drive") || !strings.Contains(page, "30/50 Blankets received") {
+		t.Errorf("Expected anyone to see the wishlist's progress, got %v: %s", recorder.Code, page)
+	}
+
+	if !strings.Contains(page, fmt.Sprintf(`href="/items/%d"`, openID)) || strings.Contains(page, fmt.Sprintf(`href="/items/%d"`, receivedID)) {
+		t.Error("Expected only the open item to link to helping with it")
+	}
+}
+
+func TestOnlyShelterCanChangeTheirWishlists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	handler, shelterID, cleanup := initWishlistServiceHandler(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	itemID, _ := handler.ItemManager.WriteItem(ctx, &managers.Item{Category: "Socks", Quantity: 5, Unit: managers.PAIR, ShelterID: shelterID, Status: managers.CREATED})
+	wishlistID, err := handler.WishlistManager.WriteWishlist(ctx, &managers.Wishlist{ShelterID: shelterID, Name: "Sock Drive", GoalDate: "2024-12-20", ItemIDs: []int64{itemID}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := fmt.Sprintf("/wishlists/%d", wishlistID)
+	if recorder := serveWishlist(handler, nil, http.MethodDelete, path, ""); recorder.Code != http.StatusUnauthorized {
+		t.Errorf("Expected visitors without a session to be unauthorized, got %v", recorder.Code)
+	}
+
+	other := &managers.UserSession{SessionKey: testKey, UserType: managers.SHELTER, UserID: shelterID + 1, LoginTime: time.Now().Unix()}
+	sessionManager := NewMockSessionManger(ctrl)
+	sessionManager.EXPECT().GetUserSession(gomock.Any(), gomock.Any()).AnyTimes().Return(other, nil)
+	handler.UserSessionManager = sessionManager
+
+	if recorder := serveWishlist(handler, other, http.MethodPut, path, `{"Name":"Mine now","GoalDate":"2024-12-20","ItemIDs":[]}`); recorder.Code != http.StatusForbidden {
+		t.Errorf("Expected other shelters to be forbidden from changing the wishlist, got %v", recorder.Code)
+	}
+
+	if recorder := serveWishlist(handler, other, http.MethodDelete, path, ""); recorder.Code != http.StatusForbidden {
+		t.Errorf("Expected other shelters to be forbidden from deleting the wishlist, got %v", recorder.Code)
+	}
+
+	if _, err := handler.WishlistManager.GetWishlist(ctx, wishlistID); err != nil {
+		t.Errorf("Expected the wishlist to be unchanged, got %v", err)
+	}
+}
@@ -0,0 +1,22 @@
+package retrievers
+
+import (
+	"fmt"
+	"html/template"
+)
+
+type AuditRetriever struct {
+	TemplateRetriever
+}
+
+func (ar AuditRetriever) RetrieveCreateEntityTemplate() (*template.Template, error) {
+	return nil, fmt.Errorf("UnsupportedOperation")
+}
+
+func (ar AuditRetriever) RetrieveSingleEntityTemplate() (*template.Template, error) {
+	return nil, fmt.Errorf("UnsupportedOperation")
+}
+
+func (ar AuditRetriever) RetrieveAllEntitiesTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, "admin/audit")
+}
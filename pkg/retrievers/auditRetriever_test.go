@@ -0,0 +1,39 @@
+package retrievers
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
+
+func TestRenderAuditTemplate(t *testing.T) {
+	tmpl, err := (&AuditRetriever{}).RetrieveAllEntitiesTemplate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := &bytes.Buffer{}
+	err = tmpl.Execute(buffer, map[string]interface{}{
+		"UserSession": &managers.UserSession{UserID: 1, UserType: managers.ADMIN},
+		"Events": []*managers.AuditEvent{
+			{ActorID: 2, Action: managers.AUDIT_ITEM_UPDATE, Entity: managers.AUDIT_ITEM, EntityID: 3, BeforeState: `{"Quantity":1}`, AfterState: `{"Quantity":2}`},
+		},
+		"Filters":   url.Values{"entity": {"item"}},
+		"Page":      2,
+		"ExportURL": "/admin/audit/?entity=item&format=csv",
+		"NextURL":   "/admin/audit/?entity=item&page=3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	html := buffer.String()
+	for _, expected := range []string{"item.update", `href="/admin/audit/?entity=item&entityId=3"`, `href="/admin/audit/?entity=item&amp;format=csv"`, "Page 2"} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("Expected audit page to contain %v", expected)
+		}
+	}
+}
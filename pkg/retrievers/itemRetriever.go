@@ -8,6 +8,8 @@ var createItemTemplatePath = "items/new"
 var getItemTemplatePath = "items/item"
 var getItemsTemplatePath = "items/items"
 var updateItemsTemplatePath = "items/edit"
+var importItemsTemplatePath = "items/import"
+var receiveItemTemplatePath = "items/receive"
 
 type ItemRetriever struct {
 	TemplateRetriever
@@ -28,3 +30,11 @@ func (ir ItemRetriever) RetrieveAllEntitiesTemplate() (*template.Template, error
 func (ir ItemRetriever) RetrieveEditEntityTemplate() (*template.Template, error) {
 	return RetrieveMultiTemplate(layoutTemplatePath, updateItemsTemplatePath)
 }
+
+func (ir ItemRetriever) RetrieveImportTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, importItemsTemplatePath)
+}
+
+func (ir ItemRetriever) RetrieveReceiveTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, receiveItemTemplatePath)
+}
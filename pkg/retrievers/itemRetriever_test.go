@@ -14,7 +14,7 @@ var itemRetriever = &ItemRetriever{}
 
 var testCategory = "testCategory"
 var testGender = "testGender"
-var testQuantity = int8(rand.Int() % 127)
+var testQuantity = rand.Int() % 127
 var testShelterID = rand.Int63()
 var testSize = "testSize"
 var testStatus = managers.CLAIMED
@@ -6,8 +6,9 @@ import (
 )
 
 var templatePaths = map[string]string{
-	"reset": "login/reset",
-	"login": "login/login",
+	"reset":     "login/reset",
+	"login":     "login/login",
+	"twoFactor": "login/twoFactor",
 }
 
 type LoginRetriever struct {
@@ -29,3 +30,7 @@ func (lr LoginRetriever) RetrieveAllEntitiesTemplate() (*template.Template, erro
 func (lr LoginRetriever) RetrieveEditEntityTemplate() (*template.Template, error) {
 	return RetrieveMultiTemplate(layoutTemplatePath, templatePaths["reset"])
 }
+
+func (lr LoginRetriever) RetrieveTwoFactorTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, templatePaths["twoFactor"])
+}
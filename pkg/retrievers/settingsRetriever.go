@@ -0,0 +1,8 @@
+package retrievers
+
+import "html/template"
+
+// RetrieveSiteSettingsTemplate returns the page administrators change the site settings on.
+func RetrieveSiteSettingsTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, "admin/settings")
+}
@@ -1,25 +1,23 @@
 package retrievers
 
-import "html/template"
-import "github.com/kwhite17/Neighbors/pkg/managers"
+import (
+	"html/template"
+	"time"
+
+	"github.com/kwhite17/Neighbors/pkg/managers"
+)
 
 func StatusAsString(status managers.ItemStatus) string {
-	switch status {
-	case managers.CREATED:
-		return "CREATED"
-	case managers.CLAIMED:
-		return "CLAIMED"
-	case managers.DELIVERED:
-		return "DELIVERED"
-	case managers.RECEIVED:
-		return "RECEIVED"
-	default:
-		return "UNKNOWN"
-	}
+	return status.String()
+}
+
+func FormatUnixTime(unixTime int64) string {
+	return time.Unix(unixTime, 0).Format("Jan 2, 2006 3:04 PM")
 }
 
 func buildFuncMap() template.FuncMap {
 	return template.FuncMap{
 		"statusAsString": StatusAsString,
+		"formatUnixTime": FormatUnixTime,
 	}
 }
@@ -9,7 +9,11 @@ var getShelterTemplatePath = "users/user"
 var getSheltersTemplatePath = "users/users"
 var getSamaritanSummaryTemplatePath = "users/samaritanSummary"
 var getShelterSummaryTemplatePath = "users/shelterSummary"
+var getApiTokensTemplatePath = "users/apiTokens"
 var updateSheltersTemplatePath = "users/edit"
+var intakeScheduleTemplatePath = "users/intake"
+var widgetSettingsTemplatePath = "users/widget"
+var getDeletedUsersTemplatePath = "admin/deletedUsers"
 
 type ShelterRetriever struct {
 	TemplateRetriever
@@ -20,7 +24,7 @@ func (sr ShelterRetriever) RetrieveCreateEntityTemplate() (*template.Template, e
 }
 
 func (sr ShelterRetriever) RetrieveSingleEntityTemplate() (*template.Template, error) {
-	return RetrieveMultiTemplate(layoutTemplatePath, getShelterTemplatePath, getSamaritanSummaryTemplatePath, getShelterSummaryTemplatePath)
+	return RetrieveMultiTemplate(layoutTemplatePath, getShelterTemplatePath, getSamaritanSummaryTemplatePath, getShelterSummaryTemplatePath, getApiTokensTemplatePath)
 }
 
 func (sr ShelterRetriever) RetrieveAllEntitiesTemplate() (*template.Template, error) {
@@ -30,3 +34,15 @@ func (sr ShelterRetriever) RetrieveAllEntitiesTemplate() (*template.Template, er
 func (sr ShelterRetriever) RetrieveEditEntityTemplate() (*template.Template, error) {
 	return RetrieveMultiTemplate(layoutTemplatePath, updateSheltersTemplatePath)
 }
+
+func (sr ShelterRetriever) RetrieveIntakeTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, intakeScheduleTemplatePath)
+}
+
+func (sr ShelterRetriever) RetrieveWidgetSettingsTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, widgetSettingsTemplatePath)
+}
+
+func (sr ShelterRetriever) RetrieveDeletedEntitiesTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, getDeletedUsersTemplatePath)
+}
@@ -46,6 +46,51 @@ func TestRenderSingleShelterTemplate(t *testing.T) {
 		t.Errorf("GetSingleShelter Failure - Expected html to contain location info, shelter name, and shelter email. Actual: %s\n", htmlStr)
 	}
 }
+func TestRenderDeletedItemsOnShelterTemplate(t *testing.T) {
+	testBuffer := bytes.NewBuffer(make([]byte, 0))
+	testShelter := generateShelter()
+	testShelter.UserType = managers.SHELTER
+	tmpl, err := shelterRetriever.RetrieveSingleEntityTemplate()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tmpl.Execute(testBuffer, map[string]interface{}{
+		"User":         testShelter,
+		"DeletedItems": []*managers.Item{{ID: 42, Category: "Coats", DeletedTime: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	htmlStr := testBuffer.String()
+	if !strings.Contains(htmlStr, "Recently Deleted") || !strings.Contains(htmlStr, "restoreItem( 42 )") {
+		t.Errorf("Expected html to list the deleted item with a restore button. Actual: %s\n", htmlStr)
+	}
+}
+
+func TestRenderDeletedUsersTemplate(t *testing.T) {
+	testBuffer := bytes.NewBuffer(make([]byte, 0))
+	testShelter := generateShelter()
+	testShelter.ID = 7
+	tmpl, err := shelterRetriever.RetrieveDeletedEntitiesTemplate()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tmpl.Execute(testBuffer, map[string]interface{}{"Users": []*managers.User{testShelter}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	htmlStr := testBuffer.String()
+	if !strings.Contains(htmlStr, testShelter.Email) || !strings.Contains(htmlStr, "restoreAccount( 7 )") {
+		t.Errorf("Expected html to list the deleted account with a restore button. Actual: %s\n", htmlStr)
+	}
+}
+
 func TestRenderSingleSamaritanTemplate(t *testing.T) {
 	testArray := make([]byte, 0)
 	testBuffer := bytes.NewBuffer(testArray)
@@ -0,0 +1,22 @@
+package retrievers
+
+import (
+	"html/template"
+	"path/filepath"
+
+	"github.com/kwhite17/Neighbors/pkg/assets"
+)
+
+var widgetTemplatePath = "widget/shelter"
+var widgetScriptPath = filepath.Join("assets", "widget", "widget.js")
+
+// RetrieveWidgetTemplate returns the page shelters embed in a frame. It stands alone, without the
+// site's layout, so it fits in the shelter's own site.
+func RetrieveWidgetTemplate() (*template.Template, error) {
+	return RetrieveTemplate(widgetTemplatePath)
+}
+
+// RetrieveWidgetScript returns the script shelters embed to render their open items in place.
+func RetrieveWidgetScript() ([]byte, error) {
+	return assets.Asset(widgetScriptPath)
+}
@@ -0,0 +1,31 @@
+package retrievers
+
+import (
+	"fmt"
+	"html/template"
+)
+
+var getWishlistTemplatePath = "wishlists/wishlist"
+var editWishlistTemplatePath = "wishlists/edit"
+
+type WishlistRetriever struct {
+	TemplateRetriever
+}
+
+// RetrieveCreateEntityTemplate returns the same form as editing, since a new wishlist is just one
+// without any details yet.
+func (wr WishlistRetriever) RetrieveCreateEntityTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, editWishlistTemplatePath)
+}
+
+func (wr WishlistRetriever) RetrieveSingleEntityTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, getWishlistTemplatePath)
+}
+
+func (wr WishlistRetriever) RetrieveAllEntitiesTemplate() (*template.Template, error) {
+	return nil, fmt.Errorf("UnsupportedOperation")
+}
+
+func (wr WishlistRetriever) RetrieveEditEntityTemplate() (*template.Template, error) {
+	return RetrieveMultiTemplate(layoutTemplatePath, editWishlistTemplatePath)
+}
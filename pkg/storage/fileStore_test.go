@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestFileStoreKeepsFilesUnderItsDirectory(t *testing.T) {
+	directory, err := ioutil.TempDir("", "neighbors")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	store := &FileStore{Directory: directory}
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "items/1/photo.png", "image/png", []byte("png")); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := store.Open(ctx, "items/1/photo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := ioutil.ReadAll(file)
+	file.Close()
+	if string(content) != "png" {
+		t.Errorf("Expected the stored file back, got %q", content)
+	}
+
+	if err := store.Delete(ctx, "items/1/photo.png"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Open(ctx, "items/1/photo.png"); err != ErrNotFound {
+		t.Errorf("Expected the file to be deleted, got %v", err)
+	}
+
+	for _, key := range []string{"../outside", "/etc/passwd", "items//photo.png", ""} {
+		if err := store.Put(ctx, key, "image/png", []byte("png")); err != errInvalidKey {
+			t.Errorf("Expected %q to be refused, got %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Store keeps files in a bucket of an S3-compatible service, such as Amazon S3 or MinIO.
+// Requests are signed with AWS Signature Version 4, and the bucket is addressed by path, which
+// every S3-compatible service supports.
+type S3Store struct {
+	// Endpoint is the service's base URL, such as https://s3.us-east-1.amazonaws.com.
+	Endpoint        string
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	Client          *http.Client
+	// now is replaced by tests to sign requests at a fixed time.
+	now func() time.Time
+}
+
+func (store *S3Store) Put(ctx context.Context, key string, contentType string, content []byte) error {
+	response, err := store.do(ctx, http.MethodPut, key, contentType, content)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return s3Error(http.MethodPut, key, response)
+	}
+	return nil
+}
+
+func (store *S3Store) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	response, err := store.do(ctx, http.MethodGet, key, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		response.Body.Close()
+		return nil, ErrNotFound
+	}
+
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		return nil, s3Error(http.MethodGet, key, response)
+	}
+	return response.Body, nil
+}
+
+func (store *S3Store) Delete(ctx context.Context, key string) error {
+	response, err := store.do(ctx, http.MethodDelete, key, "", nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+	return s3Error(http.MethodDelete, key, response)
+}
+
+func (store *S3Store) do(ctx context.Context, method string, key string, contentType string, content []byte) (*http.Response, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(store.Bucket+"/"+key, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	path := "/" + strings.Join(segments, "/")
+
+	request, err := http.NewRequest(method, strings.TrimSuffix(store.Endpoint, "/")+path, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	now := time.Now
+	if store.now != nil {
+		now = store.now
+	}
+	payloadHash := sha256.Sum256(content)
+	store.sign(request, path, hex.EncodeToString(payloadHash[:]), now())
+
+	client := store.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return client.Do(request)
+}
+
+// sign adds a Signature Version 4 Authorization header covering the host and every header already
+// set on the request. path is the request's path, already URI-encoded the way S3 expects.
+func (store *S3Store) sign(request *http.Request, path string, payloadHash string, now time.Time) {
+	timestamp := now.UTC().Format("20060102T150405Z")
+	date := timestamp[:8]
+	request.Header.Set("X-Amz-Date", timestamp)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	canonicalHeaders := ""
+	for _, name := range names {
+		canonicalHeaders += name + ":" + headers[name] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{request.Method, path, request.URL.RawQuery, canonicalHeaders, signedHeaders, payloadHash}, "\n")
+	scope := date + "/" + store.Region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + timestamp + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+store.SecretAccessKey), date)
+	for _, part := range []string{store.Region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+	request.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+store.AccessKeyID+"/"+scope+",SignedHeaders="+signedHeaders+",Signature="+signature)
+}
+
+func hmacSHA256(key []byte, message string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(message))
+	return mac.Sum(nil)
+}
+
+// uriEncode escapes everything but the characters Signature Version 4 leaves unreserved.
+func uriEncode(value string) string {
+	encoded := ""
+	for _, b := range []byte(value) {
+		if (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') || b == '-' || b == '.' || b == '_' || b == '~' {
+			encoded += string(b)
+		} else {
+			encoded += fmt.Sprintf("%%%02X", b)
+		}
+	}
+	return encoded
+}
+
+func s3Error(method string, key string, response *http.Response) error {
+	body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 512))
+	return fmt.Errorf("S3 %s %s failed with %s: %s", method, key, response.Status, strings.TrimSpace(string(body)))
+}
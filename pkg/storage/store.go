@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrNotFound is returned when a key has nothing stored under it.
+var ErrNotFound = errors.New("storage: object not found")
+
+var errInvalidKey = errors.New("storage: keys are relative paths without . or .. segments")
+
+// Store keeps uploaded files, such as item photos, outside of the database.
+type Store interface {
+	Put(ctx context.Context, key string, contentType string, content []byte) error
+	// Open returns ErrNotFound when nothing is stored under key.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete succeeds when nothing is stored under key.
+	Delete(ctx context.Context, key string) error
+}
+
+// validateKey keeps keys from escaping the directory or bucket they're stored in.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return errInvalidKey
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return errInvalidKey
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// REQUEST_ID_HEADER carries the request ID in from a proxy that assigned one, and back out to the client.
+const REQUEST_ID_HEADER = "X-Request-ID"
+
+const MAX_REQUEST_ID_LENGTH = 64
+
+type requestIDKey struct{}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the ID of the request being served, or "" outside of a request.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+func NewRequestID() string {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(id)
+}
+
+// IsValidRequestID accepts IDs from upstream proxies only if they are short and made of letters,
+// digits, dashes and underscores, so they can't be used to forge log lines.
+func IsValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > MAX_REQUEST_ID_LENGTH {
+		return false
+	}
+
+	for _, r := range requestID {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+		if !isLetter && !isDigit && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}